@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"app/infrastructure/config"
+	"app/infrastructure/db"
+	"app/infrastructure/logger"
+)
+
+// usage はコマンドの使い方です。
+const usage = `使い方: go run ./cmd/migrate <command> [args]
+
+コマンド:
+  up                 未適用のマイグレーションをすべて適用する
+  down [N]           適用済みのマイグレーションを新しいものから N 件巻き戻す（既定: 1）
+  status             マイグレーションの適用状況を表示する
+  create <name>      次の番号で空の up / down ファイルを作成する
+
+オプション:
+`
+
+func main() {
+
+	dir := flag.String("dir", db.MigrationsDir, "create コマンドでファイルを作成するディレクトリ")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	args := flag.Args()[1:]
+
+	switch flag.Arg(0) {
+	case "create":
+		// ファイルの作成のみのため、データベースには接続しない
+		if len(args) != 1 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		up, down, err := db.CreateMigration(*dir, args[0])
+		if err != nil {
+			logger.FatalJp("マイグレーションファイルの作成に失敗しました: %v", err)
+		}
+		logger.InfoJp("マイグレーションファイルを作成しました: %s, %s", up, down)
+
+	case "up":
+		applied, err := newMigrator().Up(ctx)
+		for _, m := range applied {
+			logger.InfoJp("適用しました: %04d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			logger.FatalJp("マイグレーションの適用に失敗しました: %v", err)
+		}
+		if len(applied) == 0 {
+			logger.InfoJp("スキーマは最新です")
+		}
+
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				logger.FatalJp("巻き戻す件数は 1 以上の整数で指定してください: %s", args[0])
+			}
+			steps = n
+		}
+		reverted, err := newMigrator().Down(ctx, steps)
+		for _, m := range reverted {
+			logger.InfoJp("巻き戻しました: %04d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			logger.FatalJp("マイグレーションの巻き戻しに失敗しました: %v", err)
+		}
+
+	case "status":
+		statuses, err := newMigrator().Status(ctx)
+		if err != nil {
+			logger.FatalJp("適用状況の取得に失敗しました: %v", err)
+		}
+		for _, s := range statuses {
+			applied := "未適用"
+			if s.AppliedAt != nil {
+				applied = "適用済み " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, applied)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// newMigrator は設定の接続情報でデータベースに接続し、マイグレーターを作成します。
+func newMigrator() *db.Migrator {
+
+	// 引数はサブコマンドで使用するため、設定は設定ファイルと環境変数のみから読み込む
+	cfg, err := config.Load(nil, os.LookupEnv)
+	if err != nil {
+		logger.FatalJp("設定の読み込みに失敗しました: %v", err)
+	}
+
+	sqlDB, _, err := db.OpenDatabase(cfg.Database)
+	if err != nil {
+		logger.FatalJp("データベースへの接続に失敗しました: %v", err)
+	}
+
+	m, err := db.NewMigrator(sqlDB)
+	if err != nil {
+		logger.FatalJp("マイグレーションファイルの読み込みに失敗しました: %v", err)
+	}
+
+	return m
+}
@@ -1,11 +1,28 @@
 package main
 
 import (
+	"app/infrastructure/buildinfo"
+	"app/infrastructure/config"
 	"app/infrastructure/di"
+	"app/infrastructure/lifecycle"
+	"app/infrastructure/logger"
+	systemdto "app/internal/application/dto/system"
 	"app/internal/application/interface/handler"
+	"app/internal/application/interface/httperror"
+	"app/internal/application/interface/middleware"
+	outputValueObj "app/internal/domain/output/value_obj"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
 func main() {
@@ -14,19 +31,197 @@ func main() {
 	// Echoインスタンスの初期化
 	e := echo.New()
 
+	// エラーレスポンスの形式を { code, message, level, details } に統一
+	e.HTTPErrorHandler = httperror.Handler
+
+	// 設定の読み込み(既定値 → 設定ファイル → 環境変数 → コマンドライン引数)
+	cfg, err := config.Load(os.Args[1:], os.LookupEnv)
+	if err != nil {
+		logger.FatalJp("設定の読み込みに失敗しました: %v", err)
+	}
+
 	// DI済みのAppオブジェクトの取得
-	app := di.InitializeApp()
+	app, err := di.InitializeApp(cfg)
+	if err != nil {
+		logger.FatalJp("アプリケーションの初期化に失敗しました: %v", err)
+	}
+
+	// 以降のログ(起動処理・エラーハンドラを含む)はすべて構造化ロガーに出力
+	slog.SetDefault(app.Logger.Slog())
+	logger.DebugJp("設定内容:\n%s", cfg)
+
+	// リクエストIDの付与とアクセスログ
+	// アクセスログはアプリケーションのログと同じ形式・出力先に出力する
+	e.HideBanner = true
+	e.HidePort = true
+	// サーバーのスパンは最初に開始し、以降のログ・ユースケース・SQL のスパンをその子として記録する
+	e.Use(
+		otelecho.Middleware(app.Config.Tracing.ServiceName,
+			otelecho.WithTracerProvider(app.TracerProvider),
+			otelecho.WithSkipper(func(c echo.Context) bool {
+				// プローブ・メトリクスの取得はトレースしない
+				switch c.Path() {
+				case "/healthz", "/readyz", "/metrics":
+					return true
+				}
+				return false
+			}),
+		),
+		middleware.RequestID(),
+		middleware.HTTPMetrics(app.Metrics),
+		middleware.AccessLog(app.Logger),
+	)
 
 	// ハンドラの作成
-	userHandler := handler.NewUserHandler(app.CreateUserUseCase)
+	userHandler := handler.NewUserHandler(
+		app.CreateUserUseCase,
+		app.GetUserUseCase,
+		app.ListUsersUseCase,
+		app.UpdateUserUseCase,
+		app.DeleteUserUseCase,
+	)
+	authHandler := handler.NewAuthHandler(
+		app.LoginUseCase,
+		app.VerifyTwoFactorUseCase,
+		app.RefreshTokenUseCase,
+		app.LogoutUseCase,
+		app.ForgotPasswordUseCase,
+		app.ResetPasswordUseCase,
+		app.VerifyEmailUseCase,
+		app.ResendVerificationUseCase,
+		app.EnrollTOTPUseCase,
+		app.ConfirmTOTPUseCase,
+	)
+	outputHandler := handler.NewOutputHandler(
+		app.CreateOutputUseCase,
+		app.GetOutputUseCase,
+		app.ListOutputsUseCase,
+		app.UpdateOutputUseCase,
+		app.DeleteOutputUseCase,
+		app.TransitionOutputStatusUseCase,
+		app.ListOutputTypesUseCase,
+	)
+
+	build := buildinfo.Get()
+	systemHandler := handler.NewSystemHandler(app.HealthChecker, app.Logger, systemdto.VersionResponse{
+		Version:   build.Version,
+		Commit:    build.Commit,
+		BuildTime: build.BuildTime,
+		GoVersion: build.GoVersion,
+	})
+
+	// ユースケースの実行結果をメトリクスに記録する(ルートごとに最後に指定する)
+	observe := func(usecase string) echo.MiddlewareFunc {
+		return middleware.ObserveUsecase(app.Metrics, usecase)
+	}
 
 	// ルーティング
 	e.GET("/", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Hello, World!")
 	})
-	e.POST("/users", userHandler.CreateUser)
+	e.GET("/healthz", systemHandler.Healthz)
+	e.GET("/readyz", systemHandler.Readyz)
+	e.GET("/version", systemHandler.Version)
+	e.GET("/metrics", echo.WrapHandler(app.Metrics.Handler()))
+	e.POST("/users", userHandler.CreateUser, observe("create_user"))
+	e.POST("/auth/login", authHandler.Login, observe("login"))
+	e.POST("/auth/login/two-factor", authHandler.VerifyTwoFactor, observe("verify_two_factor"))
+	e.POST("/auth/refresh", authHandler.Refresh, observe("refresh_token"))
+	e.POST("/auth/logout", authHandler.Logout, observe("logout"))
+	e.POST("/auth/password/forgot", authHandler.ForgotPassword, observe("forgot_password"))
+	e.POST("/auth/password/reset", authHandler.ResetPassword, observe("reset_password"))
+	e.GET("/auth/verify", authHandler.VerifyEmail, observe("verify_email"))
+
+	// 認証が必要なルート
+	// ルートごとに必要な権限を RequireRole で宣言する
+	authn := middleware.Authenticate(app.TokenIssuer)
+	e.GET("/auth/me", authHandler.Me, authn, middleware.RequireRole(value_obj.Guest))
+	e.POST("/auth/verify/resend", authHandler.ResendVerification, authn, middleware.RequireRole(value_obj.Guest), observe("resend_verification"))
+	e.POST("/auth/two-factor/totp", authHandler.EnrollTOTP, authn, middleware.RequireRole(value_obj.Member), observe("enroll_totp"))
+	e.POST("/auth/two-factor/totp/confirm", authHandler.ConfirmTOTP, authn, middleware.RequireRole(value_obj.Member), observe("confirm_totp"))
+	e.GET("/users", userHandler.ListUsers, authn, middleware.RequireRole(value_obj.Admin), observe("list_users"))
+	e.GET("/users/:id", userHandler.GetUser, authn, middleware.RequireRole(value_obj.Guest), observe("get_user"))
+	e.PATCH("/users/:id", userHandler.UpdateUser, authn, middleware.RequireRole(value_obj.Guest), observe("update_user"))
+	e.DELETE("/users/:id", userHandler.DeleteUser, authn, middleware.RequireRole(value_obj.Root), observe("delete_user"))
+	e.POST("/outputs", outputHandler.CreateOutput, authn, middleware.RequireRole(value_obj.Member), observe("create_output"))
+	e.GET("/outputs", outputHandler.ListOutputs, authn, middleware.RequireRole(value_obj.Guest), observe("list_outputs"))
+	e.GET("/outputs/types", outputHandler.ListOutputTypes, authn, middleware.RequireRole(value_obj.Admin), observe("list_output_types"))
+	e.GET("/outputs/:id", outputHandler.GetOutput, authn, middleware.RequireRole(value_obj.Guest), observe("get_output"))
+	e.PATCH("/outputs/:id", outputHandler.UpdateOutput, authn, middleware.RequireRole(value_obj.Guest), observe("update_output"))
+	e.DELETE("/outputs/:id", outputHandler.DeleteOutput, authn, middleware.RequireRole(value_obj.Guest), observe("delete_output"))
+	e.POST("/outputs/:id/submit", outputHandler.TransitionStatus(outputValueObj.Submit), authn, middleware.RequireRole(value_obj.Guest), observe("transition_output_status"))
+	e.POST("/outputs/:id/reject", outputHandler.TransitionStatus(outputValueObj.Reject), authn, middleware.RequireRole(value_obj.Admin), observe("transition_output_status"))
+	e.POST("/outputs/:id/publish", outputHandler.TransitionStatus(outputValueObj.Publish), authn, middleware.RequireRole(value_obj.Admin), observe("transition_output_status"))
+	e.POST("/outputs/:id/unpublish", outputHandler.TransitionStatus(outputValueObj.Unpublish), authn, middleware.RequireRole(value_obj.Guest), observe("transition_output_status"))
+	e.POST("/outputs/:id/archive", outputHandler.TransitionStatus(outputValueObj.Archive), authn, middleware.RequireRole(value_obj.Guest), observe("transition_output_status"))
+	e.POST("/outputs/:id/restore", outputHandler.TransitionStatus(outputValueObj.Restore), authn, middleware.RequireRole(value_obj.Guest), observe("transition_output_status"))
+
+	// パスワード再設定の申請
+	// 応答の後に処理するため、HTTP サーバーの停止後・データベースの停止前に処理中の申請の完了を待つ
+	app.Lifecycle.Append(lifecycle.Hook{
+		Name:   "password reset requests",
+		OnStop: app.ForgotPasswordUseCase.Wait,
+	})
+
+	// HTTP サーバー
+	// 最後に登録するため、停止時は最初に新規リクエストの受け付けを止め、処理中のリクエストの完了を待つ
+	e.Server.ReadTimeout = app.Config.Server.ReadTimeout
+	e.Server.WriteTimeout = app.Config.Server.WriteTimeout
+	e.Server.IdleTimeout = app.Config.Server.IdleTimeout
+	serveErr := make(chan error, 1)
+	app.Lifecycle.Append(lifecycle.Hook{
+		Name: "http server",
+		OnStart: func(context.Context) error {
+			// ポートの使用中などのエラーを起動時に返すため、待ち受けは同期的に開始する
+			ln, err := net.Listen("tcp", app.Config.Server.Addr)
+			if err != nil {
+				return err
+			}
+			e.Listener = ln
+			go func() {
+				if err := e.Start(app.Config.Server.Addr); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					serveErr <- err
+				}
+			}()
+			logger.InfoJp("サーバーを起動しました: %s", ln.Addr())
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return e.Shutdown(ctx)
+		},
+	})
+
+	// 起動
+	// SIGINT / SIGTERM を受け取るか、サーバーが異常終了するまで待機する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Lifecycle.Start(ctx); err != nil {
+		logger.FatalJp("サーバーの起動に失敗しました: %v", err)
+	}
+
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		logger.InfoJp("停止シグナルを受け取りました。処理中のリクエストの完了を待って停止します")
+	case err := <-serveErr:
+		logger.ErrorJp("サーバーが異常終了しました: %v", err)
+		exitCode = 1
+	}
+	// 2 回目のシグナルは待たずに強制終了させる
+	stop()
+
+	// 停止
+	// 登録の逆順(HTTP サーバー → データベース)に停止する。期限を過ぎた場合は残りの接続を強制的に閉じる
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), app.Config.Server.ShutdownTimeout)
+	defer cancel()
+	if err := app.Lifecycle.Stop(shutdownCtx); err != nil {
+		logger.ErrorJp("停止処理に失敗しました: %v", err)
+		exitCode = 1
+	}
 
-	// サーバーの起動
-	// 失敗時はログに出力して終了
-	e.Logger.Fatal(e.Start(":1322"))
+	logger.InfoJp("サーバーを停止しました")
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
 }
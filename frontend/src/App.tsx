@@ -10,11 +10,19 @@ type User = {
   id: string;
   name: string;
   email: string;
-  password: string;
   createdAt: string;
   updatedAt: string;
 }
 
+// POST /users のレスポンス
+type CreatedUser = {
+  id: string;
+  name: string;
+  email: string;
+  created_at: string;
+  updated_at: string;
+}
+
 function App() {
 
   const [users, setUsers] = useState<User[]>([]);
@@ -40,14 +48,16 @@ function App() {
       throw new Error(message);
     }
 
-    // 現状 backend が作成したユーザーを返さないため、UIは仮の1件を追加（必要なら後で一覧API実装へ）
+    // backendは作成したユーザーを返すため、その内容を一覧に追加する
+    const created = (await res.json()) as CreatedUser;
     setUsers((prev) => [
       ...prev,
       {
-        id: Date.now().toString(),
-        ...input,
-        createdAt: new Date().toISOString(),
-        updatedAt: new Date().toISOString(),
+        id: created.id,
+        name: created.name,
+        email: created.email,
+        createdAt: created.created_at,
+        updatedAt: created.updated_at,
       },
     ]);
   };
@@ -21,7 +21,7 @@ describe('CreateUserCard', () => {
     expect(screen.getByText('パスワードは8文字以上で入力してください')).toBeInTheDocument();
   });
 
-  it('パスワードが半角英数字以外を含む場合は送信せずエラーを表示する', async () => {
+  it('記号を含むパスワードも送信できる', async () => {
     const onCreate = vi.fn().mockResolvedValue(undefined);
     const user = userEvent.setup();
 
@@ -29,12 +29,15 @@ describe('CreateUserCard', () => {
 
     await user.type(screen.getByLabelText('ユーザー名'), 'Alice');
     await user.type(screen.getByLabelText('メールアドレス'), 'alice@example.com');
-    await user.type(screen.getByLabelText('パスワード'), 'Password!'); // 記号あり
+    await user.type(screen.getByLabelText('パスワード'), 'Password1!'); // 記号あり
 
     await user.click(screen.getByRole('button', { name: '作成' }));
 
-    expect(onCreate).not.toHaveBeenCalled();
-    expect(screen.getByText('パスワードは半角英数字のみで入力してください')).toBeInTheDocument();
+    await waitFor(() => expect(onCreate).toHaveBeenCalledWith({
+      name: 'Alice',
+      email: 'alice@example.com',
+      password: 'Password1!',
+    }));
   });
 
   it('送信中はボタンが無効になり、成功時にフォームがクリアされる', async () => {
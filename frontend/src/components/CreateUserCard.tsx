@@ -44,15 +44,12 @@ export const CreateUserCard = ({ onCreate }: CreateUserCardProps) => {
             return;
         }
 
-        // backendのドメインルールに合わせて事前チェック（8文字以上・半角英数字）
+        // backendのパスワードポリシーの既定値に合わせて事前チェック（8文字以上）
+        // 文字種・漏えい済みパスワードなどのチェックは環境ごとに設定されるため、backendのエラーを表示する
         if (form.password.length < 8) {
             setErrorMessage("パスワードは8文字以上で入力してください");
             return;
         }
-        if (!/^[a-zA-Z0-9]+$/.test(form.password)) {
-            setErrorMessage("パスワードは半角英数字のみで入力してください");
-            return;
-        }
 
         setIsSubmitting(true);
         try {
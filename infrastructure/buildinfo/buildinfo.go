@@ -0,0 +1,44 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// ビルド時に -ldflags で埋め込む値
+//
+//	go build -ldflags "-X app/infrastructure/buildinfo.Version=v1.2.3 -X app/infrastructure/buildinfo.Commit=$(git rev-parse HEAD) -X app/infrastructure/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/server
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info はビルド情報です。
+type Info struct {
+	Version   string
+	Commit    string
+	BuildTime string
+	GoVersion string
+}
+
+// Get はビルド情報を返します。
+// Commit・BuildTime が埋め込まれていない場合は、go build が記録した VCS の情報で補います。
+// 返り値: ビルド情報
+func Get() Info {
+
+	info := Info{Version: Version, Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = s.Value
+			case s.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = s.Value
+			}
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,36 @@
+package buildinfo
+
+import (
+	"runtime"
+	"testing"
+)
+
+// TestGet は -ldflags で埋め込んだ値をそのまま返し、Go のバージョンを補うことを検証します。
+// パッケージ変数を書き換えるため、並行しては実行しません。
+func TestGet(t *testing.T) {
+
+	version, commit, buildTime := Version, Commit, BuildTime
+	t.Cleanup(func() { Version, Commit, BuildTime = version, commit, buildTime })
+
+	tests := map[string]struct {
+		version, commit, buildTime string
+	}{
+		"embedded":  {version: "v1.2.3", commit: "0123456789abcdef", buildTime: "2025-01-01T00:00:00Z"},
+		"dev build": {version: "dev"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			Version, Commit, BuildTime = tt.version, tt.commit, tt.buildTime
+
+			got := Get()
+			if got.Version != tt.version || got.GoVersion != runtime.Version() {
+				t.Errorf("Get() = %+v, want version %q and go version %q", got, tt.version, runtime.Version())
+			}
+			// 埋め込んだ値は VCS の情報より優先する
+			if tt.commit != "" && (got.Commit != tt.commit || got.BuildTime != tt.buildTime) {
+				t.Errorf("Get() = %+v, want commit %q and build time %q", got, tt.commit, tt.buildTime)
+			}
+		})
+	}
+}
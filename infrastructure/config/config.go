@@ -0,0 +1,535 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// Config はアプリケーション全体の設定です。
+//
+// 値は「既定値 → 設定ファイル（YAML）→ 環境変数 → コマンドライン引数」の順に読み込み、後のものほど優先します。
+// 読み込み方法は Load を参照してください。
+type Config struct {
+	Server   ServerConfig   `yaml:"server"`
+	Database DatabaseConfig `yaml:"database"`
+	Security SecurityConfig `yaml:"security"`
+	Mail     MailConfig     `yaml:"mail"`
+	Log      LogConfig      `yaml:"log"`
+	Tracing  TracingConfig  `yaml:"tracing"`
+}
+
+// ServerConfig は HTTP サーバーの設定です。
+type ServerConfig struct {
+	// 待ち受けアドレス（例: ":1322"）
+	Addr string `yaml:"addr"`
+
+	// リクエストの読み込み・レスポンスの書き込み・Keep-Alive の待機のタイムアウト（0 は無制限）
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+	IdleTimeout  time.Duration `yaml:"idle_timeout"`
+
+	// 停止時に処理中のリクエストの完了を待つ最大時間。超えた場合は接続を強制的に閉じる
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// DatabaseConfig はデータベース接続の設定です。
+type DatabaseConfig struct {
+	// 接続先の DSN。スキームから接続先の種類を決定する（db.ParseDSN を参照）
+	URL string `yaml:"url"`
+
+	// Turso の認証トークン（libsql の場合のみ使用）
+	AuthToken Secret `yaml:"auth_token"`
+
+	// 起動時に開発用のシードデータを投入するか（libsql では使用不可）
+	Seed bool `yaml:"seed"`
+
+	// コネクションプールの設定（0 は database/sql の既定値）
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+}
+
+// SecurityConfig は認証まわりの設定です。
+type SecurityConfig struct {
+	// 新しく作成するパスワードハッシュのアルゴリズム（argon2id / bcrypt）
+	// 保存済みのハッシュはどちらのアルゴリズムでも検証でき、設定と異なる場合はログイン時に作り直す
+	PasswordHashAlgorithm string `yaml:"password_hash_algorithm"`
+
+	// パスワードハッシュの bcrypt コスト（password_hash_algorithm が bcrypt の場合に使用）
+	BcryptCost int `yaml:"bcrypt_cost"`
+
+	// パスワードハッシュの Argon2id のパラメーター（password_hash_algorithm が argon2id の場合に使用）
+	Argon2 Argon2Config `yaml:"argon2"`
+
+	// パスワードのペッパー。ハッシュ化の前に HMAC でパスワードに混ぜる、DB とは別に管理する秘密値
+	// （argon2id の場合のみ使用。未設定の場合は使用しない）
+	PasswordPepper Secret `yaml:"password_pepper"`
+
+	// パスワードポリシー
+	PasswordPolicy PasswordPolicyConfig `yaml:"password_policy"`
+
+	// パスワード再設定
+	PasswordReset PasswordResetConfig `yaml:"password_reset"`
+
+	// メールアドレスの確認
+	EmailVerification EmailVerificationConfig `yaml:"email_verification"`
+
+	// 2 段階認証
+	TwoFactor TwoFactorConfig `yaml:"two_factor"`
+
+	// ログインの失敗によるロックアウト
+	LoginLockout LoginLockoutConfig `yaml:"login_lockout"`
+
+	// アクセストークンの署名鍵（必須）
+	JWTSecret Secret `yaml:"jwt_secret"`
+
+	// 署名鍵を設定せず、起動ごとに一時的な鍵を生成するか（開発用。再起動で発行済みトークンは無効になる）
+	JWTEphemeralSecret bool `yaml:"jwt_ephemeral_secret"`
+}
+
+// Argon2Config はパスワードハッシュの Argon2id のパラメーターです。
+// 値を変更した場合、既存のハッシュは次回のログイン時に新しいパラメーターで作り直されます。
+type Argon2Config struct {
+	// 使用するメモリ量（KiB）
+	MemoryKiB int `yaml:"memory_kib"`
+
+	// 反復回数
+	Iterations int `yaml:"iterations"`
+
+	// 並列度（スレッド数）
+	Parallelism int `yaml:"parallelism"`
+}
+
+// PasswordPolicyConfig はユーザーが設定できるパスワードのルールです。
+type PasswordPolicyConfig struct {
+	// 最小・最大の文字数
+	MinLength int `yaml:"min_length"`
+	MaxLength int `yaml:"max_length"`
+
+	// 必須の文字種
+	RequireUppercase bool `yaml:"require_uppercase"`
+	RequireLowercase bool `yaml:"require_lowercase"`
+	RequireDigit     bool `yaml:"require_digit"`
+	RequireSymbol    bool `yaml:"require_symbol"`
+
+	// 名前・メールアドレスと似たパスワードを拒否するか
+	RejectSimilar bool `yaml:"reject_similar"`
+
+	// 漏えい済みパスワードの一覧ファイル（1 行に 1 つ。空の場合は確認しない）
+	BreachedListFile string `yaml:"breached_list_file"`
+}
+
+// PasswordResetConfig はパスワード再設定の設定です。
+type PasswordResetConfig struct {
+	// 再設定用トークンの有効期間
+	TokenTTL time.Duration `yaml:"token_ttl"`
+
+	// メールに記載する再設定画面の URL。トークンをクエリパラメーター token として付与する
+	URL string `yaml:"url"`
+
+	// 再設定メールを再送できる間隔
+	ResendInterval time.Duration `yaml:"resend_interval"`
+
+	// 1 時間あたりに送信できる再設定メールの上限
+	ResendLimit int `yaml:"resend_limit"`
+}
+
+// EmailVerificationConfig はメールアドレスの確認の設定です。
+type EmailVerificationConfig struct {
+	// 確認用トークンの有効期間
+	TokenTTL time.Duration `yaml:"token_ttl"`
+
+	// メールに記載する確認用の URL（GET /auth/verify）。トークンをクエリパラメーター token として付与する
+	URL string `yaml:"url"`
+
+	// 確認メールを再送できる間隔
+	ResendInterval time.Duration `yaml:"resend_interval"`
+
+	// 1 時間あたりに送信できる確認メールの上限（登録時の送信を含む）
+	ResendLimit int `yaml:"resend_limit"`
+}
+
+// TwoFactorConfig は 2 段階認証（TOTP）の設定です。
+type TwoFactorConfig struct {
+	// 認証アプリに表示する発行者名
+	Issuer string `yaml:"issuer"`
+
+	// TOTP シークレットを暗号化して保存するための鍵（必須）
+	EncryptionKey Secret `yaml:"encryption_key"`
+
+	// encryption_key の代わりに起動ごとに一時的な鍵を生成する（インメモリ DB での開発・テスト用）
+	// 再起動すると登録済みの 2 段階認証は使用できなくなるため、データを残す環境では使用しない
+	EphemeralKey bool `yaml:"ephemeral_key"`
+
+	// ログインの 2 段階目（コードの入力）の有効期間
+	ChallengeTTL time.Duration `yaml:"challenge_ttl"`
+
+	// 1 回のログインで誤ったコードを入力できる回数
+	MaxAttempts int `yaml:"max_attempts"`
+
+	// 発行するリカバリーコードの数
+	RecoveryCodes int `yaml:"recovery_codes"`
+}
+
+// LoginLockoutConfig はログインの失敗によるロックアウトの設定です。
+// パスワードと 2 段階目のコードの誤りをユーザーごとに数えます。
+type LoginLockoutConfig struct {
+	// ロックアウトするまでに連続して失敗できる回数
+	MaxFailures int `yaml:"max_failures"`
+
+	// 最後の失敗からログインを拒否する期間（この期間より前の失敗は数え直す）
+	Duration time.Duration `yaml:"duration"`
+}
+
+// MailConfig はメール送信の設定です。
+type MailConfig struct {
+	// 送信方法（smtp: SMTP サーバーへ送信 / file: ディレクトリへ書き出す（開発用） / log: ログに出力する（開発用））
+	Driver string `yaml:"driver"`
+
+	// 送信元アドレス
+	From string `yaml:"from"`
+
+	// SMTP サーバーの設定（driver が smtp の場合に使用）
+	SMTP SMTPConfig `yaml:"smtp"`
+
+	// 書き出し先のディレクトリ（driver が file の場合に使用）
+	Dir string `yaml:"dir"`
+}
+
+// SMTPConfig は SMTP サーバーの接続設定です。
+type SMTPConfig struct {
+	// ホスト名とポート番号
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+
+	// 認証情報（未設定の場合は認証しない）
+	Username string `yaml:"username"`
+	Password Secret `yaml:"password"`
+}
+
+// LogConfig はログ出力の設定です。
+type LogConfig struct {
+	// 出力するログの最低レベル（debug / info / warn / error）
+	Level string `yaml:"level"`
+
+	// 出力形式（json / text）
+	Format string `yaml:"format"`
+}
+
+// TracingConfig は OpenTelemetry のトレースの設定です。
+type TracingConfig struct {
+	// スパンの送信先（none: 送信しない / stdout: 標準出力 / otlp: OTLP over HTTP）
+	Exporter string `yaml:"exporter"`
+
+	// OTLP の送信先（例: localhost:4318）。未設定の場合は OTEL_EXPORTER_OTLP_ENDPOINT などの標準の環境変数に従う
+	Endpoint string `yaml:"endpoint"`
+
+	// OTLP の送信に TLS を使用しない
+	Insecure bool `yaml:"insecure"`
+
+	// トレースを記録する割合（0〜1）。上流から伝播されたトレースはその判定に従う
+	SampleRatio float64 `yaml:"sample_ratio"`
+
+	// トレースに記録するサービス名
+	ServiceName string `yaml:"service_name"`
+}
+
+// パスワードハッシュのアルゴリズム・メールの送信方法・ログレベル・出力形式とトレースの送信先
+var (
+	passwordHashAlgorithms = []string{"argon2id", "bcrypt"}
+	mailDrivers            = []string{"smtp", "file", "log"}
+	logLevels              = []string{"debug", "info", "warn", "error"}
+	logFormats             = []string{"json", "text"}
+	traceExporters         = []string{"none", "stdout", "otlp"}
+)
+
+// Default は既定値の設定を返します。
+// DATABASE_URL など環境ごとに異なる値は既定値を持たないため、別途指定が必要です。
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr:            ":1322",
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    30 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Database: DatabaseConfig{
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		},
+		Security: SecurityConfig{
+			PasswordHashAlgorithm: "argon2id",
+			BcryptCost:            bcrypt.DefaultCost,
+			Argon2: Argon2Config{
+				MemoryKiB:   64 * 1024,
+				Iterations:  3,
+				Parallelism: 4,
+			},
+			PasswordPolicy: PasswordPolicyConfig{
+				MinLength:        8,
+				MaxLength:        128,
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireDigit:     true,
+				RejectSimilar:    true,
+			},
+			PasswordReset: PasswordResetConfig{
+				TokenTTL:       30 * time.Minute,
+				URL:            "http://localhost:5173/password/reset",
+				ResendInterval: time.Minute,
+				ResendLimit:    5,
+			},
+			EmailVerification: EmailVerificationConfig{
+				TokenTTL:       24 * time.Hour,
+				URL:            "http://localhost:1322/auth/verify",
+				ResendInterval: time.Minute,
+				ResendLimit:    5,
+			},
+			TwoFactor: TwoFactorConfig{
+				Issuer:        "outbook",
+				ChallengeTTL:  5 * time.Minute,
+				MaxAttempts:   5,
+				RecoveryCodes: 10,
+			},
+			LoginLockout: LoginLockoutConfig{
+				MaxFailures: 10,
+				Duration:    15 * time.Minute,
+			},
+		},
+		Mail: MailConfig{
+			Driver: "file",
+			From:   "no-reply@localhost",
+			SMTP: SMTPConfig{
+				Port: 587,
+			},
+			Dir: "tmp/mail",
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "app",
+		},
+	}
+}
+
+// Validate は設定値が妥当かを確認し、問題をすべてまとめたエラーを返します。
+func (c *Config) Validate() error {
+
+	var errs []error
+
+	// サーバー
+	if c.Server.Addr == "" {
+		errs = append(errs, errors.New("server.addr is required"))
+	}
+	if c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 || c.Server.ShutdownTimeout < 0 {
+		errs = append(errs, errors.New("server timeouts must not be negative"))
+	}
+
+	// データベース
+	if c.Database.URL == "" {
+		errs = append(errs, errors.New("database.url is required (DATABASE_URL or TURSO_DATABASE_URL)"))
+	}
+	if c.Database.MaxOpenConns < 0 || c.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("database connection pool sizes must not be negative"))
+	}
+	if c.Database.ConnMaxLifetime < 0 || c.Database.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("database connection lifetimes must not be negative"))
+	}
+
+	// セキュリティ
+	if !contains(passwordHashAlgorithms, c.Security.PasswordHashAlgorithm) {
+		errs = append(errs, fmt.Errorf("security.password_hash_algorithm must be one of %s", strings.Join(passwordHashAlgorithms, ", ")))
+	}
+	if c.Security.BcryptCost < bcrypt.MinCost || c.Security.BcryptCost > bcrypt.MaxCost {
+		errs = append(errs, fmt.Errorf("security.bcrypt_cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost))
+	}
+	if p := c.Security.Argon2.Parallelism; p < 1 || p > 255 {
+		errs = append(errs, errors.New("security.argon2.parallelism must be between 1 and 255"))
+	}
+	if m := c.Security.Argon2.MemoryKiB; m < 8*c.Security.Argon2.Parallelism || m > 4*1024*1024 {
+		errs = append(errs, errors.New("security.argon2.memory_kib must be between 8 * parallelism and 4194304 (4 GiB)"))
+	}
+	if c.Security.Argon2.Iterations < 1 {
+		errs = append(errs, errors.New("security.argon2.iterations must be at least 1"))
+	}
+	if c.Security.PasswordPepper != "" && c.Security.PasswordHashAlgorithm != "argon2id" {
+		errs = append(errs, errors.New("security.password_pepper can only be used with argon2id"))
+	}
+	if c.Security.PasswordPolicy.MinLength < 1 {
+		errs = append(errs, errors.New("security.password_policy.min_length must be at least 1"))
+	}
+	if c.Security.PasswordPolicy.MaxLength < c.Security.PasswordPolicy.MinLength {
+		errs = append(errs, errors.New("security.password_policy.max_length must not be less than min_length"))
+	}
+	// bcrypt は 72 バイトを超えるパスワードをハッシュ化できない
+	if c.Security.PasswordHashAlgorithm == "bcrypt" && c.Security.PasswordPolicy.MaxLength > 72 {
+		errs = append(errs, errors.New("security.password_policy.max_length must be at most 72 with bcrypt"))
+	}
+	if c.Security.PasswordReset.TokenTTL <= 0 {
+		errs = append(errs, errors.New("security.password_reset.token_ttl must be positive"))
+	}
+	if u, err := url.Parse(c.Security.PasswordReset.URL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, errors.New("security.password_reset.url must be an absolute URL"))
+	}
+	if c.Security.PasswordReset.ResendInterval < 0 {
+		errs = append(errs, errors.New("security.password_reset.resend_interval must not be negative"))
+	}
+	if c.Security.PasswordReset.ResendLimit < 1 {
+		errs = append(errs, errors.New("security.password_reset.resend_limit must be at least 1"))
+	}
+	if c.Security.EmailVerification.TokenTTL <= 0 {
+		errs = append(errs, errors.New("security.email_verification.token_ttl must be positive"))
+	}
+	if u, err := url.Parse(c.Security.EmailVerification.URL); err != nil || u.Scheme == "" || u.Host == "" {
+		errs = append(errs, errors.New("security.email_verification.url must be an absolute URL"))
+	}
+	if c.Security.EmailVerification.ResendInterval < 0 {
+		errs = append(errs, errors.New("security.email_verification.resend_interval must not be negative"))
+	}
+	if c.Security.EmailVerification.ResendLimit < 1 {
+		errs = append(errs, errors.New("security.email_verification.resend_limit must be at least 1"))
+	}
+	if strings.TrimSpace(c.Security.TwoFactor.Issuer) == "" || strings.Contains(c.Security.TwoFactor.Issuer, ":") {
+		errs = append(errs, errors.New("security.two_factor.issuer must be non-empty and must not contain ':'"))
+	}
+	if c.Security.TwoFactor.EncryptionKey == "" && !c.Security.TwoFactor.EphemeralKey {
+		errs = append(errs, errors.New("security.two_factor.encryption_key is required (TWO_FACTOR_ENCRYPTION_KEY); set security.two_factor.ephemeral_key only for in-memory development"))
+	}
+	if c.Security.TwoFactor.EncryptionKey != "" && c.Security.TwoFactor.EphemeralKey {
+		errs = append(errs, errors.New("security.two_factor.encryption_key and security.two_factor.ephemeral_key cannot be used together"))
+	}
+	if c.Security.TwoFactor.ChallengeTTL <= 0 {
+		errs = append(errs, errors.New("security.two_factor.challenge_ttl must be positive"))
+	}
+	if c.Security.TwoFactor.MaxAttempts < 1 {
+		errs = append(errs, errors.New("security.two_factor.max_attempts must be at least 1"))
+	}
+	if c.Security.TwoFactor.RecoveryCodes < 1 {
+		errs = append(errs, errors.New("security.two_factor.recovery_codes must be at least 1"))
+	}
+	if c.Security.LoginLockout.MaxFailures < 1 {
+		errs = append(errs, errors.New("security.login_lockout.max_failures must be at least 1"))
+	}
+	if c.Security.LoginLockout.Duration <= 0 {
+		errs = append(errs, errors.New("security.login_lockout.duration must be positive"))
+	}
+	switch {
+	case c.Security.JWTSecret == "" && !c.Security.JWTEphemeralSecret:
+		errs = append(errs, errors.New("security.jwt_secret is required (JWT_SECRET, or set security.jwt_ephemeral_secret for development)"))
+	case c.Security.JWTSecret != "" && c.Security.JWTEphemeralSecret:
+		errs = append(errs, errors.New("security.jwt_secret and security.jwt_ephemeral_secret cannot be used together"))
+	}
+
+	// メール
+	if !contains(mailDrivers, c.Mail.Driver) {
+		errs = append(errs, fmt.Errorf("mail.driver must be one of %s", strings.Join(mailDrivers, ", ")))
+	}
+	if c.Mail.From == "" {
+		errs = append(errs, errors.New("mail.from is required"))
+	}
+	if c.Mail.Driver == "smtp" && (c.Mail.SMTP.Host == "" || c.Mail.SMTP.Port <= 0) {
+		errs = append(errs, errors.New("mail.smtp.host and mail.smtp.port are required with the smtp driver"))
+	}
+	if c.Mail.Driver == "file" && c.Mail.Dir == "" {
+		errs = append(errs, errors.New("mail.dir is required with the file driver"))
+	}
+
+	// ログ
+	if !contains(logLevels, c.Log.Level) {
+		errs = append(errs, fmt.Errorf("log.level must be one of %s", strings.Join(logLevels, ", ")))
+	}
+	if !contains(logFormats, c.Log.Format) {
+		errs = append(errs, fmt.Errorf("log.format must be one of %s", strings.Join(logFormats, ", ")))
+	}
+
+	// トレース
+	if !contains(traceExporters, c.Tracing.Exporter) {
+		errs = append(errs, fmt.Errorf("tracing.exporter must be one of %s", strings.Join(traceExporters, ", ")))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sample_ratio must be between 0 and 1"))
+	}
+	if c.Tracing.ServiceName == "" {
+		errs = append(errs, errors.New("tracing.service_name is required"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// String は秘密情報を伏せた設定内容を YAML 形式で返します。起動時のログ出力に使用します。
+func (c *Config) String() string {
+
+	out, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("<invalid config: %v>", err)
+	}
+
+	return string(out)
+}
+
+// contains は値が候補に含まれるかどうかを判定します。
+func contains(candidates []string, v string) bool {
+	for _, c := range candidates {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Secret は表示時に値を伏せる文字列です。
+// fmt での出力や YAML・JSON への変換、構造化ログ（log/slog）への出力では "[REDACTED]" となり、
+// 実際の値は Value で取得します。
+type Secret string
+
+// redacted は秘密情報の代わりに表示する文字列です。
+const redacted = "[REDACTED]"
+
+// Value は実際の値を返します。
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// String は値が設定されている場合は伏せ字を、未設定の場合は空文字を返します。
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// GoString は %#v での出力でも値を伏せます。
+func (s Secret) GoString() string {
+	return `"` + s.String() + `"`
+}
+
+// MarshalYAML は YAML への変換時に値を伏せます。
+func (s Secret) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
+
+// MarshalJSON は JSON への変換時に値を伏せます。
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// LogValue は構造化ログへの出力時に値を伏せます。
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
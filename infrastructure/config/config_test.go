@@ -0,0 +1,338 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// validConfig は検証を通る設定を返します。
+func validConfig() *Config {
+	c := Default()
+	c.Database.URL = ":memory:"
+	c.Security.JWTSecret = "test-secret"
+	c.Security.TwoFactor.EncryptionKey = "test-key"
+	return c
+}
+
+// TestConfig_Validate は設定の検証ルールを 1 つずつ違反させ、対応するエラーになることを検証します。
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		modify  func(c *Config)
+		wantErr string
+	}{
+		"valid": {
+			modify: func(c *Config) {},
+		},
+		"ephemeral jwt secret without jwt secret": {
+			modify: func(c *Config) {
+				c.Security.JWTSecret = ""
+				c.Security.JWTEphemeralSecret = true
+			},
+		},
+		"ephemeral two factor key without encryption key": {
+			modify: func(c *Config) {
+				c.Security.TwoFactor.EncryptionKey = ""
+				c.Security.TwoFactor.EphemeralKey = true
+			},
+		},
+		"bcrypt with max length 72": {
+			modify: func(c *Config) {
+				c.Security.PasswordHashAlgorithm = "bcrypt"
+				c.Security.PasswordPolicy.MaxLength = 72
+			},
+		},
+
+		// サーバー
+		"server addr required": {
+			modify:  func(c *Config) { c.Server.Addr = "" },
+			wantErr: "server.addr is required",
+		},
+		"negative server timeout": {
+			modify:  func(c *Config) { c.Server.ReadTimeout = -time.Second },
+			wantErr: "server timeouts must not be negative",
+		},
+
+		// データベース
+		"database url required": {
+			modify:  func(c *Config) { c.Database.URL = "" },
+			wantErr: "database.url is required",
+		},
+		"negative pool size": {
+			modify:  func(c *Config) { c.Database.MaxIdleConns = -1 },
+			wantErr: "database connection pool sizes must not be negative",
+		},
+		"negative connection lifetime": {
+			modify:  func(c *Config) { c.Database.ConnMaxIdleTime = -time.Second },
+			wantErr: "database connection lifetimes must not be negative",
+		},
+
+		// セキュリティ
+		"unknown password hash algorithm": {
+			modify:  func(c *Config) { c.Security.PasswordHashAlgorithm = "md5" },
+			wantErr: "security.password_hash_algorithm must be one of argon2id, bcrypt",
+		},
+		"bcrypt cost out of range": {
+			modify:  func(c *Config) { c.Security.BcryptCost = 32 },
+			wantErr: "security.bcrypt_cost must be between 4 and 31",
+		},
+		"argon2 parallelism out of range": {
+			modify:  func(c *Config) { c.Security.Argon2.Parallelism = 0 },
+			wantErr: "security.argon2.parallelism must be between 1 and 255",
+		},
+		"argon2 memory too small": {
+			modify:  func(c *Config) { c.Security.Argon2.MemoryKiB = 8*c.Security.Argon2.Parallelism - 1 },
+			wantErr: "security.argon2.memory_kib must be between",
+		},
+		"argon2 iterations": {
+			modify:  func(c *Config) { c.Security.Argon2.Iterations = 0 },
+			wantErr: "security.argon2.iterations must be at least 1",
+		},
+		"pepper with bcrypt": {
+			modify: func(c *Config) {
+				c.Security.PasswordHashAlgorithm = "bcrypt"
+				c.Security.PasswordPolicy.MaxLength = 72
+				c.Security.PasswordPepper = "pepper"
+			},
+			wantErr: "security.password_pepper can only be used with argon2id",
+		},
+		"password min length": {
+			modify:  func(c *Config) { c.Security.PasswordPolicy.MinLength = 0 },
+			wantErr: "security.password_policy.min_length must be at least 1",
+		},
+		"password max length below min length": {
+			modify:  func(c *Config) { c.Security.PasswordPolicy.MaxLength = 7 },
+			wantErr: "security.password_policy.max_length must not be less than min_length",
+		},
+		"password max length with bcrypt": {
+			modify:  func(c *Config) { c.Security.PasswordHashAlgorithm = "bcrypt" },
+			wantErr: "security.password_policy.max_length must be at most 72 with bcrypt",
+		},
+		"password reset token ttl": {
+			modify:  func(c *Config) { c.Security.PasswordReset.TokenTTL = 0 },
+			wantErr: "security.password_reset.token_ttl must be positive",
+		},
+		"password reset url": {
+			modify:  func(c *Config) { c.Security.PasswordReset.URL = "/password/reset" },
+			wantErr: "security.password_reset.url must be an absolute URL",
+		},
+		"password reset resend interval": {
+			modify:  func(c *Config) { c.Security.PasswordReset.ResendInterval = -time.Second },
+			wantErr: "security.password_reset.resend_interval must not be negative",
+		},
+		"password reset resend limit": {
+			modify:  func(c *Config) { c.Security.PasswordReset.ResendLimit = 0 },
+			wantErr: "security.password_reset.resend_limit must be at least 1",
+		},
+		"email verification token ttl": {
+			modify:  func(c *Config) { c.Security.EmailVerification.TokenTTL = 0 },
+			wantErr: "security.email_verification.token_ttl must be positive",
+		},
+		"email verification url": {
+			modify:  func(c *Config) { c.Security.EmailVerification.URL = "localhost/auth/verify" },
+			wantErr: "security.email_verification.url must be an absolute URL",
+		},
+		"email verification resend interval": {
+			modify:  func(c *Config) { c.Security.EmailVerification.ResendInterval = -time.Second },
+			wantErr: "security.email_verification.resend_interval must not be negative",
+		},
+		"email verification resend limit": {
+			modify:  func(c *Config) { c.Security.EmailVerification.ResendLimit = 0 },
+			wantErr: "security.email_verification.resend_limit must be at least 1",
+		},
+		"two factor issuer with colon": {
+			modify:  func(c *Config) { c.Security.TwoFactor.Issuer = "out:book" },
+			wantErr: "security.two_factor.issuer must be non-empty and must not contain ':'",
+		},
+		"two factor encryption key required": {
+			modify:  func(c *Config) { c.Security.TwoFactor.EncryptionKey = "" },
+			wantErr: "security.two_factor.encryption_key is required",
+		},
+		"two factor encryption key with ephemeral key": {
+			modify:  func(c *Config) { c.Security.TwoFactor.EphemeralKey = true },
+			wantErr: "security.two_factor.encryption_key and security.two_factor.ephemeral_key cannot be used together",
+		},
+		"two factor challenge ttl": {
+			modify:  func(c *Config) { c.Security.TwoFactor.ChallengeTTL = 0 },
+			wantErr: "security.two_factor.challenge_ttl must be positive",
+		},
+		"two factor max attempts": {
+			modify:  func(c *Config) { c.Security.TwoFactor.MaxAttempts = 0 },
+			wantErr: "security.two_factor.max_attempts must be at least 1",
+		},
+		"two factor recovery codes": {
+			modify:  func(c *Config) { c.Security.TwoFactor.RecoveryCodes = 0 },
+			wantErr: "security.two_factor.recovery_codes must be at least 1",
+		},
+		"login lockout max failures": {
+			modify:  func(c *Config) { c.Security.LoginLockout.MaxFailures = 0 },
+			wantErr: "security.login_lockout.max_failures must be at least 1",
+		},
+		"login lockout duration": {
+			modify:  func(c *Config) { c.Security.LoginLockout.Duration = 0 },
+			wantErr: "security.login_lockout.duration must be positive",
+		},
+		"jwt secret required": {
+			modify:  func(c *Config) { c.Security.JWTSecret = "" },
+			wantErr: "security.jwt_secret is required",
+		},
+		"jwt secret with ephemeral secret": {
+			modify:  func(c *Config) { c.Security.JWTEphemeralSecret = true },
+			wantErr: "security.jwt_secret and security.jwt_ephemeral_secret cannot be used together",
+		},
+
+		// メール
+		"unknown mail driver": {
+			modify:  func(c *Config) { c.Mail.Driver = "sendmail" },
+			wantErr: "mail.driver must be one of smtp, file, log",
+		},
+		"mail from required": {
+			modify:  func(c *Config) { c.Mail.From = "" },
+			wantErr: "mail.from is required",
+		},
+		"smtp host required": {
+			modify:  func(c *Config) { c.Mail.Driver = "smtp" },
+			wantErr: "mail.smtp.host and mail.smtp.port are required with the smtp driver",
+		},
+		"mail dir required": {
+			modify:  func(c *Config) { c.Mail.Dir = "" },
+			wantErr: "mail.dir is required with the file driver",
+		},
+
+		// ログ
+		"unknown log level": {
+			modify:  func(c *Config) { c.Log.Level = "trace" },
+			wantErr: "log.level must be one of debug, info, warn, error",
+		},
+		"unknown log format": {
+			modify:  func(c *Config) { c.Log.Format = "xml" },
+			wantErr: "log.format must be one of json, text",
+		},
+
+		// トレース
+		"unknown trace exporter": {
+			modify:  func(c *Config) { c.Tracing.Exporter = "jaeger" },
+			wantErr: "tracing.exporter must be one of none, stdout, otlp",
+		},
+		"sample ratio out of range": {
+			modify:  func(c *Config) { c.Tracing.SampleRatio = 1.5 },
+			wantErr: "tracing.sample_ratio must be between 0 and 1",
+		},
+		"service name required": {
+			modify:  func(c *Config) { c.Tracing.ServiceName = "" },
+			wantErr: "tracing.service_name is required",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := validConfig()
+			tt.modify(c)
+
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestConfig_Validate_CollectsAllErrors は複数の違反をまとめて返すことを検証します。
+func TestConfig_Validate_CollectsAllErrors(t *testing.T) {
+	t.Parallel()
+
+	c := validConfig()
+	c.Server.Addr = ""
+	c.Log.Level = "trace"
+
+	err := c.Validate()
+	if err == nil || !strings.Contains(err.Error(), "server.addr is required") || !strings.Contains(err.Error(), "log.level must be one of") {
+		t.Fatalf("Validate() error = %v, want both violations", err)
+	}
+}
+
+// TestSecret_Redaction は秘密情報が文字列化・YAML・JSON・構造化ログのいずれでも伏せられ、
+// Value でのみ実際の値を取得できることを検証します。
+func TestSecret_Redaction(t *testing.T) {
+	t.Parallel()
+
+	const secret = "super-secret-value"
+
+	c := validConfig()
+	c.Database.AuthToken = secret
+	c.Security.PasswordPepper = secret
+	c.Security.JWTSecret = secret
+	c.Security.TwoFactor.EncryptionKey = secret
+	c.Mail.SMTP.Password = secret
+
+	// jsonOf は値を JSON に変換します。
+	jsonOf := func(v any) string {
+		out, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		return string(out)
+	}
+
+	// slogOf は指定したハンドラーで値を属性として出力します。
+	slogOf := func(newHandler func(*bytes.Buffer) slog.Handler, v any) string {
+		var buf bytes.Buffer
+		slog.New(newHandler(&buf)).Info("config", "value", v)
+		return buf.String()
+	}
+	jsonHandler := func(buf *bytes.Buffer) slog.Handler { return slog.NewJSONHandler(buf, nil) }
+	textHandler := func(buf *bytes.Buffer) slog.Handler { return slog.NewTextHandler(buf, nil) }
+
+	tests := map[string]struct {
+		output    string
+		redaction int
+	}{
+		"String":            {output: Secret(secret).String(), redaction: 1},
+		"fmt %v":            {output: fmt.Sprintf("%v", Secret(secret)), redaction: 1},
+		"fmt %#v":           {output: fmt.Sprintf("%#v", c.Security), redaction: 3},
+		"config String":     {output: c.String(), redaction: 5},
+		"json secret":       {output: jsonOf(Secret(secret)), redaction: 1},
+		"json config":       {output: jsonOf(c), redaction: 5},
+		"slog json secret":  {output: slogOf(jsonHandler, Secret(secret)), redaction: 1},
+		"slog text secret":  {output: slogOf(textHandler, Secret(secret)), redaction: 1},
+		"slog json config":  {output: slogOf(jsonHandler, c), redaction: 5},
+		"slog text section": {output: slogOf(textHandler, c.Mail.SMTP), redaction: 1},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if strings.Contains(tt.output, secret) {
+				t.Fatalf("output leaks the secret: %s", tt.output)
+			}
+			if got := strings.Count(tt.output, redacted); got != tt.redaction {
+				t.Errorf("redacted count = %d, want %d: %s", got, tt.redaction, tt.output)
+			}
+		})
+	}
+
+	// 未設定の場合は伏せ字にしない
+	if got := Secret("").String(); got != "" {
+		t.Errorf("empty Secret.String() = %q, want empty", got)
+	}
+	if got := Secret(secret).Value(); got != secret {
+		t.Errorf("Value() = %q, want %q", got, secret)
+	}
+}
@@ -0,0 +1,266 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// setting は環境変数・コマンドライン引数から設定できる 1 項目です。
+type setting struct {
+	// 環境変数名
+	env string
+
+	// コマンドライン引数名（空文字の場合は引数では指定できない）
+	flag string
+
+	// コマンドライン引数の説明
+	usage string
+
+	// 文字列の値を設定に反映する関数
+	set func(c *Config, v string) error
+}
+
+// settings は環境変数・コマンドライン引数と設定項目の対応です。
+// 同じ項目に複数の環境変数がある場合は、後に書いたものを優先します。
+var settings = []setting{
+	// サーバー
+	{env: "SERVER_ADDR", flag: "addr", usage: "待ち受けアドレス（例: :1322）", set: setString(func(c *Config) *string { return &c.Server.Addr })},
+	{env: "SERVER_READ_TIMEOUT", set: setDuration(func(c *Config) *time.Duration { return &c.Server.ReadTimeout })},
+	{env: "SERVER_WRITE_TIMEOUT", set: setDuration(func(c *Config) *time.Duration { return &c.Server.WriteTimeout })},
+	{env: "SERVER_IDLE_TIMEOUT", set: setDuration(func(c *Config) *time.Duration { return &c.Server.IdleTimeout })},
+	{env: "SERVER_SHUTDOWN_TIMEOUT", set: setDuration(func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout })},
+
+	// データベース(TURSO_DATABASE_URL は従来の環境変数名。DATABASE_URL があればそちらを優先)
+	{env: "TURSO_DATABASE_URL", set: setString(func(c *Config) *string { return &c.Database.URL })},
+	{env: "DATABASE_URL", flag: "database-url", usage: "データベースの接続先（libsql://..., file:./local.db, :memory:）", set: setString(func(c *Config) *string { return &c.Database.URL })},
+	{env: "TURSO_AUTH_TOKEN", set: setSecret(func(c *Config) *Secret { return &c.Database.AuthToken })},
+	{env: "DATABASE_SEED", flag: "seed", usage: "起動時に開発用のシードデータを投入する（true / false）", set: setBool(func(c *Config) *bool { return &c.Database.Seed })},
+	{env: "DATABASE_MAX_OPEN_CONNS", set: setInt(func(c *Config) *int { return &c.Database.MaxOpenConns })},
+	{env: "DATABASE_MAX_IDLE_CONNS", set: setInt(func(c *Config) *int { return &c.Database.MaxIdleConns })},
+	{env: "DATABASE_CONN_MAX_LIFETIME", set: setDuration(func(c *Config) *time.Duration { return &c.Database.ConnMaxLifetime })},
+	{env: "DATABASE_CONN_MAX_IDLE_TIME", set: setDuration(func(c *Config) *time.Duration { return &c.Database.ConnMaxIdleTime })},
+
+	// セキュリティ
+	{env: "PASSWORD_HASH_ALGORITHM", flag: "password-hash-algorithm", usage: "新しいパスワードハッシュのアルゴリズム（argon2id / bcrypt）", set: setString(func(c *Config) *string { return &c.Security.PasswordHashAlgorithm })},
+	{env: "BCRYPT_COST", flag: "bcrypt-cost", usage: "パスワードハッシュの bcrypt コスト", set: setInt(func(c *Config) *int { return &c.Security.BcryptCost })},
+	{env: "ARGON2_MEMORY_KIB", set: setInt(func(c *Config) *int { return &c.Security.Argon2.MemoryKiB })},
+	{env: "ARGON2_ITERATIONS", set: setInt(func(c *Config) *int { return &c.Security.Argon2.Iterations })},
+	{env: "ARGON2_PARALLELISM", set: setInt(func(c *Config) *int { return &c.Security.Argon2.Parallelism })},
+	{env: "PASSWORD_PEPPER", set: setSecret(func(c *Config) *Secret { return &c.Security.PasswordPepper })},
+	{env: "PASSWORD_MIN_LENGTH", set: setInt(func(c *Config) *int { return &c.Security.PasswordPolicy.MinLength })},
+	{env: "PASSWORD_MAX_LENGTH", set: setInt(func(c *Config) *int { return &c.Security.PasswordPolicy.MaxLength })},
+	{env: "PASSWORD_REQUIRE_UPPERCASE", set: setBool(func(c *Config) *bool { return &c.Security.PasswordPolicy.RequireUppercase })},
+	{env: "PASSWORD_REQUIRE_LOWERCASE", set: setBool(func(c *Config) *bool { return &c.Security.PasswordPolicy.RequireLowercase })},
+	{env: "PASSWORD_REQUIRE_DIGIT", set: setBool(func(c *Config) *bool { return &c.Security.PasswordPolicy.RequireDigit })},
+	{env: "PASSWORD_REQUIRE_SYMBOL", set: setBool(func(c *Config) *bool { return &c.Security.PasswordPolicy.RequireSymbol })},
+	{env: "PASSWORD_REJECT_SIMILAR", set: setBool(func(c *Config) *bool { return &c.Security.PasswordPolicy.RejectSimilar })},
+	{env: "PASSWORD_BREACHED_LIST_FILE", flag: "password-breached-list", usage: "漏えい済みパスワードの一覧ファイル", set: setString(func(c *Config) *string { return &c.Security.PasswordPolicy.BreachedListFile })},
+	{env: "PASSWORD_RESET_TOKEN_TTL", set: setDuration(func(c *Config) *time.Duration { return &c.Security.PasswordReset.TokenTTL })},
+	{env: "PASSWORD_RESET_URL", set: setString(func(c *Config) *string { return &c.Security.PasswordReset.URL })},
+	{env: "PASSWORD_RESET_RESEND_INTERVAL", set: setDuration(func(c *Config) *time.Duration { return &c.Security.PasswordReset.ResendInterval })},
+	{env: "PASSWORD_RESET_RESEND_LIMIT", set: setInt(func(c *Config) *int { return &c.Security.PasswordReset.ResendLimit })},
+	{env: "EMAIL_VERIFICATION_TOKEN_TTL", set: setDuration(func(c *Config) *time.Duration { return &c.Security.EmailVerification.TokenTTL })},
+	{env: "EMAIL_VERIFICATION_URL", set: setString(func(c *Config) *string { return &c.Security.EmailVerification.URL })},
+	{env: "EMAIL_VERIFICATION_RESEND_INTERVAL", set: setDuration(func(c *Config) *time.Duration { return &c.Security.EmailVerification.ResendInterval })},
+	{env: "EMAIL_VERIFICATION_RESEND_LIMIT", set: setInt(func(c *Config) *int { return &c.Security.EmailVerification.ResendLimit })},
+	{env: "TWO_FACTOR_ISSUER", set: setString(func(c *Config) *string { return &c.Security.TwoFactor.Issuer })},
+	{env: "TWO_FACTOR_ENCRYPTION_KEY", set: setSecret(func(c *Config) *Secret { return &c.Security.TwoFactor.EncryptionKey })},
+	{env: "TWO_FACTOR_EPHEMERAL_KEY", set: setBool(func(c *Config) *bool { return &c.Security.TwoFactor.EphemeralKey })},
+	{env: "TWO_FACTOR_CHALLENGE_TTL", set: setDuration(func(c *Config) *time.Duration { return &c.Security.TwoFactor.ChallengeTTL })},
+	{env: "TWO_FACTOR_MAX_ATTEMPTS", set: setInt(func(c *Config) *int { return &c.Security.TwoFactor.MaxAttempts })},
+	{env: "TWO_FACTOR_RECOVERY_CODES", set: setInt(func(c *Config) *int { return &c.Security.TwoFactor.RecoveryCodes })},
+	{env: "LOGIN_LOCKOUT_MAX_FAILURES", set: setInt(func(c *Config) *int { return &c.Security.LoginLockout.MaxFailures })},
+	{env: "LOGIN_LOCKOUT_DURATION", set: setDuration(func(c *Config) *time.Duration { return &c.Security.LoginLockout.Duration })},
+	{env: "JWT_SECRET", set: setSecret(func(c *Config) *Secret { return &c.Security.JWTSecret })},
+	{env: "JWT_EPHEMERAL_SECRET", set: setBool(func(c *Config) *bool { return &c.Security.JWTEphemeralSecret })},
+
+	// メール
+	{env: "MAIL_DRIVER", flag: "mail-driver", usage: "メールの送信方法（smtp / file / log）", set: setString(func(c *Config) *string { return &c.Mail.Driver })},
+	{env: "MAIL_FROM", set: setString(func(c *Config) *string { return &c.Mail.From })},
+	{env: "SMTP_HOST", set: setString(func(c *Config) *string { return &c.Mail.SMTP.Host })},
+	{env: "SMTP_PORT", set: setInt(func(c *Config) *int { return &c.Mail.SMTP.Port })},
+	{env: "SMTP_USERNAME", set: setString(func(c *Config) *string { return &c.Mail.SMTP.Username })},
+	{env: "SMTP_PASSWORD", set: setSecret(func(c *Config) *Secret { return &c.Mail.SMTP.Password })},
+	{env: "MAIL_DIR", set: setString(func(c *Config) *string { return &c.Mail.Dir })},
+
+	// ログ
+	{env: "LOG_LEVEL", flag: "log-level", usage: "ログレベル（debug / info / warn / error）", set: setString(func(c *Config) *string { return &c.Log.Level })},
+	{env: "LOG_FORMAT", flag: "log-format", usage: "ログの出力形式（json / text）", set: setString(func(c *Config) *string { return &c.Log.Format })},
+
+	// トレース
+	{env: "TRACING_EXPORTER", flag: "tracing-exporter", usage: "トレースの送信先（none / stdout / otlp）", set: setString(func(c *Config) *string { return &c.Tracing.Exporter })},
+	{env: "TRACING_ENDPOINT", set: setString(func(c *Config) *string { return &c.Tracing.Endpoint })},
+	{env: "TRACING_INSECURE", set: setBool(func(c *Config) *bool { return &c.Tracing.Insecure })},
+	{env: "TRACING_SAMPLE_RATIO", set: setFloat(func(c *Config) *float64 { return &c.Tracing.SampleRatio })},
+	{env: "TRACING_SERVICE_NAME", set: setString(func(c *Config) *string { return &c.Tracing.ServiceName })},
+}
+
+// Load は設定を読み込み、検証済みの設定を返します。
+//
+//  1. 既定値（Default）
+//  2. 設定ファイル（-config 引数、なければ環境変数 CONFIG_FILE で指定した YAML）
+//  3. 環境変数（SERVER_ADDR, DATABASE_URL など）
+//  4. コマンドライン引数（-addr, -database-url など）
+//
+// の順に読み込み、後のものほど優先します。
+// 引数: コマンドライン引数（プログラム名を除く）, 環境変数の取得関数（通常は os.LookupEnv）
+// 返り値: 設定, 引数・ファイル・環境変数の形式が不正な場合や検証に失敗した場合はエラー
+func Load(args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+
+	// コマンドライン引数の解析
+	// 反映は最後に行うが、設定ファイルのパスを知るために先に解析する
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	configFile := fs.String("config", "", "設定ファイル（YAML）のパス")
+	flagValues := map[string]*string{}
+	for _, s := range settings {
+		if s.flag != "" {
+			flagValues[s.flag] = fs.String(s.flag, "", s.usage)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cfg := Default()
+
+	// 設定ファイル
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv("CONFIG_FILE")
+	}
+	if path != "" {
+		if err := loadFile(cfg, path); err != nil {
+			return nil, err
+		}
+	}
+
+	// 環境変数
+	for _, s := range settings {
+		v, ok := lookupEnv(s.env)
+		if !ok || v == "" {
+			continue
+		}
+		if err := s.set(cfg, v); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.env, err)
+		}
+	}
+
+	// コマンドライン引数（明示的に指定されたもののみ）
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.flag != f.Name || flagErr != nil {
+				continue
+			}
+			if err := s.set(cfg, *flagValues[s.flag]); err != nil {
+				flagErr = fmt.Errorf("-%s: %w", s.flag, err)
+			}
+		}
+	})
+	if flagErr != nil {
+		return nil, flagErr
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// loadFile は YAML の設定ファイルを読み込み、記載された項目のみを上書きします。
+// 未知の項目はタイプミスの可能性が高いためエラーにします。
+func loadFile(cfg *Config, path string) error {
+
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+	default:
+		return fmt.Errorf("unsupported config file format: %s (only YAML is supported)", path)
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(body))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// setString は文字列の項目に値を設定する関数を返します。
+func setString(field func(c *Config) *string) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+// setSecret は秘密情報の項目に値を設定する関数を返します。
+func setSecret(field func(c *Config) *Secret) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		*field(c) = Secret(v)
+		return nil
+	}
+}
+
+// setInt は整数の項目に値を設定する関数を返します。
+func setInt(field func(c *Config) *int) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", v)
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+// setFloat は小数の項目に値を設定する関数を返します。
+func setFloat(field func(c *Config) *float64) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", v)
+		}
+		*field(c) = f
+		return nil
+	}
+}
+
+// setBool は真偽値の項目に値を設定する関数を返します。
+func setBool(field func(c *Config) *bool) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+// setDuration は時間の項目に値を設定する関数を返します（例: 15s, 1m30s）。
+func setDuration(field func(c *Config) *time.Duration) func(c *Config, v string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", v)
+		}
+		*field(c) = d
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// lookupEnvFrom はテスト用の環境変数から値を取得する関数を返します。
+func lookupEnvFrom(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+}
+
+// writeConfigFile は一時ディレクトリに設定ファイルを作成し、そのパスを返します。
+func writeConfigFile(t *testing.T, name, body string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+// TestLoad は「既定値 → 設定ファイル → 環境変数 → コマンドライン引数」の順に後のものほど優先されることと、
+// 形式の不正・検証の失敗がエラーになることを検証します。
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	file := writeConfigFile(t, "config.yaml", `
+server:
+  addr: ":2000"
+  read_timeout: 10s
+database:
+  url: "file:./from-file.db"
+  max_open_conns: 20
+log:
+  level: warn
+tracing:
+  service_name: from-file
+`)
+	otherFile := writeConfigFile(t, "other.yml", `
+server:
+  addr: ":2500"
+`)
+	unknownField := writeConfigFile(t, "unknown.yaml", `
+server:
+  adr: ":2000"
+`)
+	jsonFile := writeConfigFile(t, "config.json", `{}`)
+
+	// required は検証を通すために必要な環境変数です。
+	required := map[string]string{
+		"DATABASE_URL":              ":memory:",
+		"JWT_SECRET":                "test-secret",
+		"TWO_FACTOR_ENCRYPTION_KEY": "test-key",
+	}
+	withRequired := func(env map[string]string) map[string]string {
+		merged := map[string]string{}
+		for k, v := range required {
+			merged[k] = v
+		}
+		for k, v := range env {
+			merged[k] = v
+		}
+		return merged
+	}
+
+	tests := map[string]struct {
+		args    []string
+		env     map[string]string
+		check   func(t *testing.T, c *Config)
+		wantErr string
+	}{
+		"defaults": {
+			env: required,
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":1322" || c.Log.Level != "info" || c.Server.ReadTimeout != 15*time.Second {
+					t.Errorf("got addr=%q level=%q read_timeout=%v, want defaults", c.Server.Addr, c.Log.Level, c.Server.ReadTimeout)
+				}
+			},
+		},
+		"file overrides defaults": {
+			env: map[string]string{"CONFIG_FILE": file, "JWT_SECRET": "test-secret", "TWO_FACTOR_ENCRYPTION_KEY": "test-key"},
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":2000" || c.Server.ReadTimeout != 10*time.Second || c.Database.URL != "file:./from-file.db" {
+					t.Errorf("got addr=%q read_timeout=%v url=%q, want values from file", c.Server.Addr, c.Server.ReadTimeout, c.Database.URL)
+				}
+				// ファイルに無い項目は既定値のまま
+				if c.Server.WriteTimeout != 30*time.Second || c.Database.MaxIdleConns != 5 || c.Log.Format != "json" {
+					t.Errorf("got write_timeout=%v max_idle_conns=%d format=%q, want defaults", c.Server.WriteTimeout, c.Database.MaxIdleConns, c.Log.Format)
+				}
+			},
+		},
+		"config flag takes precedence over CONFIG_FILE": {
+			args: []string{"-config", otherFile},
+			env:  withRequired(map[string]string{"CONFIG_FILE": file}),
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":2500" || c.Log.Level != "info" {
+					t.Errorf("got addr=%q level=%q, want values from -config only", c.Server.Addr, c.Log.Level)
+				}
+			},
+		},
+		"env overrides file": {
+			env: withRequired(map[string]string{"CONFIG_FILE": file, "SERVER_ADDR": ":3000", "LOG_LEVEL": "error"}),
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":3000" || c.Log.Level != "error" || c.Database.URL != ":memory:" {
+					t.Errorf("got addr=%q level=%q url=%q, want values from env", c.Server.Addr, c.Log.Level, c.Database.URL)
+				}
+				if c.Database.MaxOpenConns != 20 || c.Tracing.ServiceName != "from-file" {
+					t.Errorf("got max_open_conns=%d service_name=%q, want values from file", c.Database.MaxOpenConns, c.Tracing.ServiceName)
+				}
+			},
+		},
+		"flag overrides env": {
+			args: []string{"-addr", ":4000", "-log-level", "debug"},
+			env:  withRequired(map[string]string{"CONFIG_FILE": file, "SERVER_ADDR": ":3000", "LOG_LEVEL": "error"}),
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":4000" || c.Log.Level != "debug" {
+					t.Errorf("got addr=%q level=%q, want values from flags", c.Server.Addr, c.Log.Level)
+				}
+			},
+		},
+		"empty env is ignored": {
+			env: withRequired(map[string]string{"CONFIG_FILE": file, "SERVER_ADDR": ""}),
+			check: func(t *testing.T, c *Config) {
+				if c.Server.Addr != ":2000" {
+					t.Errorf("addr = %q, want value from file", c.Server.Addr)
+				}
+			},
+		},
+		"DATABASE_URL takes precedence over TURSO_DATABASE_URL": {
+			env: withRequired(map[string]string{"TURSO_DATABASE_URL": "libsql://example.turso.io"}),
+			check: func(t *testing.T, c *Config) {
+				if c.Database.URL != ":memory:" {
+					t.Errorf("url = %q, want DATABASE_URL", c.Database.URL)
+				}
+			},
+		},
+		"typed env values": {
+			env: withRequired(map[string]string{
+				"DATABASE_SEED":        "true",
+				"BCRYPT_COST":          "11",
+				"TRACING_SAMPLE_RATIO": "0.25",
+				"SERVER_READ_TIMEOUT":  "1m30s",
+				"TURSO_AUTH_TOKEN":     "token",
+			}),
+			check: func(t *testing.T, c *Config) {
+				if !c.Database.Seed || c.Security.BcryptCost != 11 || c.Tracing.SampleRatio != 0.25 || c.Server.ReadTimeout != 90*time.Second {
+					t.Errorf("got seed=%v cost=%d ratio=%v read_timeout=%v", c.Database.Seed, c.Security.BcryptCost, c.Tracing.SampleRatio, c.Server.ReadTimeout)
+				}
+				if c.Database.AuthToken.Value() != "token" {
+					t.Errorf("auth token = %q, want %q", c.Database.AuthToken.Value(), "token")
+				}
+			},
+		},
+		"ephemeral jwt secret": {
+			env: map[string]string{"DATABASE_URL": ":memory:", "JWT_EPHEMERAL_SECRET": "true", "TWO_FACTOR_ENCRYPTION_KEY": "test-key"},
+			check: func(t *testing.T, c *Config) {
+				if !c.Security.JWTEphemeralSecret || c.Security.JWTSecret != "" {
+					t.Errorf("got ephemeral=%v secret set=%v, want ephemeral secret only", c.Security.JWTEphemeralSecret, c.Security.JWTSecret != "")
+				}
+			},
+		},
+		"invalid env value": {
+			env:     withRequired(map[string]string{"BCRYPT_COST": "high"}),
+			wantErr: `BCRYPT_COST: invalid integer "high"`,
+		},
+		"invalid flag value": {
+			args:    []string{"-seed", "maybe"},
+			env:     required,
+			wantErr: `-seed: invalid boolean "maybe"`,
+		},
+		"unknown flag": {
+			args:    []string{"-unknown"},
+			env:     required,
+			wantErr: "invalid arguments",
+		},
+		"unknown field in file": {
+			env:     withRequired(map[string]string{"CONFIG_FILE": unknownField}),
+			wantErr: "field adr not found",
+		},
+		"unsupported file format": {
+			env:     withRequired(map[string]string{"CONFIG_FILE": jsonFile}),
+			wantErr: "only YAML is supported",
+		},
+		"missing file": {
+			env:     withRequired(map[string]string{"CONFIG_FILE": filepath.Join(t.TempDir(), "missing.yaml")}),
+			wantErr: "failed to read config file",
+		},
+		"validation error": {
+			env:     map[string]string{"JWT_SECRET": "test-secret", "TWO_FACTOR_ENCRYPTION_KEY": "test-key"},
+			wantErr: "invalid config: database.url is required",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := Load(tt.args, lookupEnvFrom(tt.env))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			tt.check(t, c)
+		})
+	}
+}
@@ -1,50 +1,226 @@
 package db
 
 import (
+	"context"
 	"database/sql"
-	"os"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync/atomic"
 
+	"app/infrastructure/config"
+	"app/infrastructure/health"
+	"app/infrastructure/lifecycle"
 	"app/infrastructure/logger"
+	"app/infrastructure/metrics"
+	"app/infrastructure/tracing"
 
 	libsql "github.com/tursodatabase/libsql-client-go/libsql"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+)
+
+// Driver はデータベースの接続先の種類です。
+type Driver string
+
+// 接続先の種類
+const (
+	// Turso / libsql サーバー（libsql://, https://, wss:// など）
+	DriverLibSQL Driver = "libsql"
 
-	"app/internal/domain/user/entity"
+	// ローカルの SQLite ファイル（file:./local.db や ./local.db）
+	DriverSQLite Driver = "sqlite"
+
+	// プロセス内のインメモリ SQLite（:memory:）。終了するとデータは消える
+	DriverMemory Driver = "memory"
 )
 
-func NewConnection() *gorm.DB {
-	tursoURL := os.Getenv("TURSO_DATABASE_URL")
-	tursoAuthToken := os.Getenv("TURSO_AUTH_TOKEN")
+// ErrDatabaseURLRequired は接続先が指定されていないことを表します。
+var ErrDatabaseURLRequired = errors.New("DATABASE_URL (or TURSO_DATABASE_URL) is required")
+
+// memoryDBSeq はインメモリデータベースの名前を接続ごとに分けるための連番です。
+var memoryDBSeq atomic.Int64
+
+// ParseDSN は DSN から接続先の種類と、ドライバーに渡す接続文字列を決定します。
+//
+//   - libsql://, http://, https://, ws://, wss:// → DriverLibSQL
+//   - :memory:, file::memory:, mode=memory を含む file: → DriverMemory
+//   - file:, スキームの無いパス → DriverSQLite
+//
+// 引数: DSN
+// 返り値: 接続先の種類, 接続文字列, 空・未対応のスキームの場合はエラー
+func ParseDSN(dsn string) (Driver, string, error) {
 
-	if tursoURL == "" || tursoAuthToken == "" {
-		logger.FatalJp("環境変数 TURSO_DATABASE_URL と TURSO_AUTH_TOKEN は必須です")
+	dsn = strings.TrimSpace(dsn)
+	if dsn == "" {
+		return "", "", ErrDatabaseURLRequired
 	}
 
-	// Tursoコネクターの作成
-	connector, err := libsql.NewConnector(tursoURL, libsql.WithAuthToken(tursoAuthToken))
+	// インメモリ
+	// 同じプロセス内の接続間でデータを共有するため、名前付きの共有キャッシュにする
+	if dsn == ":memory:" || strings.HasPrefix(dsn, "file::memory:") || (strings.HasPrefix(dsn, "file:") && strings.Contains(dsn, "mode=memory")) {
+		return DriverMemory, fmt.Sprintf("file:app_memory_%d?mode=memory&cache=shared", memoryDBSeq.Add(1)), nil
+	}
+
+	// ローカルファイル
+	if strings.HasPrefix(dsn, "file:") {
+		return DriverSQLite, withBusyTimeout(dsn), nil
+	}
+
+	u, err := url.Parse(dsn)
 	if err != nil {
-		logger.FatalJp("Turso への接続コネクター作成に失敗しました: %v", err)
+		return "", "", fmt.Errorf("invalid database url: %w", err)
+	}
+	switch u.Scheme {
+	case "libsql", "http", "https", "ws", "wss":
+		return DriverLibSQL, dsn, nil
+	case "":
+		return DriverSQLite, withBusyTimeout("file:" + dsn), nil
 	}
 
-	// database/sqlでTursoに接続
-	sqlDB := sql.OpenDB(connector)
+	return "", "", fmt.Errorf("unsupported database url scheme: %s", u.Scheme)
+}
 
-	// 接続テスト
-	if err := sqlDB.Ping(); err != nil {
-		logger.FatalJp("データベースへの接続確認に失敗しました: %v", err)
+// withBusyTimeout はローカルファイルの書き込み競合時に即座に失敗しないよう、待機時間を設定します。
+func withBusyTimeout(dsn string) string {
+	if strings.Contains(dsn, "_busy_timeout=") {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&_busy_timeout=5000"
 	}
+	return dsn + "?_busy_timeout=5000"
+}
+
+// NewConnection はアプリケーションが使用する GORM の接続を作成します。
+//
+// スキーマの変更は起動時には行わず、未適用のマイグレーションが残っている場合は ErrSchemaOutdated を返します。
+// マイグレーションは cmd/migrate から適用します。
+// ただしインメモリの場合は毎回空のデータベースから始まるため、接続時にすべて適用します。
+// SQL のログは構造化ロガーに出力します。
+// 接続はライフサイクルの停止時に閉じます。
+// 準備確認（/readyz）には接続確認とマイグレーションの適用状況の確認を登録します。
+// SQL の実行時間とコネクションプールの統計はメトリクスに、SQL ごとのスパンはトレースに記録します。
+// 引数: データベース接続の設定, 構造化ロガー, ライフサイクル, ヘルスチェックレジストリ, メトリクス
+// 返り値: GORM の接続, 接続・スキーマ確認・シード投入に失敗した場合はエラー
+func NewConnection(cfg config.DatabaseConfig, log *logger.SlogLogger, lc *lifecycle.Lifecycle, checks *health.Registry, m *metrics.Metrics) (*gorm.DB, error) {
+
+	ctx := context.Background()
 
-	// GORMでTursoを使用
-	db, err := gorm.Open(sqlite.Dialector{Conn: sqlDB}, &gorm.Config{})
+	sqlDB, driver, err := OpenDatabase(cfg)
 	if err != nil {
-		logger.FatalJp("データベース接続の初期化に失敗しました: %v", err)
+		return nil, err
+	}
+
+	// スキーマのバージョンチェック
+	migrator, err := NewMigrator(sqlDB)
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	if driver == DriverMemory {
+		if _, err := migrator.Up(ctx); err != nil {
+			sqlDB.Close()
+			return nil, err
+		}
+	}
+	if err := migrator.CheckUpToDate(ctx); err != nil {
+		sqlDB.Close()
+		return nil, err
 	}
 
-	// マイグレーション
-	if err := db.AutoMigrate(&entity.User{}); err != nil {
-		logger.FatalJp("ユーザーテーブルのマイグレーションに失敗しました: %v", err)
+	// シードデータの投入
+	if cfg.Seed {
+		if err := Seed(ctx, sqlDB, driver); err != nil {
+			sqlDB.Close()
+			return nil, err
+		}
+		log.Info(ctx, "シードデータを投入しました")
+	}
+
+	// GORMで使用
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqlDB}, &gorm.Config{Logger: logger.NewGormLogger(log)})
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to initialize gorm: %w", err)
+	}
+
+	// メトリクス・トレース
+	if err := db.Use(metrics.NewGormPlugin(m)); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to register gorm metrics plugin: %w", err)
+	}
+	m.RegisterDBStats(sqlDB)
+	if err := db.Use(tracing.NewGormPlugin()); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to register gorm tracing plugin: %w", err)
+	}
+
+	// 準備確認
+	checks.Register("database", sqlDB.PingContext)
+	checks.Register("migrations", migrator.CheckUpToDate)
+
+	// 停止時の接続のクローズ
+	// 後に登録されるモジュール（HTTP サーバーなど）がすべて停止してから閉じられる
+	lc.Append(lifecycle.Hook{
+		Name: "database",
+		OnStop: func(context.Context) error {
+			return sqlDB.Close()
+		},
+	})
+
+	return db, nil
+}
+
+// OpenDatabase は設定の DSN に応じたドライバーで接続し、接続確認済みの database/sql の接続を返します。
+// サーバーとマイグレーションコマンドの両方から使用します。
+// 引数: データベース接続の設定
+// 返り値: 接続, 接続先の種類, 接続・接続確認に失敗した場合はエラー
+func OpenDatabase(cfg config.DatabaseConfig) (*sql.DB, Driver, error) {
+
+	driver, dsn, err := ParseDSN(cfg.URL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var sqlDB *sql.DB
+	switch driver {
+	case DriverLibSQL:
+		// Tursoコネクターの作成
+		var opts []libsql.Option
+		if cfg.AuthToken != "" {
+			opts = append(opts, libsql.WithAuthToken(cfg.AuthToken.Value()))
+		}
+		connector, err := libsql.NewConnector(dsn, opts...)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create libsql connector: %w", err)
+		}
+		sqlDB = sql.OpenDB(connector)
+	default:
+		// SQLite(ローカルファイル / インメモリ)
+		sqlDB, err = sql.Open(sqlite.DriverName, dsn)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open sqlite: %w", err)
+		}
+	}
+
+	// コネクションプールの設定
+	// 接続がすべて閉じるとインメモリのデータベースは破棄されるため、インメモリの場合はアイドル接続を残し続ける
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	if driver == DriverMemory {
+		sqlDB.SetMaxIdleConns(max(cfg.MaxIdleConns, 1))
+	} else {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	// 接続テスト
+	if err := sqlDB.Ping(); err != nil {
+		sqlDB.Close()
+		return nil, "", fmt.Errorf("failed to ping %s database: %w", driver, err)
 	}
 
-	return db
+	return sqlDB, driver, nil
 }
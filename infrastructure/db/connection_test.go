@@ -0,0 +1,115 @@
+package db
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestParseDSN は DSN から接続先の種類と、ドライバーに渡す接続文字列を決定できることを検証します。
+func TestParseDSN(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dsn        string
+		wantDriver Driver
+		wantDSN    string
+		wantErr    error
+		wantErrMsg string
+	}{
+		"file scheme": {
+			dsn:        "file:./local.db",
+			wantDriver: DriverSQLite,
+			wantDSN:    "file:./local.db?_busy_timeout=5000",
+		},
+		"file scheme with query": {
+			dsn:        "file:./local.db?_foreign_keys=on",
+			wantDriver: DriverSQLite,
+			wantDSN:    "file:./local.db?_foreign_keys=on&_busy_timeout=5000",
+		},
+		"file scheme with busy timeout": {
+			dsn:        "file:./local.db?_busy_timeout=100",
+			wantDriver: DriverSQLite,
+			wantDSN:    "file:./local.db?_busy_timeout=100",
+		},
+		"path without scheme": {
+			dsn:        " ./data/local.db ",
+			wantDriver: DriverSQLite,
+			wantDSN:    "file:./data/local.db?_busy_timeout=5000",
+		},
+		"memory": {
+			dsn:        ":memory:",
+			wantDriver: DriverMemory,
+		},
+		"file memory": {
+			dsn:        "file::memory:?cache=shared",
+			wantDriver: DriverMemory,
+		},
+		"file with memory mode": {
+			dsn:        "file:test.db?mode=memory",
+			wantDriver: DriverMemory,
+		},
+		"turso": {
+			dsn:        "libsql://app-example.turso.io",
+			wantDriver: DriverLibSQL,
+			wantDSN:    "libsql://app-example.turso.io",
+		},
+		"turso with auth token": {
+			dsn:        "libsql://app-example.turso.io?authToken=secret-token",
+			wantDriver: DriverLibSQL,
+			wantDSN:    "libsql://app-example.turso.io?authToken=secret-token",
+		},
+		"libsql server over https": {
+			dsn:        "https://127.0.0.1:8080",
+			wantDriver: DriverLibSQL,
+			wantDSN:    "https://127.0.0.1:8080",
+		},
+		"empty": {
+			dsn:     "  ",
+			wantErr: ErrDatabaseURLRequired,
+		},
+		"unsupported scheme": {
+			dsn:        "postgres://localhost/app",
+			wantErrMsg: "unsupported database url scheme: postgres",
+		},
+		"invalid url": {
+			dsn:        "libsql://app-example.turso.io/%zz",
+			wantErrMsg: "invalid database url",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			driver, dsn, err := ParseDSN(tt.dsn)
+			if tt.wantErr != nil || tt.wantErrMsg != "" {
+				if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) || !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Fatalf("ParseDSN(%q) error = %v, want %v %q", tt.dsn, err, tt.wantErr, tt.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDSN(%q) error = %v", tt.dsn, err)
+			}
+			if driver != tt.wantDriver {
+				t.Errorf("driver = %q, want %q", driver, tt.wantDriver)
+			}
+
+			// インメモリは接続ごとに別の名前付き共有キャッシュになる
+			if tt.wantDriver == DriverMemory {
+				if !strings.HasPrefix(dsn, "file:app_memory_") || !strings.HasSuffix(dsn, "?mode=memory&cache=shared") {
+					t.Errorf("dsn = %q, want named shared in-memory database", dsn)
+				}
+				if _, again, _ := ParseDSN(tt.dsn); again == dsn {
+					t.Errorf("dsn = %q, want a different name on each call", dsn)
+				}
+				return
+			}
+			if dsn != tt.wantDSN {
+				t.Errorf("dsn = %q, want %q", dsn, tt.wantDSN)
+			}
+		})
+	}
+}
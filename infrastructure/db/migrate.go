@@ -0,0 +1,372 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFiles はバイナリに埋め込むマイグレーション SQL です。
+// ファイル名は「連番_名前.up.sql」「連番_名前.down.sql」の組で作成します。
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// MigrationsDir はリポジトリ内のマイグレーションファイルの配置先です（create コマンドの既定の出力先）。
+const MigrationsDir = "infrastructure/db/migrations"
+
+// schemaMigrationsTable は適用済みのマイグレーションを記録するテーブルです。
+const schemaMigrationsTable = "schema_migrations"
+
+// ErrSchemaOutdated は未適用のマイグレーションが残っていることを表します。
+var ErrSchemaOutdated = errors.New("database schema is out of date")
+
+// migrationFileName はマイグレーションファイル名の形式です。
+var migrationFileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration は 1 つのバージョンの up / down の SQL の組です。
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus はマイグレーションの適用状況です。
+// AppliedAt が nil の場合は未適用です。
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator はバージョン管理された SQL マイグレーションを適用・巻き戻しします。
+// 各マイグレーションはトランザクション内で実行し、schema_migrations への記録と同時に確定します。
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// マイグレーターコンストラクタ
+// バイナリに埋め込まれたマイグレーションを読み込みます。
+// 引数: データベース接続
+// 返り値: マイグレーターオブジェクト, マイグレーションファイルが不正な場合はエラー
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+
+	sub, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := LoadMigrations(sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// LoadMigrations はディレクトリ直下のマイグレーションファイルをバージョン順に読み込みます。
+// バージョンは 1 からの連番とし、欠番がある場合はマージ漏れなどとしてエラーにします。
+// 引数: マイグレーションファイルを含むファイルシステム
+// 返り値: バージョン昇順のマイグレーション, 形式が不正・up/down の片方が無い・バージョンが重複する・欠番がある場合はエラー
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sql") {
+			continue
+		}
+
+		m := migrationFileName.FindStringSubmatch(e.Name())
+		if m == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", e.Name())
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version: %s: %w", e.Name(), err)
+		}
+
+		body, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(body)
+		} else {
+			mig.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if strings.TrimSpace(mig.Up) == "" || strings.TrimSpace(mig.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, mig := range migrations {
+		if want := int64(i + 1); mig.Version != want {
+			return nil, fmt.Errorf("migration versions must be consecutive from 1: %d is missing before %d_%s", want, mig.Version, mig.Name)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Up は未適用のマイグレーションをすべてバージョン順に適用します。
+// 引数: コンテキスト
+// 返り値: 今回適用したマイグレーション, 適用に失敗した場合はエラー（失敗したマイグレーションより前の適用結果は残る）
+// レシーバー: マイグレーターオブジェクト
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]Migration, 0, len(pending))
+	for _, mig := range pending {
+		err := m.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO "+schemaMigrationsTable+" (version, name, applied_at) VALUES (?, ?, ?)",
+				mig.Version, mig.Name, time.Now().UTC().Format(time.RFC3339))
+			return err
+		})
+		if err != nil {
+			return applied, fmt.Errorf("failed to apply migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		applied = append(applied, mig)
+	}
+
+	return applied, nil
+}
+
+// Down は適用済みのマイグレーションを新しいものから指定数だけ巻き戻します。
+// 引数: コンテキスト, 巻き戻す数（適用済みの数より多い場合はすべて）
+// 返り値: 今回巻き戻したマイグレーション, 巻き戻しに失敗した場合はエラー
+// レシーバー: マイグレーターオブジェクト
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+
+	if steps < 1 {
+		return nil, fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+	if len(versions) > steps {
+		versions = versions[:steps]
+	}
+
+	reverted := make([]Migration, 0, len(versions))
+	for _, v := range versions {
+		mig, ok := m.find(v)
+		if !ok {
+			return reverted, fmt.Errorf("migration %d is applied but not found in this binary", v)
+		}
+
+		err := m.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, "DELETE FROM "+schemaMigrationsTable+" WHERE version = ?", mig.Version)
+			return err
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("failed to revert migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		reverted = append(reverted, mig)
+	}
+
+	return reverted, nil
+}
+
+// Status はすべてのマイグレーションの適用状況をバージョン順に返します。
+// 引数: コンテキスト
+// 返り値: 適用状況の一覧, 取得に失敗した場合はエラー
+// レシーバー: マイグレーターオブジェクト
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		s := MigrationStatus{Migration: mig}
+		if at, ok := applied[mig.Version]; ok {
+			at := at
+			s.AppliedAt = &at
+		}
+		statuses = append(statuses, s)
+	}
+
+	return statuses, nil
+}
+
+// Pending は未適用のマイグレーションをバージョン順に返します。
+// 引数: コンテキスト
+// 返り値: 未適用のマイグレーション, 取得に失敗した場合はエラー
+// レシーバー: マイグレーターオブジェクト
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]Migration, 0)
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			pending = append(pending, mig)
+		}
+	}
+
+	return pending, nil
+}
+
+// CheckUpToDate はスキーマが最新かを確認します。起動時のチェックに使用します。
+// 引数: コンテキスト
+// 返り値: 未適用のマイグレーションがある場合は ErrSchemaOutdated, 確認に失敗した場合はエラー
+// レシーバー: マイグレーターオブジェクト
+func (m *Migrator) CheckUpToDate(ctx context.Context) error {
+
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(pending))
+	for _, mig := range pending {
+		names = append(names, fmt.Sprintf("%04d_%s", mig.Version, mig.Name))
+	}
+
+	return fmt.Errorf("%w: pending migrations: %s", ErrSchemaOutdated, strings.Join(names, ", "))
+}
+
+// appliedVersions は適用済みのバージョンと適用日時を返します。
+// schema_migrations テーブルが無い場合は作成します。
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]time.Time, error) {
+
+	if _, err := m.db.ExecContext(ctx,
+		"CREATE TABLE IF NOT EXISTS "+schemaMigrationsTable+" (version integer PRIMARY KEY, name text NOT NULL, applied_at text NOT NULL)"); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", schemaMigrationsTable, err)
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM "+schemaMigrationsTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", schemaMigrationsTable, err)
+	}
+	defer rows.Close()
+
+	applied := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", schemaMigrationsTable, err)
+		}
+		at, _ := time.Parse(time.RFC3339, appliedAt)
+		applied[version] = at
+	}
+
+	return applied, rows.Err()
+}
+
+// find はバージョンに一致するマイグレーションを返します。
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig, true
+		}
+	}
+	return Migration{}, false
+}
+
+// inTx は fn をトランザクション内で実行し、エラーが無ければ確定します。
+func (m *Migrator) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateMigration は次のバージョン番号で空の up / down ファイルを作成します。
+// 名前は小文字の英数字とアンダースコアに正規化します（例: "Add Users Index" → add_users_index）。
+// 引数: マイグレーションファイルの配置先, マイグレーション名
+// 返り値: 作成した up / down ファイルのパス, 名前が空・ファイル作成に失敗した場合はエラー
+func CreateMigration(dir string, name string) (string, string, error) {
+
+	name = strings.Trim(regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", "", errors.New("migration name is required")
+	}
+
+	existing, err := LoadMigrations(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	var next int64 = 1
+	if len(existing) > 0 {
+		next = existing[len(existing)-1].Version + 1
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", next, name))
+	up, down := base+".up.sql", base+".down.sql"
+
+	if err := os.WriteFile(up, []byte("-- "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte("-- "+name+" の巻き戻し\n"), 0o644); err != nil {
+		_ = os.Remove(up)
+		return "", "", err
+	}
+
+	return up, down, nil
+}
@@ -0,0 +1,310 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// testMigrationFiles はテスト用のマイグレーションファイル（3 バージョン）です。
+func testMigrationFiles() fstest.MapFS {
+	return fstest.MapFS{
+		"0001_create_books.up.sql":     {Data: []byte("CREATE TABLE books (id text PRIMARY KEY, title text);")},
+		"0001_create_books.down.sql":   {Data: []byte("DROP TABLE books;")},
+		"0002_add_books_isbn.up.sql":   {Data: []byte("ALTER TABLE books ADD COLUMN isbn text;")},
+		"0002_add_books_isbn.down.sql": {Data: []byte("ALTER TABLE books DROP COLUMN isbn;")},
+		"0003_create_notes.up.sql":     {Data: []byte("CREATE TABLE notes (id text PRIMARY KEY);")},
+		"0003_create_notes.down.sql":   {Data: []byte("DROP TABLE notes;")},
+		"README.md":                    {Data: []byte("SQL 以外のファイルは読み込まない")},
+	}
+}
+
+// newTestMigrator はインメモリの SQLite に接続し、指定したファイルのマイグレーターを作成します。
+func newTestMigrator(t *testing.T, fsys fstest.MapFS) (*Migrator, *sql.DB) {
+	t.Helper()
+
+	// テストごとに別のデータベースとし、接続間で共有するため名前付きの共有キャッシュにする
+	sqlDB, err := sql.Open("sqlite3", "file:"+strings.ReplaceAll(t.Name(), "/", "_")+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+
+	return &Migrator{db: sqlDB, migrations: migrations}, sqlDB
+}
+
+// tableExists はテーブルが作成されているかを返します。
+func tableExists(t *testing.T, sqlDB *sql.DB, name string) bool {
+	t.Helper()
+
+	var n int
+	if err := sqlDB.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&n); err != nil {
+		t.Fatalf("failed to query sqlite_master: %v", err)
+	}
+	return n == 1
+}
+
+// versionsOf はマイグレーションのバージョンの一覧を返します。
+func versionsOf(migrations []Migration) []int64 {
+	versions := make([]int64, 0, len(migrations))
+	for _, mig := range migrations {
+		versions = append(versions, mig.Version)
+	}
+	return versions
+}
+
+// TestLoadMigrations はマイグレーションファイルの読み込みと、ファイル構成の検証を確認します。
+func TestLoadMigrations(t *testing.T) {
+	t.Parallel()
+
+	// without はテスト用のファイルから指定したファイルを除き、追加のファイルを加えたものを返します。
+	without := func(names []string, extra fstest.MapFS) fstest.MapFS {
+		fsys := testMigrationFiles()
+		for _, name := range names {
+			delete(fsys, name)
+		}
+		for name, f := range extra {
+			fsys[name] = f
+		}
+		return fsys
+	}
+
+	tests := map[string]struct {
+		fsys         fstest.MapFS
+		wantVersions []int64
+		wantErr      string
+	}{
+		"valid": {
+			fsys:         testMigrationFiles(),
+			wantVersions: []int64{1, 2, 3},
+		},
+		"empty": {
+			fsys:         fstest.MapFS{},
+			wantVersions: []int64{},
+		},
+		"duplicate version": {
+			fsys:    without(nil, fstest.MapFS{"0002_add_books_author.up.sql": {Data: []byte("SELECT 1;")}}),
+			wantErr: "duplicate migration version 2",
+		},
+		"gapped version": {
+			fsys:    without([]string{"0002_add_books_isbn.up.sql", "0002_add_books_isbn.down.sql"}, nil),
+			wantErr: "2 is missing before 3_create_notes",
+		},
+		"not starting from 1": {
+			fsys:    without([]string{"0001_create_books.up.sql", "0001_create_books.down.sql"}, nil),
+			wantErr: "1 is missing before 2_add_books_isbn",
+		},
+		"missing down file": {
+			fsys:    without([]string{"0003_create_notes.down.sql"}, nil),
+			wantErr: "migration 3_create_notes must have both up and down files",
+		},
+		"empty up file": {
+			fsys:    without(nil, fstest.MapFS{"0001_create_books.up.sql": {Data: []byte("  \n")}}),
+			wantErr: "migration 1_create_books must have both up and down files",
+		},
+		"invalid file name": {
+			fsys:    without(nil, fstest.MapFS{"0004_Create-Tags.up.sql": {Data: []byte("SELECT 1;")}}),
+			wantErr: "invalid migration file name: 0004_Create-Tags.up.sql",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			migrations, err := LoadMigrations(tt.fsys)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadMigrations() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadMigrations() error = %v", err)
+			}
+			if got := versionsOf(migrations); !slices.Equal(got, tt.wantVersions) {
+				t.Errorf("versions = %v, want %v", got, tt.wantVersions)
+			}
+		})
+	}
+}
+
+// TestLoadMigrations_Embedded はバイナリに埋め込んだマイグレーションが検証を通ることを確認します。
+func TestLoadMigrations_Embedded(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewMigrator(nil); err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+}
+
+// TestMigrator_UpDownStatus はマイグレーションの適用・巻き戻しと、適用状況・最新かの確認を検証します。
+func TestMigrator_UpDownStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m, sqlDB := newTestMigrator(t, testMigrationFiles())
+
+	// 未適用の状態
+	if err := m.CheckUpToDate(ctx); !errors.Is(err, ErrSchemaOutdated) {
+		t.Fatalf("CheckUpToDate() error = %v, want %v", err, ErrSchemaOutdated)
+	} else if !strings.Contains(err.Error(), "0001_create_books, 0002_add_books_isbn, 0003_create_notes") {
+		t.Errorf("CheckUpToDate() error = %v, want pending migrations listed", err)
+	}
+
+	// すべて適用
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if got := versionsOf(applied); !slices.Equal(got, []int64{1, 2, 3}) {
+		t.Errorf("Up() applied = %v, want [1 2 3]", got)
+	}
+	if err := m.CheckUpToDate(ctx); err != nil {
+		t.Errorf("CheckUpToDate() after Up error = %v", err)
+	}
+	if _, err := sqlDB.Exec("INSERT INTO books (id, title, isbn) VALUES ('b1', 'Go', '9784000000000')"); err != nil {
+		t.Errorf("books must have isbn after Up: %v", err)
+	}
+
+	// 再度適用しても何もしない
+	applied, err = m.Up(ctx)
+	if err != nil || len(applied) != 0 {
+		t.Errorf("second Up() = %v, %v, want nothing applied", versionsOf(applied), err)
+	}
+
+	// 新しいものから 2 つ巻き戻す
+	reverted, err := m.Down(ctx, 2)
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if got := versionsOf(reverted); !slices.Equal(got, []int64{3, 2}) {
+		t.Errorf("Down() reverted = %v, want [3 2]", got)
+	}
+	if tableExists(t, sqlDB, "notes") || !tableExists(t, sqlDB, "books") {
+		t.Error("notes must be dropped and books must remain after Down(2)")
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("Status() = %d entries, want 3", len(statuses))
+	}
+	for i, s := range statuses {
+		if s.Version != int64(i+1) {
+			t.Errorf("Status()[%d].Version = %d, want %d", i, s.Version, i+1)
+		}
+		if wantApplied := s.Version == 1; (s.AppliedAt != nil) != wantApplied {
+			t.Errorf("Status()[%d].AppliedAt = %v, want applied = %v", i, s.AppliedAt, wantApplied)
+		}
+	}
+	if err := m.CheckUpToDate(ctx); !errors.Is(err, ErrSchemaOutdated) {
+		t.Errorf("CheckUpToDate() after Down error = %v, want %v", err, ErrSchemaOutdated)
+	}
+
+	// 適用済みの数より多く指定した場合はすべて巻き戻す
+	reverted, err = m.Down(ctx, 10)
+	if err != nil {
+		t.Fatalf("Down(10) error = %v", err)
+	}
+	if got := versionsOf(reverted); !slices.Equal(got, []int64{1}) {
+		t.Errorf("Down(10) reverted = %v, want [1]", got)
+	}
+	if tableExists(t, sqlDB, "books") {
+		t.Error("books must be dropped after reverting all migrations")
+	}
+
+	if _, err := m.Down(ctx, 0); err == nil {
+		t.Error("Down(0) must fail")
+	}
+}
+
+// TestMigrator_UpRollback は途中で失敗したマイグレーションの変更が残らず、
+// それより前に適用したマイグレーションは確定したままになることを検証します。
+func TestMigrator_UpRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fsys := testMigrationFiles()
+	// 1 文目は成功し、2 文目で失敗する
+	fsys["0003_create_notes.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE notes (id text PRIMARY KEY);\nINSERT INTO missing_table (id) VALUES ('x');")}
+	m, sqlDB := newTestMigrator(t, fsys)
+
+	applied, err := m.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "failed to apply migration 3_create_notes") {
+		t.Fatalf("Up() error = %v, want failure of 3_create_notes", err)
+	}
+	if got := versionsOf(applied); !slices.Equal(got, []int64{1, 2}) {
+		t.Errorf("Up() applied = %v, want [1 2]", got)
+	}
+
+	// 失敗したマイグレーションの 1 文目も取り消される
+	if tableExists(t, sqlDB, "notes") {
+		t.Error("notes must be rolled back")
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		t.Fatalf("Pending() error = %v", err)
+	}
+	if got := versionsOf(pending); !slices.Equal(got, []int64{3}) {
+		t.Errorf("Pending() = %v, want [3]", got)
+	}
+}
+
+// TestCreateMigration は次のバージョン番号で up / down ファイルが作成され、名前が正規化されることを検証します。
+func TestCreateMigration(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		wantUp   string
+		wantDown string
+		wantErr  bool
+	}{
+		{name: "Create Users", wantUp: "0001_create_users.up.sql", wantDown: "0001_create_users.down.sql"},
+		{name: "add-users_email INDEX!", wantUp: "0002_add_users_email_index.up.sql", wantDown: "0002_add_users_email_index.down.sql"},
+		{name: " !? ", wantErr: true},
+	}
+
+	// 前の作成結果を次のバージョン番号の決定に使うため、順に実行する
+	for _, tt := range tests {
+		up, down, err := CreateMigration(dir, tt.name)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("CreateMigration(%q) must fail", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("CreateMigration(%q) error = %v", tt.name, err)
+		}
+		if up != filepath.Join(dir, tt.wantUp) || down != filepath.Join(dir, tt.wantDown) {
+			t.Errorf("CreateMigration(%q) = %s, %s, want %s, %s", tt.name, up, down, tt.wantUp, tt.wantDown)
+		}
+	}
+
+	// 作成したファイルはそのまま読み込める
+	migrations, err := LoadMigrations(os.DirFS(dir))
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+	if got := versionsOf(migrations); !slices.Equal(got, []int64{1, 2}) {
+		t.Errorf("versions = %v, want [1 2]", got)
+	}
+}
@@ -0,0 +1,16 @@
+-- ユーザーテーブル
+-- AutoMigrate で作成済みの既存データベースでもそのまま適用できるよう IF NOT EXISTS を付ける
+CREATE TABLE IF NOT EXISTS users (
+    id text,
+    name text,
+    email text,
+    password text,
+    role text,
+    bio text,
+    skill_level text,
+    years_of_experience integer,
+    delete_flag numeric,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (id)
+);
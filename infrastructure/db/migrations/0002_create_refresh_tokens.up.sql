@@ -0,0 +1,14 @@
+-- リフレッシュトークンテーブル(トークンはハッシュ値のみ保存する)
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_hash text,
+    user_id text,
+    family_id text,
+    expires_at datetime,
+    revoked_at datetime,
+    replaced_by text,
+    created_at datetime,
+    PRIMARY KEY (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
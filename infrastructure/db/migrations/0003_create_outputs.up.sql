@@ -0,0 +1,18 @@
+-- アウトプットテーブル
+CREATE TABLE IF NOT EXISTS outputs (
+    id text,
+    user_id text,
+    title text,
+    description text,
+    url text,
+    type text,
+    status text,
+    event_date datetime,
+    isbn text,
+    status_changed_by text,
+    status_changed_at datetime,
+    delete_flag numeric,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (id)
+);
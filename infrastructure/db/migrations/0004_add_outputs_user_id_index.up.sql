@@ -0,0 +1,2 @@
+-- アウトプット一覧(所有者で絞り込み、作成日時の新しい順)の検索用インデックス
+CREATE INDEX IF NOT EXISTS idx_outputs_user_id_created_at ON outputs (user_id, created_at);
@@ -0,0 +1,2 @@
+-- 採番した ID を空文字に戻すと主キーが重複するため、巻き戻しでは何もしない
+SELECT 1;
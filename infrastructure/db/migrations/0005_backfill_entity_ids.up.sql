@@ -0,0 +1,42 @@
+-- ID が空のまま保存されたユーザー・アウトプットに UUIDv7 形式の ID を採番する
+-- 以前はエンティティの生成時に ID を設定していなかったため、空文字の主キーで保存された行が残っている
+--
+-- UUIDv7 の先頭 48 ビットには created_at（ミリ秒）を使用し、既存の行も作成順に並ぶようにする
+-- 残りは SQLite の randomblob / random で埋める（バージョン 7・バリアント 10xx）
+
+-- ユーザー
+-- 主キーが空文字のユーザーは高々 1 件のため、新しい ID を一時テーブルに採番してから参照元と合わせて置き換える
+CREATE TABLE id_backfill_users (new_id text);
+
+INSERT INTO id_backfill_users (new_id)
+SELECT
+    substr(ts, 1, 8) || '-' || substr(ts, 9, 4) || '-7' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+    substr('89ab', 1 + (abs(random()) % 4), 1) || substr(lower(hex(randomblob(2))), 2) || '-' || lower(hex(randomblob(6)))
+FROM (
+    SELECT printf('%012x', CAST((julianday(coalesce(created_at, 'now')) - 2440587.5) * 86400000 AS INTEGER)) AS ts
+    FROM users
+    WHERE id = ''
+);
+
+UPDATE outputs SET user_id = (SELECT new_id FROM id_backfill_users)
+WHERE user_id = '' AND EXISTS (SELECT 1 FROM id_backfill_users);
+
+UPDATE outputs SET status_changed_by = (SELECT new_id FROM id_backfill_users)
+WHERE status_changed_by = '' AND status_changed_at IS NOT NULL AND EXISTS (SELECT 1 FROM id_backfill_users);
+
+UPDATE refresh_tokens SET user_id = (SELECT new_id FROM id_backfill_users)
+WHERE user_id = '' AND EXISTS (SELECT 1 FROM id_backfill_users);
+
+UPDATE users SET id = (SELECT new_id FROM id_backfill_users)
+WHERE id = '' AND EXISTS (SELECT 1 FROM id_backfill_users);
+
+DROP TABLE id_backfill_users;
+
+-- アウトプット(他のテーブルから参照されないため、そのまま置き換える)
+UPDATE outputs SET id = (
+    SELECT
+        substr(ts, 1, 8) || '-' || substr(ts, 9, 4) || '-7' || substr(lower(hex(randomblob(2))), 2) || '-' ||
+        substr('89ab', 1 + (abs(random()) % 4), 1) || substr(lower(hex(randomblob(2))), 2) || '-' || lower(hex(randomblob(6)))
+    FROM (SELECT printf('%012x', CAST((julianday(coalesce(outputs.created_at, 'now')) - 2440587.5) * 86400000 AS INTEGER)) AS ts)
+)
+WHERE id = '';
@@ -0,0 +1,5 @@
+-- 有効な（論理削除されていない）ユーザーのメールアドレスの一意制約
+-- 同時に登録された場合もアプリケーションの重複チェックをすり抜けないよう、データベースでも保証する
+-- 論理削除済みのユーザーと同じメールアドレスでは再登録できるよう、部分インデックスにする
+-- 既に重複している有効なユーザーがいる場合は適用に失敗するため、先にどちらかを論理削除しておく
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_active ON users (email) WHERE delete_flag = 0;
@@ -0,0 +1,12 @@
+-- パスワード再設定トークンテーブル(トークンはハッシュ値のみ保存する)
+-- 使用済みのトークンは used_at を記録し、再度は使えないようにする
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    token_hash text,
+    user_id text,
+    expires_at datetime,
+    used_at datetime,
+    created_at datetime,
+    PRIMARY KEY (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
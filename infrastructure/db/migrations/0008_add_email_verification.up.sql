@@ -0,0 +1,20 @@
+-- メールアドレスの確認日時(未確認の場合は NULL)
+-- 未確認のユーザーはゲストと同じ権限でのみ操作できる
+ALTER TABLE users ADD COLUMN email_verified_at datetime;
+
+-- 確認の仕組みを導入する前に登録されたユーザーは、確認済みとして扱う(権限を変えない)
+UPDATE users SET email_verified_at = coalesce(created_at, CURRENT_TIMESTAMP) WHERE email_verified_at IS NULL;
+
+-- メールアドレス確認トークンテーブル(トークンはハッシュ値のみ保存する)
+-- 発行時のメールアドレスを記録し、その後にメールアドレスが変更された場合は確認に使えないようにする
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    token_hash text,
+    user_id text,
+    email text,
+    expires_at datetime,
+    used_at datetime,
+    created_at datetime,
+    PRIMARY KEY (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON email_verification_tokens (user_id);
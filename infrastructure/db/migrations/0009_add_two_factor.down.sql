@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_two_factor_challenges_user_id;
+DROP TABLE IF EXISTS two_factor_challenges;
+DROP INDEX IF EXISTS idx_recovery_codes_user_id;
+DROP TABLE IF EXISTS recovery_codes;
+DROP TABLE IF EXISTS totp_credentials;
+ALTER TABLE users DROP COLUMN two_factor_enabled_at;
@@ -0,0 +1,40 @@
+-- 2 段階認証(TOTP)を有効にした日時(無効の場合は NULL)
+-- 管理者・ルート権限のユーザーは、有効にするまでメンバーと同じ権限でのみ操作できる
+ALTER TABLE users ADD COLUMN two_factor_enabled_at datetime;
+
+-- TOTP シークレットテーブル(シークレットは暗号化した値のみ保存する)
+-- last_used_step に最後に使用したコードの時間ステップを記録し、同じコードの再利用を防ぐ
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    user_id text,
+    secret_ciphertext text,
+    confirmed_at datetime,
+    last_used_step integer DEFAULT 0,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (user_id)
+);
+
+-- リカバリーコードテーブル(コードはハッシュ値のみ保存する)
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    code_hash text,
+    user_id text,
+    used_at datetime,
+    created_at datetime,
+    PRIMARY KEY (code_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_recovery_codes_user_id ON recovery_codes (user_id);
+
+-- 2 段階認証のチャレンジテーブル(トークンはハッシュ値のみ保存する)
+-- attempts に誤ったコードの入力回数を記録し、上限に達したチャレンジは使えないようにする
+CREATE TABLE IF NOT EXISTS two_factor_challenges (
+    token_hash text,
+    user_id text,
+    expires_at datetime,
+    used_at datetime,
+    attempts integer DEFAULT 0,
+    created_at datetime,
+    PRIMARY KEY (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_two_factor_challenges_user_id ON two_factor_challenges (user_id);
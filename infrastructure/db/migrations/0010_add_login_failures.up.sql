@@ -0,0 +1,4 @@
+-- 連続したログインの失敗回数と、最後に失敗した日時(パスワード・2 段階目のコードの誤りを数える)
+-- 失敗回数が上限に達したユーザーは、最後の失敗から一定期間ログインできないようにする
+ALTER TABLE users ADD COLUMN failed_login_count integer DEFAULT 0;
+ALTER TABLE users ADD COLUMN last_failed_login_at datetime;
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+)
+
+// seedSQL は開発用のシードデータです。
+//
+//go:embed seeds/seed.sql
+var seedSQL string
+
+// ErrSeedNotAllowed は Turso / libsql のデータベースにシードデータを投入しようとしたことを表します。
+var ErrSeedNotAllowed = errors.New("seeding is only allowed for local sqlite databases")
+
+// Seed は開発用のシードデータを投入します。
+// 本番のデータベースを誤って汚さないよう、ローカルの SQLite（ファイル / インメモリ）でのみ実行できます。
+// 既に同じ ID のデータがある場合はスキップするため、何度実行しても結果は変わりません。
+// 引数: コンテキスト, データベース接続, 接続先の種類
+// 返り値: libsql の場合は ErrSeedNotAllowed, 投入に失敗した場合はエラー
+func Seed(ctx context.Context, db *sql.DB, driver Driver) error {
+
+	if driver == DriverLibSQL {
+		return ErrSeedNotAllowed
+	}
+
+	if _, err := db.ExecContext(ctx, seedSQL); err != nil {
+		return fmt.Errorf("failed to seed database: %w", err)
+	}
+
+	return nil
+}
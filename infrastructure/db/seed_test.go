@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/infrastructure/config"
+)
+
+// TestSeed は、シードデータを 2 回投入しても重複せず、libsql には投入できないことを検証します。
+func TestSeed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sqlDB, driver, err := OpenDatabase(config.DatabaseConfig{URL: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	m, err := NewMigrator(sqlDB)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	// counts はシードデータを投入するテーブルの件数を返します。
+	counts := func() map[string]int {
+		got := map[string]int{}
+		for _, table := range []string{"users", "outputs"} {
+			var n int
+			if err := sqlDB.QueryRowContext(ctx, "SELECT count(*) FROM "+table).Scan(&n); err != nil {
+				t.Fatalf("failed to count %s: %v", table, err)
+			}
+			got[table] = n
+		}
+		return got
+	}
+
+	if err := Seed(ctx, sqlDB, driver); err != nil {
+		t.Fatalf("first Seed() error = %v", err)
+	}
+	first := counts()
+	if first["users"] == 0 || first["outputs"] == 0 {
+		t.Fatalf("counts after first Seed() = %v, want seeded rows", first)
+	}
+
+	if err := Seed(ctx, sqlDB, driver); err != nil {
+		t.Fatalf("second Seed() error = %v", err)
+	}
+	if second := counts(); second["users"] != first["users"] || second["outputs"] != first["outputs"] {
+		t.Errorf("counts after second Seed() = %v, want %v", second, first)
+	}
+
+	if err := Seed(ctx, sqlDB, DriverLibSQL); !errors.Is(err, ErrSeedNotAllowed) {
+		t.Errorf("Seed(libsql) error = %v, want %v", err, ErrSeedNotAllowed)
+	}
+}
@@ -0,0 +1,16 @@
+-- 開発用のシードデータ
+-- パスワードはすべて Password1（bcrypt でハッシュ化済み）、メールアドレスはすべて確認済み
+-- root / admin は 2 段階認証を設定していないため、POST /auth/two-factor/totp で設定するまではメンバーと同じ権限になる
+-- 何度投入しても重複しないよう INSERT OR IGNORE で主キーの衝突を無視する
+
+INSERT OR IGNORE INTO users (id, name, email, password, role, bio, skill_level, years_of_experience, delete_flag, created_at, updated_at, email_verified_at) VALUES
+    ('seed-user-root', 'Root', 'root@example.com', '$2a$10$vCvTcVBIh7QTtyEFOsIyBezfjgLABJwiQsVGXP1QeQq2wXh6C4xl6', 'root', 'システム管理者です。', 'expert', 10, 0, '2025-01-01 09:00:00+09:00', '2025-01-01 09:00:00+09:00', '2025-01-01 09:00:00+09:00'),
+    ('seed-user-admin', 'Admin', 'admin@example.com', '$2a$10$vCvTcVBIh7QTtyEFOsIyBezfjgLABJwiQsVGXP1QeQq2wXh6C4xl6', 'admin', 'アウトプットのレビューを担当しています。', 'advanced', 5, 0, '2025-01-01 09:00:00+09:00', '2025-01-01 09:00:00+09:00', '2025-01-01 09:00:00+09:00'),
+    ('seed-user-alice', 'Alice', 'alice@example.com', '$2a$10$vCvTcVBIh7QTtyEFOsIyBezfjgLABJwiQsVGXP1QeQq2wXh6C4xl6', 'member', 'Go を勉強中です。', 'beginner', 1, 0, '2025-01-02 09:00:00+09:00', '2025-01-02 09:00:00+09:00', '2025-01-02 09:00:00+09:00'),
+    ('seed-user-bob', 'Bob', 'bob@example.com', '$2a$10$vCvTcVBIh7QTtyEFOsIyBezfjgLABJwiQsVGXP1QeQq2wXh6C4xl6', 'guest', '', 'beginner', 0, 0, '2025-01-03 09:00:00+09:00', '2025-01-03 09:00:00+09:00', '2025-01-03 09:00:00+09:00');
+
+INSERT OR IGNORE INTO outputs (id, user_id, title, description, url, type, status, event_date, isbn, status_changed_by, status_changed_at, delete_flag, created_at, updated_at) VALUES
+    ('seed-output-1', 'seed-user-alice', 'Go の context 入門', 'context.Context の使い方をまとめました。', 'https://example.com/posts/go-context', 'blog', 'published', NULL, '', 'seed-user-admin', '2025-01-05 09:00:00+09:00', 0, '2025-01-04 09:00:00+09:00', '2025-01-05 09:00:00+09:00'),
+    ('seed-output-2', 'seed-user-alice', 'echo で作る REST API', 'サンプルリポジトリです。', 'https://github.com/example/echo-sample', 'repository', 'in_review', NULL, '', 'seed-user-alice', '2025-01-06 09:00:00+09:00', 0, '2025-01-06 09:00:00+09:00', '2025-01-06 09:00:00+09:00'),
+    ('seed-output-3', 'seed-user-alice', 'プログラミング言語Go 読書メモ', '第 1 章〜第 3 章', '', 'book_note', 'draft', NULL, '9784621300251', '', NULL, 0, '2025-01-07 09:00:00+09:00', '2025-01-07 09:00:00+09:00'),
+    ('seed-output-4', 'seed-user-admin', 'Go Conference 登壇', 'テスト設計についての発表です。', 'https://example.com/slides/go-test', 'talk', 'published', '2025-06-01 00:00:00+00:00', '', 'seed-user-admin', '2025-06-02 09:00:00+09:00', 0, '2025-05-20 09:00:00+09:00', '2025-06-02 09:00:00+09:00');
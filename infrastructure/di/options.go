@@ -0,0 +1,55 @@
+package di
+
+import (
+	"app/infrastructure/config"
+	authUsecase "app/internal/application/usecase/auth"
+)
+
+// パスワード再設定の設定コンストラクタ
+// 設定 security.password_reset からユースケースに渡す設定を組み立てます。
+// 引数: 認証まわりの設定
+// 返り値: パスワード再設定の設定
+func NewPasswordResetOptions(cfg config.SecurityConfig) authUsecase.PasswordResetOptions {
+	return authUsecase.PasswordResetOptions{
+		TokenTTL:       cfg.PasswordReset.TokenTTL,
+		URL:            cfg.PasswordReset.URL,
+		ResendInterval: cfg.PasswordReset.ResendInterval,
+		ResendLimit:    cfg.PasswordReset.ResendLimit,
+	}
+}
+
+// メールアドレス確認の設定コンストラクタ
+// 設定 security.email_verification からユースケースに渡す設定を組み立てます。
+// 引数: 認証まわりの設定
+// 返り値: メールアドレス確認の設定
+func NewEmailVerificationOptions(cfg config.SecurityConfig) authUsecase.EmailVerificationOptions {
+	return authUsecase.EmailVerificationOptions{
+		TokenTTL:       cfg.EmailVerification.TokenTTL,
+		URL:            cfg.EmailVerification.URL,
+		ResendInterval: cfg.EmailVerification.ResendInterval,
+		ResendLimit:    cfg.EmailVerification.ResendLimit,
+	}
+}
+
+// 2 段階認証の設定コンストラクタ
+// 設定 security.two_factor からユースケースに渡す設定を組み立てます。
+// 引数: 認証まわりの設定
+// 返り値: 2 段階認証の設定
+func NewTwoFactorOptions(cfg config.SecurityConfig) authUsecase.TwoFactorOptions {
+	return authUsecase.TwoFactorOptions{
+		ChallengeTTL:  cfg.TwoFactor.ChallengeTTL,
+		MaxAttempts:   cfg.TwoFactor.MaxAttempts,
+		RecoveryCodes: cfg.TwoFactor.RecoveryCodes,
+	}
+}
+
+// ログインのロックアウトの設定コンストラクタ
+// 設定 security.login_lockout からユースケースに渡す設定を組み立てます。
+// 引数: 認証まわりの設定
+// 返り値: ログインのロックアウトの設定
+func NewLoginLockoutOptions(cfg config.SecurityConfig) authUsecase.LoginLockoutOptions {
+	return authUsecase.LoginLockoutOptions{
+		MaxFailures: cfg.LoginLockout.MaxFailures,
+		Duration:    cfg.LoginLockout.Duration,
+	}
+}
@@ -4,27 +4,124 @@
 package di
 
 import (
+	"app/infrastructure/config"
 	"app/infrastructure/db"
+	"app/infrastructure/health"
+	"app/infrastructure/idgen"
+	"app/infrastructure/lifecycle"
+	"app/infrastructure/logger"
+	"app/infrastructure/mail"
+	"app/infrastructure/metrics"
 	"app/infrastructure/repository"
 	"app/infrastructure/security"
+	"app/infrastructure/tracing"
 	"app/internal/application/port"
+	authUsecase "app/internal/application/usecase/auth"
+	outputUsecase "app/internal/application/usecase/output"
 	usecase "app/internal/application/usecase/user"
 
 	"github.com/google/wire"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type App struct {
-	CreateUserUseCase *usecase.CreateUserUsecase
+	Config                        *config.Config
+	Logger                        *logger.SlogLogger
+	Lifecycle                     *lifecycle.Lifecycle
+	HealthChecker                 port.HealthChecker
+	Metrics                       *metrics.Metrics
+	TracerProvider                *sdktrace.TracerProvider
+	CreateUserUseCase             *usecase.CreateUserUsecase
+	GetUserUseCase                *usecase.GetUserUsecase
+	ListUsersUseCase              *usecase.ListUsersUsecase
+	UpdateUserUseCase             *usecase.UpdateUserUsecase
+	DeleteUserUseCase             *usecase.DeleteUserUsecase
+	LoginUseCase                  *authUsecase.LoginUsecase
+	VerifyTwoFactorUseCase        *authUsecase.VerifyTwoFactorUsecase
+	RefreshTokenUseCase           *authUsecase.RefreshTokenUsecase
+	LogoutUseCase                 *authUsecase.LogoutUsecase
+	ForgotPasswordUseCase         *authUsecase.ForgotPasswordUsecase
+	ResetPasswordUseCase          *authUsecase.ResetPasswordUsecase
+	VerifyEmailUseCase            *authUsecase.VerifyEmailUsecase
+	ResendVerificationUseCase     *authUsecase.ResendVerificationUsecase
+	EnrollTOTPUseCase             *authUsecase.EnrollTOTPUsecase
+	ConfirmTOTPUseCase            *authUsecase.ConfirmTOTPUsecase
+	CreateOutputUseCase           *outputUsecase.CreateOutputUsecase
+	GetOutputUseCase              *outputUsecase.GetOutputUsecase
+	ListOutputsUseCase            *outputUsecase.ListOutputsUsecase
+	UpdateOutputUseCase           *outputUsecase.UpdateOutputUsecase
+	DeleteOutputUseCase           *outputUsecase.DeleteOutputUsecase
+	TransitionOutputStatusUseCase *outputUsecase.TransitionOutputStatusUsecase
+	ListOutputTypesUseCase        *outputUsecase.ListOutputTypesUsecase
+	TokenIssuer                   port.TokenIssuer
 }
 
-func InitializeApp() *App {
+func InitializeApp(cfg *config.Config) (*App, error) {
 	wire.Build(
+		wire.FieldsOf(new(*config.Config), "Database", "Security", "Mail", "Log", "Tracing"),
+		logger.NewSlogLogger,
+		wire.Bind(new(port.Logger), new(*logger.SlogLogger)),
+		lifecycle.New,
+		metrics.NewMetrics,
+		tracing.NewTracerProvider,
+		health.NewRegistry,
+		wire.Bind(new(port.HealthChecker), new(*health.Registry)),
 		db.NewConnection,
-		security.NewBcryptPasswordHasher,
-		wire.Bind(new(port.PasswordHasher), new(*security.BcryptPasswordHasher)),
+		security.NewPasswordHasher,
+		wire.Bind(new(port.PasswordHasher), new(*security.PasswordHasher)),
+		security.NewPasswordPolicy,
+		NewPasswordResetOptions,
+		NewEmailVerificationOptions,
+		NewTwoFactorOptions,
+		NewLoginLockoutOptions,
+		security.NewTOTPAuthenticator,
+		wire.Bind(new(port.TOTP), new(*security.TOTPAuthenticator)),
+		security.NewAESSecretCipher,
+		wire.Bind(new(port.SecretCipher), new(*security.AESSecretCipher)),
+		security.NewRandomRecoveryCodeGenerator,
+		wire.Bind(new(port.RecoveryCodeGenerator), new(*security.RandomRecoveryCodeGenerator)),
+		security.NewJWTTokenIssuer,
+		wire.Bind(new(port.TokenIssuer), new(*security.JWTTokenIssuer)),
+		mail.NewMailSender,
+		idgen.NewUUIDv7Generator,
+		wire.Bind(new(port.IDGenerator), new(*idgen.UUIDv7Generator)),
+		security.NewRandomTokenGenerator,
+		wire.Bind(new(port.SecureTokenGenerator), new(*security.RandomTokenGenerator)),
 		repository.NewUserRepository,
+		repository.NewRefreshTokenRepository,
+		repository.NewPasswordResetTokenRepository,
+		repository.NewEmailVerificationTokenRepository,
+		repository.NewTOTPCredentialRepository,
+		repository.NewRecoveryCodeRepository,
+		repository.NewTwoFactorChallengeRepository,
+		repository.NewOutputRepository,
+		repository.NewTransactionManager,
+		wire.Bind(new(port.TransactionManager), new(*repository.GormTransactionManager)),
 		usecase.NewCreateUserUsecase,
+		usecase.NewGetUserUsecase,
+		usecase.NewListUsersUsecase,
+		usecase.NewUpdateUserUsecase,
+		usecase.NewDeleteUserUsecase,
+		authUsecase.NewLoginUsecase,
+		authUsecase.NewVerifyTwoFactorUsecase,
+		authUsecase.NewRefreshTokenUsecase,
+		authUsecase.NewLogoutUsecase,
+		authUsecase.NewForgotPasswordUsecase,
+		authUsecase.NewResetPasswordUsecase,
+		authUsecase.NewEmailVerificationIssuer,
+		wire.Bind(new(port.EmailVerificationSender), new(*authUsecase.EmailVerificationIssuer)),
+		authUsecase.NewVerifyEmailUsecase,
+		authUsecase.NewResendVerificationUsecase,
+		authUsecase.NewEnrollTOTPUsecase,
+		authUsecase.NewConfirmTOTPUsecase,
+		outputUsecase.NewCreateOutputUsecase,
+		outputUsecase.NewGetOutputUsecase,
+		outputUsecase.NewListOutputsUsecase,
+		outputUsecase.NewUpdateOutputUsecase,
+		outputUsecase.NewDeleteOutputUsecase,
+		outputUsecase.NewTransitionOutputStatusUsecase,
+		outputUsecase.NewListOutputTypesUsecase,
 		wire.Struct(new(App), "*"),
 	)
-	return nil
+	return nil, nil
 }
@@ -7,27 +7,168 @@
 package di
 
 import (
+	"app/infrastructure/config"
 	"app/infrastructure/db"
+	"app/infrastructure/health"
+	"app/infrastructure/idgen"
+	"app/infrastructure/lifecycle"
+	"app/infrastructure/logger"
+	"app/infrastructure/mail"
+	"app/infrastructure/metrics"
 	"app/infrastructure/repository"
 	"app/infrastructure/security"
+	"app/infrastructure/tracing"
+	"app/internal/application/port"
+	"app/internal/application/usecase/auth"
+	"app/internal/application/usecase/output"
 	"app/internal/application/usecase/user"
+	"go.opentelemetry.io/otel/sdk/trace"
 )
 
 // Injectors from wire.go:
 
-func InitializeApp() *App {
-	gormDB := db.NewConnection()
+func InitializeApp(cfg *config.Config) (*App, error) {
+	logConfig := cfg.Log
+	slogLogger, err := logger.NewSlogLogger(logConfig)
+	if err != nil {
+		return nil, err
+	}
+	lifecycleLifecycle := lifecycle.New(slogLogger)
+	registry := health.NewRegistry()
+	metricsMetrics := metrics.NewMetrics()
+	tracingConfig := cfg.Tracing
+	tracerProvider, err := tracing.NewTracerProvider(tracingConfig, lifecycleLifecycle)
+	if err != nil {
+		return nil, err
+	}
+	databaseConfig := cfg.Database
+	gormDB, err := db.NewConnection(databaseConfig, slogLogger, lifecycleLifecycle, registry, metricsMetrics)
+	if err != nil {
+		return nil, err
+	}
 	userRepository := repository.NewUserRepository(gormDB)
-	bcryptPasswordHasher := security.NewBcryptPasswordHasher()
-	createUserUsecase := user.NewCreateUserUsecase(userRepository, bcryptPasswordHasher)
+	securityConfig := cfg.Security
+	passwordHasher := security.NewPasswordHasher(securityConfig, metricsMetrics)
+	uuiDv7Generator := idgen.NewUUIDv7Generator()
+	gormTransactionManager := repository.NewTransactionManager(gormDB)
+	passwordPolicy, err := security.NewPasswordPolicy(securityConfig)
+	if err != nil {
+		return nil, err
+	}
+	emailVerificationTokenRepository := repository.NewEmailVerificationTokenRepository(gormDB)
+	randomTokenGenerator := security.NewRandomTokenGenerator()
+	mailConfig := cfg.Mail
+	mailSender, err := mail.NewMailSender(mailConfig, slogLogger)
+	if err != nil {
+		return nil, err
+	}
+	emailVerificationOptions := NewEmailVerificationOptions(securityConfig)
+	emailVerificationIssuer := auth.NewEmailVerificationIssuer(emailVerificationTokenRepository, randomTokenGenerator, mailSender, gormTransactionManager, slogLogger, emailVerificationOptions)
+	createUserUsecase := user.NewCreateUserUsecase(userRepository, passwordHasher, uuiDv7Generator, gormTransactionManager, passwordPolicy, emailVerificationIssuer, slogLogger)
+	getUserUsecase := user.NewGetUserUsecase(userRepository)
+	listUsersUsecase := user.NewListUsersUsecase(userRepository)
+	updateUserUsecase := user.NewUpdateUserUsecase(userRepository, emailVerificationIssuer, slogLogger)
+	deleteUserUsecase := user.NewDeleteUserUsecase(userRepository)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(gormDB)
+	twoFactorChallengeRepository := repository.NewTwoFactorChallengeRepository(gormDB)
+	jwtTokenIssuer, err := security.NewJWTTokenIssuer(securityConfig)
+	if err != nil {
+		return nil, err
+	}
+	twoFactorOptions := NewTwoFactorOptions(securityConfig)
+	loginLockoutOptions := NewLoginLockoutOptions(securityConfig)
+	loginUsecase := auth.NewLoginUsecase(userRepository, refreshTokenRepository, twoFactorChallengeRepository, passwordHasher, jwtTokenIssuer, randomTokenGenerator, slogLogger, twoFactorOptions, loginLockoutOptions)
+	totpCredentialRepository := repository.NewTOTPCredentialRepository(gormDB)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(gormDB)
+	totpAuthenticator := security.NewTOTPAuthenticator(securityConfig)
+	aesSecretCipher, err := security.NewAESSecretCipher(securityConfig)
+	if err != nil {
+		return nil, err
+	}
+	randomRecoveryCodeGenerator := security.NewRandomRecoveryCodeGenerator()
+	verifyTwoFactorUsecase := auth.NewVerifyTwoFactorUsecase(userRepository, refreshTokenRepository, twoFactorChallengeRepository, totpCredentialRepository, recoveryCodeRepository, totpAuthenticator, aesSecretCipher, randomRecoveryCodeGenerator, jwtTokenIssuer, randomTokenGenerator, gormTransactionManager, slogLogger, twoFactorOptions, loginLockoutOptions)
+	refreshTokenUsecase := auth.NewRefreshTokenUsecase(userRepository, refreshTokenRepository, jwtTokenIssuer, randomTokenGenerator, gormTransactionManager, slogLogger)
+	logoutUsecase := auth.NewLogoutUsecase(refreshTokenRepository, randomTokenGenerator)
+	passwordResetTokenRepository := repository.NewPasswordResetTokenRepository(gormDB)
+	passwordResetOptions := NewPasswordResetOptions(securityConfig)
+	forgotPasswordUsecase := auth.NewForgotPasswordUsecase(userRepository, passwordResetTokenRepository, randomTokenGenerator, mailSender, gormTransactionManager, slogLogger, passwordResetOptions)
+	resetPasswordUsecase := auth.NewResetPasswordUsecase(userRepository, passwordResetTokenRepository, refreshTokenRepository, passwordHasher, randomTokenGenerator, gormTransactionManager, passwordPolicy, slogLogger)
+	verifyEmailUsecase := auth.NewVerifyEmailUsecase(userRepository, emailVerificationTokenRepository, randomTokenGenerator, gormTransactionManager, slogLogger)
+	resendVerificationUsecase := auth.NewResendVerificationUsecase(userRepository, emailVerificationTokenRepository, emailVerificationIssuer, emailVerificationOptions)
+	enrollTOTPUsecase := auth.NewEnrollTOTPUsecase(userRepository, totpCredentialRepository, totpAuthenticator, aesSecretCipher)
+	confirmTOTPUsecase := auth.NewConfirmTOTPUsecase(userRepository, totpCredentialRepository, recoveryCodeRepository, refreshTokenRepository, totpAuthenticator, aesSecretCipher, randomRecoveryCodeGenerator, gormTransactionManager, slogLogger, twoFactorOptions)
+	outputRepository := repository.NewOutputRepository(gormDB)
+	createOutputUsecase := output.NewCreateOutputUsecase(outputRepository, uuiDv7Generator)
+	getOutputUsecase := output.NewGetOutputUsecase(outputRepository)
+	listOutputsUsecase := output.NewListOutputsUsecase(outputRepository)
+	updateOutputUsecase := output.NewUpdateOutputUsecase(outputRepository)
+	deleteOutputUsecase := output.NewDeleteOutputUsecase(outputRepository)
+	transitionOutputStatusUsecase := output.NewTransitionOutputStatusUsecase(outputRepository, slogLogger)
+	listOutputTypesUsecase := output.NewListOutputTypesUsecase()
 	app := &App{
-		CreateUserUseCase: createUserUsecase,
+		Config:                        cfg,
+		Logger:                        slogLogger,
+		Lifecycle:                     lifecycleLifecycle,
+		HealthChecker:                 registry,
+		Metrics:                       metricsMetrics,
+		TracerProvider:                tracerProvider,
+		CreateUserUseCase:             createUserUsecase,
+		GetUserUseCase:                getUserUsecase,
+		ListUsersUseCase:              listUsersUsecase,
+		UpdateUserUseCase:             updateUserUsecase,
+		DeleteUserUseCase:             deleteUserUsecase,
+		LoginUseCase:                  loginUsecase,
+		VerifyTwoFactorUseCase:        verifyTwoFactorUsecase,
+		RefreshTokenUseCase:           refreshTokenUsecase,
+		LogoutUseCase:                 logoutUsecase,
+		ForgotPasswordUseCase:         forgotPasswordUsecase,
+		ResetPasswordUseCase:          resetPasswordUsecase,
+		VerifyEmailUseCase:            verifyEmailUsecase,
+		ResendVerificationUseCase:     resendVerificationUsecase,
+		EnrollTOTPUseCase:             enrollTOTPUsecase,
+		ConfirmTOTPUseCase:            confirmTOTPUsecase,
+		CreateOutputUseCase:           createOutputUsecase,
+		GetOutputUseCase:              getOutputUsecase,
+		ListOutputsUseCase:            listOutputsUsecase,
+		UpdateOutputUseCase:           updateOutputUsecase,
+		DeleteOutputUseCase:           deleteOutputUsecase,
+		TransitionOutputStatusUseCase: transitionOutputStatusUsecase,
+		ListOutputTypesUseCase:        listOutputTypesUsecase,
+		TokenIssuer:                   jwtTokenIssuer,
 	}
-	return app
+	return app, nil
 }
 
 // wire.go:
 
 type App struct {
-	CreateUserUseCase *user.CreateUserUsecase
+	Config                        *config.Config
+	Logger                        *logger.SlogLogger
+	Lifecycle                     *lifecycle.Lifecycle
+	HealthChecker                 port.HealthChecker
+	Metrics                       *metrics.Metrics
+	TracerProvider                *trace.TracerProvider
+	CreateUserUseCase             *user.CreateUserUsecase
+	GetUserUseCase                *user.GetUserUsecase
+	ListUsersUseCase              *user.ListUsersUsecase
+	UpdateUserUseCase             *user.UpdateUserUsecase
+	DeleteUserUseCase             *user.DeleteUserUsecase
+	LoginUseCase                  *auth.LoginUsecase
+	VerifyTwoFactorUseCase        *auth.VerifyTwoFactorUsecase
+	RefreshTokenUseCase           *auth.RefreshTokenUsecase
+	LogoutUseCase                 *auth.LogoutUsecase
+	ForgotPasswordUseCase         *auth.ForgotPasswordUsecase
+	ResetPasswordUseCase          *auth.ResetPasswordUsecase
+	VerifyEmailUseCase            *auth.VerifyEmailUsecase
+	ResendVerificationUseCase     *auth.ResendVerificationUsecase
+	EnrollTOTPUseCase             *auth.EnrollTOTPUsecase
+	ConfirmTOTPUseCase            *auth.ConfirmTOTPUsecase
+	CreateOutputUseCase           *output.CreateOutputUsecase
+	GetOutputUseCase              *output.GetOutputUsecase
+	ListOutputsUseCase            *output.ListOutputsUsecase
+	UpdateOutputUseCase           *output.UpdateOutputUsecase
+	DeleteOutputUseCase           *output.DeleteOutputUsecase
+	TransitionOutputStatusUseCase *output.TransitionOutputStatusUsecase
+	ListOutputTypesUseCase        *output.ListOutputTypesUsecase
+	TokenIssuer                   port.TokenIssuer
 }
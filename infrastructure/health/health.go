@@ -0,0 +1,78 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"app/internal/application/port"
+)
+
+// checkTimeout は 1 つの確認処理にかける最大時間です。
+// プローブのタイムアウトより短くし、応答しない依存先があっても結果を返せるようにします。
+const checkTimeout = 2 * time.Second
+
+// CheckFunc は依存先が利用可能かを確認する関数です。利用できない場合はエラーを返します。
+type CheckFunc func(ctx context.Context) error
+
+// check は登録された確認処理です。
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Registry は依存先の確認処理を登録し、まとめて実行します。
+// 各モジュールは DI で受け取った Registry に Register で確認処理を追加します。
+type Registry struct {
+	mu     sync.RWMutex
+	checks []check
+}
+
+// ヘルスチェックレジストリコンストラクタ
+// 返り値: ヘルスチェックレジストリオブジェクト
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register は確認処理を登録します。
+// 引数: 確認対象の名前, 確認処理
+// レシーバー: ヘルスチェックレジストリオブジェクト
+func (r *Registry) Register(name string, fn CheckFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, check{name: name, fn: fn})
+}
+
+// Check は登録済みの確認処理を並行して実行し、登録順に結果を返します。
+// 各確認処理は checkTimeout で打ち切ります。
+// 引数: コンテキスト
+// 返り値: 確認結果
+// レシーバー: ヘルスチェックレジストリオブジェクト
+func (r *Registry) Check(ctx context.Context) []port.HealthCheckResult {
+
+	r.mu.RLock()
+	checks := append([]check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	results := make([]port.HealthCheckResult, len(checks))
+
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.fn(ctx)
+			results[i] = port.HealthCheckResult{Name: c.name, Err: err, Duration: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+var _ port.HealthChecker = (*Registry)(nil)
@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	systemdto "app/internal/application/dto/system"
+	"app/internal/application/interface/handler"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TestRegistry_Check は登録した確認処理がすべて実行され、登録順に名前とエラーが返ることを検証します。
+func TestRegistry_Check(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	down := errors.New("connection refused")
+
+	tests := map[string]struct {
+		register  func(r *Registry)
+		wantNames []string
+		wantErrs  []error
+	}{
+		"no checks": {
+			register:  func(r *Registry) {},
+			wantNames: []string{},
+			wantErrs:  []error{},
+		},
+		"results in registration order": {
+			register: func(r *Registry) {
+				// 後に登録したものが先に終わっても、結果は登録順
+				r.Register("database", func(context.Context) error {
+					time.Sleep(20 * time.Millisecond)
+					return nil
+				})
+				r.Register("migrations", func(context.Context) error { return down })
+				r.Register("mail", func(context.Context) error { return nil })
+			},
+			wantNames: []string{"database", "migrations", "mail"},
+			wantErrs:  []error{nil, down, nil},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRegistry()
+			tt.register(r)
+
+			results := r.Check(context.Background())
+			if len(results) != len(tt.wantNames) {
+				t.Fatalf("results = %+v, want %d results", results, len(tt.wantNames))
+			}
+			for i, res := range results {
+				if res.Name != tt.wantNames[i] || !errors.Is(res.Err, tt.wantErrs[i]) || (tt.wantErrs[i] == nil && res.Err != nil) {
+					t.Errorf("results[%d] = %+v, want name %q error %v", i, res, tt.wantNames[i], tt.wantErrs[i])
+				}
+			}
+		})
+	}
+}
+
+// TestRegistry_Check_Concurrent は確認処理が並行して実行され、それぞれに期限付きのコンテキストが渡されることを検証します。
+func TestRegistry_Check_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	// 2 つの確認処理が互いの開始を待つため、順に実行すると期限切れになる
+	aStarted, bStarted := make(chan struct{}), make(chan struct{})
+	waitFor := func(started, other chan struct{}) CheckFunc {
+		return func(ctx context.Context) error {
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > checkTimeout {
+				t.Errorf("deadline = %v, %v, want within %v", deadline, ok, checkTimeout)
+			}
+			close(started)
+			select {
+			case <-other:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	r := NewRegistry()
+	r.Register("a", waitFor(aStarted, bStarted))
+	r.Register("b", waitFor(bStarted, aStarted))
+
+	for _, res := range r.Check(context.Background()) {
+		if res.Err != nil {
+			t.Errorf("check %s error = %v, want checks to run concurrently", res.Name, res.Err)
+		}
+	}
+}
+
+// TestRegistry_Readyz は登録した確認処理が 1 つでも失敗した場合に、/readyz がすべての結果をまとめて 503 を返すことを検証します。
+func TestRegistry_Readyz(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		migrationsErr error
+		mailErr       error
+		wantCode      int
+		wantStatus    string
+		wantChecks    []systemdto.CheckResponse
+	}{
+		"all up": {
+			wantCode:   http.StatusOK,
+			wantStatus: "ok",
+			wantChecks: []systemdto.CheckResponse{{Name: "database", Status: "up"}, {Name: "migrations", Status: "up"}, {Name: "mail", Status: "up"}},
+		},
+		"one down": {
+			migrationsErr: errors.New("pending migrations"),
+			wantCode:      http.StatusServiceUnavailable,
+			wantStatus:    "unavailable",
+			wantChecks:    []systemdto.CheckResponse{{Name: "database", Status: "up"}, {Name: "migrations", Status: "down"}, {Name: "mail", Status: "up"}},
+		},
+		"several down": {
+			migrationsErr: errors.New("pending migrations"),
+			mailErr:       errors.New("smtp unreachable"),
+			wantCode:      http.StatusServiceUnavailable,
+			wantStatus:    "unavailable",
+			wantChecks:    []systemdto.CheckResponse{{Name: "database", Status: "up"}, {Name: "migrations", Status: "down"}, {Name: "mail", Status: "down"}},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewRegistry()
+			r.Register("database", func(context.Context) error { return nil })
+			r.Register("migrations", func(context.Context) error { return tt.migrationsErr })
+			r.Register("mail", func(context.Context) error { return tt.mailErr })
+
+			h := handler.NewSystemHandler(r, testlogger.NewPortLogger(t), systemdto.VersionResponse{})
+			rec := httptest.NewRecorder()
+			if err := h.Readyz(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/readyz", nil), rec)); err != nil {
+				t.Fatalf("Readyz() error = %v", err)
+			}
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			var res systemdto.HealthResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("invalid response body: %v", err)
+			}
+			if res.Status != tt.wantStatus || len(res.Checks) != len(tt.wantChecks) {
+				t.Fatalf("response = %+v, want status %q with %d checks", res, tt.wantStatus, len(tt.wantChecks))
+			}
+			for i, c := range res.Checks {
+				if c.Name != tt.wantChecks[i].Name || c.Status != tt.wantChecks[i].Status {
+					t.Errorf("checks[%d] = %+v, want %+v", i, c, tt.wantChecks[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package idgen
+
+import (
+	"app/internal/application/port"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UUIDv7Generator は UUIDv7（RFC 9562）で ID を採番します。
+//
+// 先頭 48 ビットがミリ秒単位の生成時刻のため、文字列の昇順が生成順になり、
+// 主キーのインデックスへの挿入位置も末尾に集まります。
+// 同じミリ秒内の生成でも、プロセス内では単調増加します。
+type UUIDv7Generator struct{}
+
+// ID 生成コンストラクタ
+// 返り値: ID 生成オブジェクト
+func NewUUIDv7Generator() *UUIDv7Generator {
+	return &UUIDv7Generator{}
+}
+
+// NewID は新しい UUIDv7 を小文字のハイフン区切り形式で返します。
+// 返り値: ID, 乱数の取得に失敗した場合はエラー
+// レシーバー: ID 生成オブジェクト
+func (g *UUIDv7Generator) NewID() (string, error) {
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	return id.String(), nil
+}
+
+var _ port.IDGenerator = (*UUIDv7Generator)(nil)
@@ -0,0 +1,108 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"app/infrastructure/logger"
+)
+
+// Hook はライフサイクルに登録する 1 つのモジュールの起動・停止処理です。
+// OnStart / OnStop はどちらも省略できます。
+type Hook struct {
+	// ログに出力するモジュール名
+	Name string
+
+	// 起動処理。ブロックせずに戻る必要がある（常駐する処理は goroutine で開始する）
+	OnStart func(ctx context.Context) error
+
+	// 停止処理。ctx の期限までに終了する必要がある
+	OnStop func(ctx context.Context) error
+}
+
+// Lifecycle はモジュールの起動・停止の順序を管理します。
+//
+// 起動は登録順、停止は登録の逆順に行います。
+// 先に登録したモジュール（データベースなど）は、後に登録したモジュール（HTTP サーバーなど）が
+// 停止するまで使用できる状態に保たれます。
+type Lifecycle struct {
+	mu      sync.Mutex
+	log     *logger.SlogLogger
+	hooks   []Hook
+	started int
+}
+
+// ライフサイクルコンストラクタ
+// 引数: 構造化ロガーオブジェクト
+// 返り値: ライフサイクルオブジェクト
+func New(log *logger.SlogLogger) *Lifecycle {
+	return &Lifecycle{log: log}
+}
+
+// Append は起動・停止処理を登録します。
+// 引数: 起動・停止処理
+// レシーバー: ライフサイクルオブジェクト
+func (l *Lifecycle) Append(h Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, h)
+}
+
+// Start は登録順に起動処理を実行します。
+// 途中で失敗した場合は、起動済みのモジュールを逆順に停止してからエラーを返します。
+// 引数: コンテキスト
+// 返り値: 起動に失敗した場合はエラー
+// レシーバー: ライフサイクルオブジェクト
+func (l *Lifecycle) Start(ctx context.Context) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for l.started < len(l.hooks) {
+		h := l.hooks[l.started]
+		if h.OnStart != nil {
+			if err := h.OnStart(ctx); err != nil {
+				startErr := fmt.Errorf("failed to start %s: %w", h.Name, err)
+				return errors.Join(startErr, l.stop(ctx))
+			}
+		}
+		l.log.Debug(ctx, "module started", "module", h.Name)
+		l.started++
+	}
+
+	return nil
+}
+
+// Stop は起動済みのモジュールを登録の逆順に停止します。
+// 停止に失敗したモジュールがあっても残りの停止処理は続け、すべてのエラーをまとめて返します。
+// 2 回目以降の呼び出しは何もしません。
+// 引数: コンテキスト（停止処理の期限）
+// 返り値: 停止に失敗した場合はエラー
+// レシーバー: ライフサイクルオブジェクト
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stop(ctx)
+}
+
+// stop は起動済みのモジュールを逆順に停止します。呼び出し側でロックを取得している必要があります。
+func (l *Lifecycle) stop(ctx context.Context) error {
+
+	var errs []error
+	for ; l.started > 0; l.started-- {
+		h := l.hooks[l.started-1]
+		if h.OnStop == nil {
+			continue
+		}
+		if err := h.OnStop(ctx); err != nil {
+			l.log.Error(ctx, "failed to stop module", "module", h.Name, "error", err)
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", h.Name, err))
+			continue
+		}
+		l.log.Debug(ctx, "module stopped", "module", h.Name)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,260 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"app/infrastructure/config"
+	"app/infrastructure/logger"
+)
+
+// recorder は起動・停止処理の呼び出し順を記録するテスト用のフックを作成します。
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+// hook は起動・停止時に「start:名前」「stop:名前」を記録し、指定したエラーを返すフックを返します。
+func (r *recorder) hook(name string, startErr, stopErr error) Hook {
+	return Hook{
+		Name: name,
+		OnStart: func(context.Context) error {
+			r.record("start:" + name)
+			return startErr
+		},
+		OnStop: func(context.Context) error {
+			r.record("stop:" + name)
+			return stopErr
+		},
+	}
+}
+
+func (r *recorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// take は記録した呼び出し順を返し、記録を空にします。
+func (r *recorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := r.events
+	r.events = nil
+	return events
+}
+
+// newTestLifecycle はログを破棄するライフサイクルを作成します。
+func newTestLifecycle(t *testing.T) *Lifecycle {
+	t.Helper()
+
+	log, err := logger.New(io.Discard, config.LogConfig{Level: "debug", Format: "json"})
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	return New(log)
+}
+
+// TestLifecycle_StartStop は起動が登録順、停止が登録の逆順に行われ、
+// 停止に失敗したモジュールがあっても残りの停止処理を続けることを検証します。
+func TestLifecycle_StartStop(t *testing.T) {
+	t.Parallel()
+
+	stopErr := errors.New("close failed")
+
+	tests := map[string]struct {
+		hooks      func(r *recorder) []Hook
+		wantStart  []string
+		wantStop   []string
+		wantErr    error
+		wantErrMsg string
+	}{
+		"start in order and stop in reverse": {
+			hooks: func(r *recorder) []Hook {
+				return []Hook{r.hook("db", nil, nil), r.hook("cache", nil, nil), r.hook("http server", nil, nil)}
+			},
+			wantStart: []string{"start:db", "start:cache", "start:http server"},
+			wantStop:  []string{"stop:http server", "stop:cache", "stop:db"},
+		},
+		"hooks without start or stop": {
+			hooks: func(r *recorder) []Hook {
+				onlyStop := r.hook("metrics", nil, nil)
+				onlyStop.OnStart = nil
+				onlyStart := r.hook("worker", nil, nil)
+				onlyStart.OnStop = nil
+				return []Hook{r.hook("db", nil, nil), onlyStop, onlyStart}
+			},
+			wantStart: []string{"start:db", "start:worker"},
+			wantStop:  []string{"stop:metrics", "stop:db"},
+		},
+		"stop error does not skip remaining modules": {
+			hooks: func(r *recorder) []Hook {
+				return []Hook{r.hook("db", nil, nil), r.hook("cache", nil, stopErr), r.hook("http server", nil, nil)}
+			},
+			wantStart:  []string{"start:db", "start:cache", "start:http server"},
+			wantStop:   []string{"stop:http server", "stop:cache", "stop:db"},
+			wantErr:    stopErr,
+			wantErrMsg: "failed to stop cache",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &recorder{}
+			lc := newTestLifecycle(t)
+			for _, h := range tt.hooks(r) {
+				lc.Append(h)
+			}
+
+			if err := lc.Start(ctx); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			if got := r.take(); !slices.Equal(got, tt.wantStart) {
+				t.Errorf("start order = %v, want %v", got, tt.wantStart)
+			}
+
+			err := lc.Stop(ctx)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErrMsg != "" && !strings.Contains(err.Error(), tt.wantErrMsg)) {
+				t.Errorf("Stop() error = %v, want %v (%q)", err, tt.wantErr, tt.wantErrMsg)
+			}
+			if got := r.take(); !slices.Equal(got, tt.wantStop) {
+				t.Errorf("stop order = %v, want %v", got, tt.wantStop)
+			}
+
+			// 2 回目の停止は何もしない
+			if err := lc.Stop(ctx); err != nil {
+				t.Errorf("second Stop() error = %v", err)
+			}
+			if got := r.take(); len(got) != 0 {
+				t.Errorf("second Stop() called %v, want nothing", got)
+			}
+		})
+	}
+}
+
+// TestLifecycle_StartRollback は起動に失敗した場合に、起動済みのモジュールのみを逆順に停止して
+// エラーを返し、失敗したモジュール以降は起動しないことを検証します。
+func TestLifecycle_StartRollback(t *testing.T) {
+	t.Parallel()
+
+	startErr := errors.New("address already in use")
+	stopErr := errors.New("close failed")
+
+	tests := map[string]struct {
+		hooks      func(r *recorder) []Hook
+		wantEvents []string
+		wantErrs   []error
+		wantErrMsg string
+	}{
+		"first module fails": {
+			hooks: func(r *recorder) []Hook {
+				return []Hook{r.hook("db", startErr, nil), r.hook("cache", nil, nil), r.hook("http server", nil, nil)}
+			},
+			wantEvents: []string{"start:db"},
+			wantErrs:   []error{startErr},
+			wantErrMsg: "failed to start db",
+		},
+		"last module fails": {
+			hooks: func(r *recorder) []Hook {
+				return []Hook{r.hook("db", nil, nil), r.hook("cache", nil, nil), r.hook("http server", startErr, nil)}
+			},
+			wantEvents: []string{"start:db", "start:cache", "start:http server", "stop:cache", "stop:db"},
+			wantErrs:   []error{startErr},
+			wantErrMsg: "failed to start http server",
+		},
+		"rollback error is joined": {
+			hooks: func(r *recorder) []Hook {
+				return []Hook{r.hook("db", nil, stopErr), r.hook("cache", startErr, nil), r.hook("http server", nil, nil)}
+			},
+			wantEvents: []string{"start:db", "start:cache", "stop:db"},
+			wantErrs:   []error{startErr, stopErr},
+			wantErrMsg: "failed to start cache",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &recorder{}
+			lc := newTestLifecycle(t)
+			for _, h := range tt.hooks(r) {
+				lc.Append(h)
+			}
+
+			err := lc.Start(ctx)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Fatalf("Start() error = %v, want containing %q", err, tt.wantErrMsg)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("Start() error = %v, want wrapping %v", err, want)
+				}
+			}
+			if got := r.take(); !slices.Equal(got, tt.wantEvents) {
+				t.Errorf("events = %v, want %v", got, tt.wantEvents)
+			}
+
+			// 巻き戻し済みのため、停止処理は再度呼ばれない
+			if err := lc.Stop(ctx); err != nil {
+				t.Errorf("Stop() after rollback error = %v", err)
+			}
+			if got := r.take(); len(got) != 0 {
+				t.Errorf("Stop() after rollback called %v, want nothing", got)
+			}
+		})
+	}
+}
+
+// TestLifecycle_StopTimeout は停止処理が期限を過ぎても終わらない場合に、期限で打ち切ってエラーを返し、
+// 先に登録したモジュールの停止処理も呼び出すことを検証します。
+func TestLifecycle_StopTimeout(t *testing.T) {
+	t.Parallel()
+
+	r := &recorder{}
+	lc := newTestLifecycle(t)
+	lc.Append(r.hook("db", nil, nil))
+	lc.Append(Hook{
+		Name: "http server",
+		// 処理中のリクエストが終わらない HTTP サーバーのように、期限まで待ち続ける
+		OnStop: func(ctx context.Context) error {
+			r.record("stop:http server")
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+
+	if err := lc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	r.take()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	begin := time.Now()
+	err := lc.Stop(ctx)
+	elapsed := time.Since(begin)
+
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "failed to stop http server") {
+		t.Fatalf("Stop() error = %v, want deadline exceeded of http server", err)
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("Stop() took %v, want to return at the deadline", elapsed)
+	}
+	if got, want := r.take(), []string{"stop:http server", "stop:db"}; !slices.Equal(got, want) {
+		t.Errorf("stop order = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold はこれ以上時間がかかった SQL を警告として出力する閾値です。
+const slowQueryThreshold = 200 * time.Millisecond
+
+// GormLogger は GORM のログを構造化ロガーに出力するアダプターです。
+// リポジトリから渡された context.Context を使用するため、SQL のログにもリクエストIDが付与されます。
+//
+//   - SQL のエラー（レコードが見つからない場合を除く）: error
+//   - 閾値を超えた SQL: warn
+//   - それ以外の SQL: debug
+type GormLogger struct {
+	logger *SlogLogger
+}
+
+// GORM ロガーコンストラクタ
+// 引数: 構造化ロガーオブジェクト
+// 返り値: GORM ロガー
+func NewGormLogger(l *SlogLogger) *GormLogger {
+	return &GormLogger{logger: l}
+}
+
+// LogMode は GORM のログレベル指定です。ログレベルは構造化ロガー側で管理するため、自身をそのまま返します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return g
+}
+
+// Info は GORM 内部の情報ログを出力します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	g.logger.Info(ctx, fmt.Sprintf(msg, args...))
+}
+
+// Warn は GORM 内部の警告ログを出力します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	g.logger.Warn(ctx, fmt.Sprintf(msg, args...))
+}
+
+// Error は GORM 内部のエラーログを出力します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	g.logger.Error(ctx, fmt.Sprintf(msg, args...))
+}
+
+// Trace は実行した SQL を出力します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+
+	elapsed := time.Since(begin)
+	failed := err != nil && !errors.Is(err, gorm.ErrRecordNotFound)
+
+	// SQL の組み立てにはコストがかかるため、出力しない場合は組み立てない
+	if !failed && elapsed < slowQueryThreshold && !g.logger.Slog().Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	sql, rows := fc()
+	attrs := []any{"sql", sql, "rows", rows, "elapsed_ms", elapsed.Milliseconds()}
+
+	switch {
+	case failed:
+		g.logger.Error(ctx, "sql error", append(attrs, "error", err)...)
+	case elapsed >= slowQueryThreshold:
+		g.logger.Warn(ctx, "slow sql", attrs...)
+	default:
+		g.logger.Debug(ctx, "sql", attrs...)
+	}
+}
+
+// ParamsFilter は SQL のログにパラメーターを埋め込まないようにします。
+// パスワードハッシュやトークンのハッシュ値がログに残らないよう、プレースホルダーのまま出力します。
+// レシーバー: GORM ロガーオブジェクト
+func (g *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
+
+var _ gormlogger.Interface = (*GormLogger)(nil)
+var _ gorm.ParamsFilter = (*GormLogger)(nil)
@@ -1,33 +1,173 @@
 package logger
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"os"
+	"strings"
+
+	"app/infrastructure/config"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/application/requestctx"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
-// 日本語ログ出力用の共通ロガー
-// 標準出力に日時・ファイル名付きで出力します。
-var std = log.New(os.Stdout, "", log.LstdFlags|log.Lshortfile)
+// SlogLogger は log/slog を使用した構造化ロガーです。
+//
+// ログには context.Context のリクエストIDと操作者のユーザーIDを request_id / user_id として、
+// 記録中のトレースがあれば trace_id / span_id として自動で付与します。
+// ログレベルは slog.LevelVar で保持しているため、起動後も SetLevel で変更できます。
+type SlogLogger struct {
+	logger *slog.Logger
+	level  *slog.LevelVar
+}
+
+// 構造化ロガーコンストラクタ
+// 標準出力に設定の形式（json / text）で出力します。
+// 引数: ログ出力の設定
+// 返り値: 構造化ロガーオブジェクト, ログレベル・出力形式が不正な場合はエラー
+func NewSlogLogger(cfg config.LogConfig) (*SlogLogger, error) {
+	return New(os.Stdout, cfg)
+}
+
+// New は出力先を指定して構造化ロガーを作成します。
+// 引数: 出力先, ログ出力の設定
+// 返り値: 構造化ロガーオブジェクト, ログレベル・出力形式が不正な場合はエラー
+func New(w io.Writer, cfg config.LogConfig) (*SlogLogger, error) {
+
+	level := new(slog.LevelVar)
+	if err := setLevel(level, cfg.Level); err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var h slog.Handler
+	switch cfg.Format {
+	case "json", "":
+		h = slog.NewJSONHandler(w, opts)
+	case "text":
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format: %s", cfg.Format)
+	}
+
+	return &SlogLogger{logger: slog.New(contextHandler{h}), level: level}, nil
+}
+
+// Slog は内部の *slog.Logger を返します。
+// slog.SetDefault や、Echo・GORM のログの出力先として使用します。
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) Slog() *slog.Logger {
+	return l.logger
+}
+
+// SetLevel はログレベル（debug / info / warn / error）を変更します。
+// 引数: ログレベル
+// 返り値: 未知のログレベルの場合はエラー
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) SetLevel(level string) error {
+	return setLevel(l.level, level)
+}
+
+// Debug はデバッグレベルのログを出力します。
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) Debug(ctx context.Context, msg string, args ...any) {
+	l.logger.DebugContext(ctx, msg, args...)
+}
+
+// Info は情報レベルのログを出力します。
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) Info(ctx context.Context, msg string, args ...any) {
+	l.logger.InfoContext(ctx, msg, args...)
+}
+
+// Warn は警告レベルのログを出力します。
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) Warn(ctx context.Context, msg string, args ...any) {
+	l.logger.WarnContext(ctx, msg, args...)
+}
+
+// Error はエラーレベルのログを出力します。
+// レシーバー: 構造化ロガーオブジェクト
+func (l *SlogLogger) Error(ctx context.Context, msg string, args ...any) {
+	l.logger.ErrorContext(ctx, msg, args...)
+}
+
+var _ port.Logger = (*SlogLogger)(nil)
+
+// setLevel は文字列のログレベルを slog.Level に変換して設定します。
+func setLevel(v *slog.LevelVar, level string) error {
+
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.ToUpper(level))); err != nil || level == "" {
+		return fmt.Errorf("unknown log level: %q", level)
+	}
+	v.Set(l)
+
+	return nil
+}
+
+// contextHandler は context.Context のリクエストID・操作者のユーザーID・トレースIDをログに付与する slog.Handler です。
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle はレコードに request_id / user_id / trace_id / span_id を追加してから出力します。
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+
+	if id, ok := requestctx.RequestIDFromContext(ctx); ok {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if p, ok := policy.PrincipalFromContext(ctx); ok {
+		r.AddAttrs(slog.String("user_id", p.UserID))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() && sc.IsSampled() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs は属性を追加したハンドラを返します。
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup はグループを追加したハンドラを返します。
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// 以下は起動処理やコマンドなど、ロガーを注入できない箇所で使用する関数です。
+// slog のデフォルトロガーに出力するため、main で slog.SetDefault を呼び出した後は同じ形式で出力されます。
+
+// DebugJp はデバッグレベルの日本語ログを出力します。
+func DebugJp(format string, v ...interface{}) {
+	slog.Debug(fmt.Sprintf(format, v...))
+}
 
 // InfoJp は情報レベルの日本語ログを出力します。
 func InfoJp(format string, v ...interface{}) {
-	std.Printf("[INFO] %s\n", fmt.Sprintf(format, v...))
+	slog.Info(fmt.Sprintf(format, v...))
 }
 
 // WarnJp は警告レベルの日本語ログを出力します。
 func WarnJp(format string, v ...interface{}) {
-	std.Printf("[WARN] %s\n", fmt.Sprintf(format, v...))
+	slog.Warn(fmt.Sprintf(format, v...))
 }
 
 // ErrorJp はエラーレベルの日本語ログを出力します。
 func ErrorJp(format string, v ...interface{}) {
-	std.Printf("[ERROR] %s\n", fmt.Sprintf(format, v...))
+	slog.Error(fmt.Sprintf(format, v...))
 }
 
 // FatalJp は致命的エラーを日本語で出力し、アプリケーションを終了します。
 func FatalJp(format string, v ...interface{}) {
-	std.Fatalf("[FATAL] %s\n", fmt.Sprintf(format, v...))
+	slog.Error(fmt.Sprintf(format, v...))
+	os.Exit(1)
 }
-
-
@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"app/infrastructure/config"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FileSender はメールを送信せず、1 通ずつ .eml ファイルとしてディレクトリへ書き出す port.MailSender の実装です。
+// SMTP サーバーを用意できないローカル開発で、送信内容（再設定用のリンクなど）を確認するために使用します。
+type FileSender struct {
+	dir  string
+	from string
+}
+
+// ファイル書き出しメール送信コンストラクタ
+// 引数: メール送信の設定
+// 返り値: ファイル書き出しメール送信オブジェクト
+func NewFileSender(cfg config.MailConfig) *FileSender {
+	return &FileSender{dir: cfg.Dir, from: cfg.From}
+}
+
+// メール送信
+// ファイル名は「送信日時-ランダムな値.eml」とし、送信順に並ぶようにします。
+// 引数: コンテキスト, 送信するメール
+// 返り値: 書き出しに失敗した場合はエラー
+// レシーバー: ファイル書き出しメール送信オブジェクト
+func (s *FileSender) Send(ctx context.Context, m port.Mail) (err error) {
+
+	_, span := tracing.Start(ctx, "MailSender.Send")
+	span.SetAttributes(attribute.String("mail.driver", "file"))
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now()
+	msg, err := buildMessage(s.from, m, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("failed to generate mail file name: %w", err)
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000000000Z"), hex.EncodeToString(suffix))
+
+	// 再設定用のリンクなどを含むため、所有者のみ読み書きできるようにする
+	if err := os.WriteFile(filepath.Join(s.dir, name), msg, 0o600); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
+
+var _ port.MailSender = (*FileSender)(nil)
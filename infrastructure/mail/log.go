@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"context"
+
+	"app/infrastructure/config"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// LogSender はメールを送信せず、宛先・件名・本文をログに出力する port.MailSender の実装です。
+// ファイルの書き出し先も用意しない手元での動作確認で、確認用のリンクなどをログから拾えるようにします。
+// 本文にトークンを含むため、本番環境では使用しないでください。
+type LogSender struct {
+	from   string
+	logger port.Logger
+}
+
+// ログ出力メール送信コンストラクタ
+// 引数: メール送信の設定, ロガー
+// 返り値: ログ出力メール送信オブジェクト
+func NewLogSender(cfg config.MailConfig, logger port.Logger) *LogSender {
+	return &LogSender{from: cfg.From, logger: logger}
+}
+
+// メール送信
+// ほかの実装と同じく、改行を含む宛先・件名や不正な宛先は拒否します。
+// 引数: コンテキスト, 送信するメール
+// 返り値: 宛先・件名が不正な場合はエラー
+// レシーバー: ログ出力メール送信オブジェクト
+func (s *LogSender) Send(ctx context.Context, m port.Mail) (err error) {
+
+	ctx, span := tracing.Start(ctx, "MailSender.Send")
+	span.SetAttributes(attribute.String("mail.driver", "log"))
+	defer func() { tracing.End(span, err) }()
+
+	if err := validateHeaders(m); err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "mail sent", "mail_from", s.from, "mail_to", m.To, "mail_subject", m.Subject, "mail_body", m.Body)
+
+	return nil
+}
+
+var _ port.MailSender = (*LogSender)(nil)
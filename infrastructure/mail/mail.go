@@ -0,0 +1,80 @@
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"app/infrastructure/config"
+	"app/internal/application/port"
+)
+
+// メール送信コンストラクタ
+// 設定 mail.driver に応じて SMTP サーバーへ送信する実装、ディレクトリへ書き出す実装、またはログに出力する実装を返します。
+// 引数: メール送信の設定, ロガー
+// 返り値: メール送信オブジェクト, 設定が不正な場合はエラー
+func NewMailSender(cfg config.MailConfig, logger port.Logger) (port.MailSender, error) {
+
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPSender(cfg), nil
+	case "file":
+		return NewFileSender(cfg), nil
+	case "log":
+		return NewLogSender(cfg, logger), nil
+	}
+
+	return nil, fmt.Errorf("unsupported mail driver: %s", cfg.Driver)
+}
+
+// buildMessage は RFC 5322 形式のメッセージを組み立てます。
+// 件名は MIME エンコードし、本文は UTF-8 のプレーンテキストを base64 で送ります。
+// 引数: 送信元アドレス, 送信するメール, 送信日時
+// 返り値: メッセージ, 宛先・件名が不正な場合はエラー
+func buildMessage(from string, m port.Mail, now time.Time) ([]byte, error) {
+
+	if err := validateHeaders(m); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", m.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	// base64 の本文は 76 文字ごとに改行する
+	body := base64.StdEncoding.EncodeToString([]byte(m.Body))
+	for len(body) > 76 {
+		buf.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body + "\r\n")
+
+	return buf.Bytes(), nil
+}
+
+// validateHeaders は宛先・件名を検証します。
+// ヘッダーに改行を含めて別のヘッダーを差し込まれないよう、宛先・件名の改行は拒否します。
+// 引数: 送信するメール
+// 返り値: 宛先・件名が不正な場合はエラー
+func validateHeaders(m port.Mail) error {
+
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return errors.New("mail header must not contain line breaks")
+	}
+	if _, err := netmail.ParseAddress(m.To); err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	return nil
+}
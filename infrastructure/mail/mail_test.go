@@ -0,0 +1,145 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	netmail "net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"app/infrastructure/config"
+	"app/internal/application/port"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// readBody はメッセージを解析し、base64 でエンコードされた本文を復号して返します。
+func readBody(t *testing.T, msg []byte) (*netmail.Message, string) {
+	t.Helper()
+
+	parsed, err := netmail.ReadMessage(strings.NewReader(string(msg)))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	raw, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, parsed.Body))
+	if err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	return parsed, string(raw)
+}
+
+// recordingLogger は出力されたログの属性を記録する port.Logger です。
+type recordingLogger struct {
+	*testlogger.PortLogger
+	args []any
+}
+
+func (l *recordingLogger) Info(_ context.Context, _ string, args ...any) {
+	l.args = append(l.args, args...)
+}
+
+// TestSenders は、SMTP・ファイル・ログの各実装が同じ形式のメッセージを送信（書き出し）し、
+// 改行を含む件名などヘッダーの差し込みにつながる入力を拒否することを検証します。
+func TestSenders(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	m := port.Mail{To: "alice@example.com", Subject: "パスワードの再設定", Body: "以下のリンクから再設定してください。\nhttps://example.com/reset?token=abc"}
+
+	t.Run("smtp", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewSMTPSender(config.MailConfig{From: "no-reply@example.com", SMTP: config.SMTPConfig{Host: "smtp.example.com", Port: 587}})
+		var gotAddr string
+		var gotTo []string
+		var gotMsg []byte
+		s.send = func(addr string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+			gotAddr, gotTo, gotMsg = addr, to, msg
+			return nil
+		}
+
+		if err := s.Send(ctx, m); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if gotAddr != "smtp.example.com:587" || len(gotTo) != 1 || gotTo[0] != m.To {
+			t.Errorf("send(addr=%q, to=%v), want smtp.example.com:587 and [%s]", gotAddr, gotTo, m.To)
+		}
+		parsed, body := readBody(t, gotMsg)
+		if subject, _ := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject")); subject != m.Subject {
+			t.Errorf("Subject = %q, want %q", subject, m.Subject)
+		}
+		if body != m.Body {
+			t.Errorf("body = %q, want %q", body, m.Body)
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join(t.TempDir(), "mail")
+		s := NewFileSender(config.MailConfig{From: "no-reply@example.com", Dir: dir})
+		if err := s.Send(ctx, m); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		if err != nil || len(files) != 1 {
+			t.Fatalf("eml files = %v (err = %v), want 1 file", files, err)
+		}
+		msg, err := os.ReadFile(files[0])
+		if err != nil {
+			t.Fatalf("failed to read mail file: %v", err)
+		}
+		parsed, body := readBody(t, msg)
+		if parsed.Header.Get("To") != m.To || body != m.Body {
+			t.Errorf("To = %q, body = %q, want %q, %q", parsed.Header.Get("To"), body, m.To, m.Body)
+		}
+	})
+
+	t.Run("log", func(t *testing.T) {
+		t.Parallel()
+
+		logger := &recordingLogger{PortLogger: testlogger.NewPortLogger(t)}
+		s := NewLogSender(config.MailConfig{From: "no-reply@example.com"}, logger)
+		if err := s.Send(ctx, m); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+
+		got := map[string]any{}
+		for i := 0; i+1 < len(logger.args); i += 2 {
+			got[logger.args[i].(string)] = logger.args[i+1]
+		}
+		if got["mail_to"] != m.To || got["mail_body"] != m.Body {
+			t.Errorf("logged args = %v, want mail_to %q and mail_body %q", logger.args, m.To, m.Body)
+		}
+	})
+
+	t.Run("header injection", func(t *testing.T) {
+		t.Parallel()
+
+		senders := map[string]port.MailSender{
+			"file": NewFileSender(config.MailConfig{From: "no-reply@example.com", Dir: t.TempDir()}),
+			"log":  NewLogSender(config.MailConfig{From: "no-reply@example.com"}, testlogger.NewPortLogger(t)),
+		}
+		for name, s := range senders {
+			for _, bad := range []port.Mail{
+				{To: "alice@example.com", Subject: "hello\r\nBcc: eve@example.com"},
+				{To: "alice@example.com\r\nBcc: eve@example.com", Subject: "hello"},
+				{To: "not an address", Subject: "hello"},
+			} {
+				if err := s.Send(ctx, bad); err == nil {
+					t.Errorf("%s: Send(%+v) error = nil, want error", name, bad)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"app/infrastructure/config"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SMTPSender は SMTP サーバーへメールを送信する port.MailSender の実装です。
+// サーバーが STARTTLS に対応していれば暗号化して送信します（ポート 465 の暗黙的な TLS には対応しません）。
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+
+	// 送信関数（テストで差し替えるため）
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// SMTP メール送信コンストラクタ
+// ユーザー名が設定されている場合は PLAIN 認証を使用します。
+// 引数: メール送信の設定
+// 返り値: SMTP メール送信オブジェクト
+func NewSMTPSender(cfg config.MailConfig) *SMTPSender {
+
+	s := &SMTPSender{
+		addr: net.JoinHostPort(cfg.SMTP.Host, strconv.Itoa(cfg.SMTP.Port)),
+		from: cfg.From,
+		send: smtp.SendMail,
+	}
+	if cfg.SMTP.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.SMTP.Username, cfg.SMTP.Password.Value(), cfg.SMTP.Host)
+	}
+
+	return s
+}
+
+// メール送信
+// 引数: コンテキスト, 送信するメール
+// 返り値: 送信に失敗した場合はエラー
+// レシーバー: SMTP メール送信オブジェクト
+func (s *SMTPSender) Send(ctx context.Context, m port.Mail) (err error) {
+
+	_, span := tracing.Start(ctx, "MailSender.Send")
+	span.SetAttributes(attribute.String("mail.driver", "smtp"))
+	defer func() { tracing.End(span, err) }()
+
+	msg, err := buildMessage(s.from, m, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := s.send(s.addr, s.auth, s.from, []string{m.To}, msg); err != nil {
+		return fmt.Errorf("failed to send mail via smtp: %w", err)
+	}
+
+	return nil
+}
+
+var _ port.MailSender = (*SMTPSender)(nil)
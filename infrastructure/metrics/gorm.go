@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// startTimeKey は SQL の実行開始時刻をステートメントに保持するキーです。
+const startTimeKey = "metrics:start_time"
+
+// GormPlugin は SQL の実行時間をメトリクスに記録する GORM のプラグインです。
+//
+//	db.Use(metrics.NewGormPlugin(m))
+type GormPlugin struct {
+	metrics *Metrics
+}
+
+// GORM プラグインコンストラクタ
+// 引数: メトリクスオブジェクト
+// 返り値: GORM プラグイン
+func NewGormPlugin(m *Metrics) *GormPlugin {
+	return &GormPlugin{metrics: m}
+}
+
+// Name はプラグイン名を返します。
+// レシーバー: GORM プラグインオブジェクト
+func (p *GormPlugin) Name() string {
+	return "metrics"
+}
+
+// Initialize は各操作の前後に計測用のコールバックを登録します。
+// レシーバー: GORM プラグインオブジェクト
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+
+	cb := db.Callback()
+	register := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range register {
+		if err := r.before("metrics:before_"+r.operation, p.before); err != nil {
+			return err
+		}
+		if err := r.after("metrics:after_"+r.operation, p.after(r.operation)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// before は実行開始時刻を記録します。
+func (p *GormPlugin) before(db *gorm.DB) {
+	db.InstanceSet(startTimeKey, time.Now())
+}
+
+// after は実行時間を記録するコールバックを返します。
+func (p *GormPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		p.metrics.ObserveDBQuery(operation, table, time.Since(start))
+	}
+}
+
+var _ gorm.Plugin = (*GormPlugin)(nil)
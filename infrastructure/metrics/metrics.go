@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"app/internal/application/port"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace はすべてのメトリクス名の接頭辞です。
+const namespace = "app"
+
+// Metrics は Prometheus 形式のメトリクスを保持します。
+//
+// グローバルのレジストリは使用せず、インスタンスごとのレジストリに登録します。
+// 出力は Handler で公開します（GET /metrics）。
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests     *prometheus.CounterVec
+	httpDuration     *prometheus.HistogramVec
+	usecaseResults   *prometheus.CounterVec
+	dbQueryDuration  *prometheus.HistogramVec
+	passwordDuration *prometheus.HistogramVec
+}
+
+// メトリクスコンストラクタ
+// Go ランタイム・プロセスのメトリクスもあわせて登録します。
+// 返り値: メトリクスオブジェクト
+func NewMetrics() *Metrics {
+
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP リクエスト数（ルート・メソッド・ステータスコード別）",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP リクエストの処理時間（ルート・メソッド別）",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		usecaseResults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "usecase_results_total",
+			Help:      "ユースケースの実行結果（成功は code=\"ok\"、失敗はエラーコード別）",
+		}, []string{"usecase", "result", "code"}),
+		dbQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "SQL の実行時間（操作・テーブル別）",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "table"}),
+		passwordDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "password_hash_duration_seconds",
+			Help:      "パスワードのハッシュ化・検証にかかった時間",
+			Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "algorithm"}),
+	}
+
+	m.registry.MustRegister(
+		m.httpRequests,
+		m.httpDuration,
+		m.usecaseResults,
+		m.dbQueryDuration,
+		m.passwordDuration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
+
+// Handler は Prometheus のテキスト形式でメトリクスを出力する HTTP ハンドラを返します。
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTPRequest は HTTP リクエストを記録します。
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) ObserveHTTPRequest(method string, route string, status int, elapsed time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// ObserveUsecase はユースケースの実行結果を記録します。
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) ObserveUsecase(usecase string, code string) {
+	result := "failure"
+	if code == "ok" {
+		result = "success"
+	}
+	m.usecaseResults.WithLabelValues(usecase, result, code).Inc()
+}
+
+// ObservePasswordHash はパスワードのハッシュ化（hash）・検証（compare）の時間をアルゴリズム別に記録します。
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) ObservePasswordHash(operation, algorithm string, elapsed time.Duration) {
+	m.passwordDuration.WithLabelValues(operation, algorithm).Observe(elapsed.Seconds())
+}
+
+// ObserveDBQuery は SQL の実行時間を記録します。GormPlugin から呼び出します。
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) ObserveDBQuery(operation string, table string, elapsed time.Duration) {
+	m.dbQueryDuration.WithLabelValues(operation, table).Observe(elapsed.Seconds())
+}
+
+// RegisterDBStats はコネクションプールの統計（使用中・アイドルの接続数、待機回数など）を
+// go_sql_* として登録します。
+// 引数: 接続
+// レシーバー: メトリクスオブジェクト
+func (m *Metrics) RegisterDBStats(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+var _ port.Metrics = (*Metrics)(nil)
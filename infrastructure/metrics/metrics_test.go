@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// TestMetrics_Scrape は /metrics の出力が Prometheus のテキスト形式として解析でき、
+// HTTP・ユースケース・SQL・パスワードハッシュ・コネクションプールのメトリクスを含むことを検証します。
+func TestMetrics_Scrape(t *testing.T) {
+	t.Parallel()
+
+	m := NewMetrics()
+
+	// SQL の実行時間とコネクションプールの統計
+	sqlDB, err := sql.Open(sqlite.DriverName, "file:metrics_test?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	defer sqlDB.Close()
+	db, err := gorm.Open(sqlite.Dialector{Conn: sqlDB}, &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	if err := db.Use(NewGormPlugin(m)); err != nil {
+		t.Fatalf("failed to register plugin: %v", err)
+	}
+	m.RegisterDBStats(sqlDB)
+	if err := db.WithContext(context.Background()).Exec("CREATE TABLE items (id INTEGER PRIMARY KEY)").Error; err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	var count int64
+	if err := db.Table("items").Count(&count).Error; err != nil {
+		t.Fatalf("failed to query: %v", err)
+	}
+
+	m.ObserveHTTPRequest(http.MethodGet, "/users/:id", http.StatusOK, 15*time.Millisecond)
+	m.ObserveUsecase("login", "ok")
+	m.ObserveUsecase("login", "user.login_failed")
+	m.ObservePasswordHash("compare", "argon2id", 50*time.Millisecond)
+
+	// スクレイプ
+	srv := httptest.NewServer(m.Handler())
+	defer srv.Close()
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("failed to scrape: %v", err)
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatalf("invalid exposition format: %v\n%s", err, body)
+	}
+
+	for _, name := range []string{
+		"app_http_requests_total",
+		"app_http_request_duration_seconds",
+		"app_usecase_results_total",
+		"app_db_query_duration_seconds",
+		"app_password_hash_duration_seconds",
+		"go_sql_max_open_connections",
+		"go_goroutines",
+	} {
+		if _, ok := families[name]; !ok {
+			t.Errorf("metric %s not found", name)
+		}
+	}
+
+	// ラベルの確認
+	for _, want := range []string{
+		`app_http_requests_total{method="GET",route="/users/:id",status="200"} 1`,
+		`app_usecase_results_total{code="ok",result="success",usecase="login"} 1`,
+		`app_usecase_results_total{code="user.login_failed",result="failure",usecase="login"} 1`,
+		`app_db_query_duration_seconds_count{operation="query",table="items"} 1`,
+		`app_password_hash_duration_seconds_count{algorithm="argon2id",operation="compare"} 1`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected %q in output", want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// keysetCursor はキーセット方式のページングで使用するカーソルの中身です。
+// 直前のページの最終行について、並び替え項目の値と ID を保持します。
+// クライアントには base64 でエンコードした不透明な文字列として渡します。
+type keysetCursor struct {
+	Value json.RawMessage `json:"v"`
+	ID    string          `json:"id"`
+}
+
+// encodeCursor は並び替え項目の値と ID からカーソル文字列を生成します。
+func encodeCursor(value interface{}, id string) (string, error) {
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(keysetCursor{Value: raw, ID: id})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor はカーソル文字列をデコードし、並び替え項目の値を dst に格納します。
+// 戻り値は直前のページの最終行の ID です。
+func decodeCursor(cursor string, dst interface{}) (string, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	var c keysetCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return "", err
+	}
+	if len(c.Value) == 0 {
+		return "", errors.New("cursor has no value")
+	}
+	if err := json.Unmarshal(c.Value, dst); err != nil {
+		return "", err
+	}
+
+	return c.ID, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	userEntity "app/internal/domain/user/entity"
+	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailVerificationTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// メールアドレス確認トークンリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: メールアドレス確認トークンリポジトリオブジェクト
+func NewEmailVerificationTokenRepository(db *gorm.DB) userRepository.EmailVerificationTokenRepository {
+	return &EmailVerificationTokenRepositoryImpl{db: db}
+}
+
+// CreateEmailVerificationToken はメールアドレス確認トークンを保存します。
+// 引数: コンテキスト, 保存するメールアドレス確認トークンエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: メールアドレス確認トークンリポジトリオブジェクト
+func (r *EmailVerificationTokenRepositoryImpl) CreateEmailVerificationToken(cxt context.Context, token *userEntity.EmailVerificationToken) error {
+
+	return conn(cxt, r.db).Create(token).Error
+}
+
+// FindByTokenHash はハッシュ値に一致するメールアドレス確認トークンを取得します。
+// 使用済み・期限切れのトークンも返却し、使用できるかの判断はユースケースに委ねます。
+// 引数: コンテキスト, トークンのハッシュ値
+// 返り値: 一致したトークン, 見つからない場合は UserEmailVerificationTokenInvalidError, 取得に失敗した場合はエラー
+// レシーバー: メールアドレス確認トークンリポジトリオブジェクト
+func (r *EmailVerificationTokenRepositoryImpl) FindByTokenHash(cxt context.Context, tokenHash string) (*userEntity.EmailVerificationToken, error) {
+
+	var t userEntity.EmailVerificationToken
+	if err := conn(cxt, r.db).
+		Where("token_hash = ?", tokenHash).
+		First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserEmailVerificationTokenInvalidError
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// FindRecentByUserID はユーザーに発行したトークンを新しい順に取得します。
+// 使用済み・無効化済みのトークンも含めます（送信回数の数え上げに使用するため）。
+// 引数: コンテキスト, ユーザーID, 最大件数
+// 返り値: 発行日時の新しい順のトークン, 取得に失敗した場合はエラー
+// レシーバー: メールアドレス確認トークンリポジトリオブジェクト
+func (r *EmailVerificationTokenRepositoryImpl) FindRecentByUserID(cxt context.Context, userID string, limit int) ([]*userEntity.EmailVerificationToken, error) {
+
+	var tokens []*userEntity.EmailVerificationToken
+	if err := conn(cxt, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// UseEmailVerificationToken は未使用のトークンを使用済みにします。
+// 同じトークンで同時に確認された場合も一方だけが成功するよう、未使用であることを更新の条件にします。
+// 引数: コンテキスト, 使用するトークンのハッシュ値, 使用日時
+// 返り値: 使用済み・存在しない場合は UserEmailVerificationTokenInvalidError, 更新に失敗した場合はエラー
+// レシーバー: メールアドレス確認トークンリポジトリオブジェクト
+func (r *EmailVerificationTokenRepositoryImpl) UseEmailVerificationToken(cxt context.Context, tokenHash string, usedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.EmailVerificationToken{}).
+		Where("token_hash = ? AND used_at IS NULL", tokenHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserEmailVerificationTokenInvalidError
+	}
+
+	return nil
+}
+
+// InvalidateByUserID はユーザーの未使用のトークンをすべて使用済みにします。
+// 引数: コンテキスト, ユーザーID, 無効化日時
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: メールアドレス確認トークンリポジトリオブジェクト
+func (r *EmailVerificationTokenRepositoryImpl) InvalidateByUserID(cxt context.Context, userID string, invalidatedAt time.Time) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.EmailVerificationToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", invalidatedAt).Error
+}
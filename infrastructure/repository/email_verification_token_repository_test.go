@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestEmailVerificationTokenRepository は、メールアドレス確認トークンが一度しか使用できず、
+// 直近のトークンが新しい順に取得でき、確認済みへの更新が発行時のメールアドレスに限られることを検証します。
+func TestEmailVerificationTokenRepository(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	tokens := repository.NewEmailVerificationTokenRepository(conn)
+	users := repository.NewUserRepository(conn)
+	now := time.Now()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	for i, hash := range []string{"hash-1", "hash-2", "hash-3"} {
+		token, err := entity.NewEmailVerificationToken("user-1", "alice@example.com", hash, now.Add(time.Duration(i)*time.Minute), now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("NewEmailVerificationToken() error = %v", err)
+		}
+		if err := tokens.CreateEmailVerificationToken(ctx, token); err != nil {
+			t.Fatalf("CreateEmailVerificationToken() error = %v", err)
+		}
+	}
+
+	// 新しい順に最大 limit 件
+	recent, err := tokens.FindRecentByUserID(ctx, "user-1", 2)
+	if err != nil {
+		t.Fatalf("FindRecentByUserID() error = %v", err)
+	}
+	if len(recent) != 2 || recent[0].TokenHash != "hash-3" || recent[1].TokenHash != "hash-2" {
+		t.Errorf("FindRecentByUserID() = %+v, want hash-3, hash-2", recent)
+	}
+
+	// 一度目の使用のみ成功する
+	if err := tokens.UseEmailVerificationToken(ctx, "hash-3", now); err != nil {
+		t.Fatalf("first UseEmailVerificationToken() error = %v", err)
+	}
+	if err := tokens.UseEmailVerificationToken(ctx, "hash-3", now); !errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+		t.Errorf("second UseEmailVerificationToken() error = %v, want %v", err, value_obj.UserEmailVerificationTokenInvalidError)
+	}
+
+	// 無効化後は残りのトークンも使用できない
+	if err := tokens.InvalidateByUserID(ctx, "user-1", now); err != nil {
+		t.Fatalf("InvalidateByUserID() error = %v", err)
+	}
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		if err := tokens.UseEmailVerificationToken(ctx, hash, now); !errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+			t.Errorf("UseEmailVerificationToken(%s) after invalidation error = %v, want %v", hash, err, value_obj.UserEmailVerificationTokenInvalidError)
+		}
+	}
+
+	if _, err := tokens.FindByTokenHash(ctx, "unknown"); !errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+		t.Errorf("FindByTokenHash(unknown) error = %v, want %v", err, value_obj.UserEmailVerificationTokenInvalidError)
+	}
+
+	// メールアドレスが変更されている場合は確認済みにしない
+	if err := users.MarkEmailVerified(ctx, "user-1", "old@example.com", now); !errors.Is(err, value_obj.UserNotFoundError) {
+		t.Errorf("MarkEmailVerified(old email) error = %v, want %v", err, value_obj.UserNotFoundError)
+	}
+	if err := users.MarkEmailVerified(ctx, "user-1", "alice@example.com", now); err != nil {
+		t.Fatalf("MarkEmailVerified() error = %v", err)
+	}
+	u, err := users.FindByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !u.IsEmailVerified() {
+		t.Errorf("user = %+v, want email verified", u)
+	}
+}
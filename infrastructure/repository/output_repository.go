@@ -0,0 +1,154 @@
+package repository
+
+import (
+	outputEntity "app/internal/domain/output/entity"
+	outputRepository "app/internal/domain/output/repository"
+	outputValueObj "app/internal/domain/output/value_obj"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type OutputRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// アウトプットリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: アウトプットリポジトリオブジェクト
+func NewOutputRepository(db *gorm.DB) outputRepository.OutputRepository {
+	return &OutputRepositoryImpl{db: db}
+}
+
+// CreateOutput はアウトプットを新規登録します。
+// 引数: コンテキスト, 登録するアウトプットエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) CreateOutput(cxt context.Context, output *outputEntity.Output) error {
+
+	return conn(cxt, r.db).Create(output).Error
+}
+
+// FindByID はIDに一致する論理削除されていないアウトプットを取得します。
+// 引数: コンテキスト, アウトプットID
+// 返り値: 一致したアウトプット, 見つからない場合は OutputNotFoundError, 取得に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) FindByID(cxt context.Context, id string) (*outputEntity.Output, error) {
+
+	var o outputEntity.Output
+	if err := conn(cxt, r.db).
+		Where("id = ? AND delete_flag = ?", id, false).
+		First(&o).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, outputValueObj.OutputNotFoundError
+		}
+		return nil, err
+	}
+
+	return &o, nil
+}
+
+// ListOutputs は検索条件に一致する論理削除されていないアウトプットを作成日時の新しい順に取得します。
+// 引数: コンテキスト, 検索条件
+// 返り値: 一致したアウトプットと総件数, 取得に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) ListOutputs(cxt context.Context, criteria outputRepository.OutputListCriteria) (*outputRepository.OutputPage, error) {
+
+	q := conn(cxt, r.db).
+		Model(&outputEntity.Output{}).
+		Where("delete_flag = ?", false)
+
+	// 完全一致条件
+	if criteria.UserID != "" {
+		q = q.Where("user_id = ?", criteria.UserID)
+	}
+	if criteria.Type != "" {
+		q = q.Where("type = ?", criteria.Type)
+	}
+	if criteria.Status != "" {
+		q = q.Where("status = ?", criteria.Status)
+	}
+
+	// 総件数の取得(ページング適用前)
+	var total int64
+	if err := q.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 一覧の取得
+	// 作成日時が同じ場合も順序が安定するよう ID を第2キーにする
+	var outputs []*outputEntity.Output
+	if err := q.
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(criteria.Limit).
+		Offset(criteria.Offset).
+		Find(&outputs).Error; err != nil {
+		return nil, err
+	}
+
+	return &outputRepository.OutputPage{Outputs: outputs, Total: total}, nil
+}
+
+// UpdateOutput は指定したアウトプットを更新します。
+// 取得から更新までの間に他の操作で下書き以外に変わっていた場合は更新しません。
+// 引数: コンテキスト, 更新後のアウトプットエンティティ
+// 返り値: 対象が下書きでない（存在しない場合を含む）場合は OutputNotEditableError, 更新に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) UpdateOutput(cxt context.Context, output *outputEntity.Output) error {
+	// 空文字への更新も反映するため、ID・所有者・作成日時以外の全カラムを更新対象にする
+	// ステータスは遷移表を経由して UpdateOutputStatus でのみ変更する
+	result := conn(cxt, r.db).
+		Model(&outputEntity.Output{}).
+		Where("id = ? AND status = ? AND delete_flag = ?", output.ID, string(outputValueObj.Draft), false).
+		Select("*").
+		Omit("id", "user_id", "status", "status_changed_by", "status_changed_at", "created_at").
+		Updates(output)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outputValueObj.OutputNotEditableError
+	}
+
+	return nil
+}
+
+// UpdateOutputStatus は指定したアウトプットのステータスと変更者・変更日時を更新します。
+// 取得から更新までの間に他の操作でステータスが変わっていた場合は更新せず、遷移エラーとして扱います。
+// 引数: コンテキスト, 遷移後のアウトプットエンティティ, 遷移前のステータス
+// 返り値: ステータスが変わっていた場合は OutputStatusTransitionError, 更新に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) UpdateOutputStatus(cxt context.Context, output *outputEntity.Output, from string) error {
+
+	result := conn(cxt, r.db).
+		Model(&outputEntity.Output{}).
+		Where("id = ? AND status = ? AND delete_flag = ?", output.ID, from, false).
+		Updates(map[string]interface{}{
+			"status":            output.Status,
+			"status_changed_by": output.StatusChangedBy,
+			"status_changed_at": output.StatusChangedAt,
+			"updated_at":        output.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return outputValueObj.OutputStatusTransitionError
+	}
+
+	return nil
+}
+
+// DeleteOutput は指定したアウトプットを論理削除します。
+// 引数: コンテキスト, 削除対象ID
+// 返り値: 削除に失敗した場合はエラー
+// レシーバー: アウトプットリポジトリオブジェクト
+func (r *OutputRepositoryImpl) DeleteOutput(cxt context.Context, id string) error {
+
+	return conn(cxt, r.db).
+		Model(&outputEntity.Output{}).
+		Where("id = ?", id).
+		Update("delete_flag", true).Error
+}
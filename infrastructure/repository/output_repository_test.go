@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestOutputRepository_UpdateOutput は、取得後に別の操作で下書き以外に変わったアウトプットの内容を
+// 上書きしないことを検証します。
+func TestOutputRepository_UpdateOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	if err := repository.NewUserRepository(conn).CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	outputs := repository.NewOutputRepository(conn)
+
+	o, err := entity.NewOutput("output-1", "user-1", "Go入門", "学習記録", "", "other")
+	if err != nil {
+		t.Fatalf("NewOutput() error = %v", err)
+	}
+	if err := outputs.CreateOutput(ctx, o); err != nil {
+		t.Fatalf("CreateOutput() error = %v", err)
+	}
+
+	// 下書きは更新できる
+	o.Title = "Go応用"
+	if err := outputs.UpdateOutput(ctx, o); err != nil {
+		t.Fatalf("UpdateOutput() error = %v", err)
+	}
+
+	// 編集画面で取得した後に、別のリクエストでレビューに提出される
+	stale, err := outputs.FindByID(ctx, "output-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	submitted := *stale
+	if err := submitted.ApplyStatusAction(value_obj.Submit, "user-1", time.Now()); err != nil {
+		t.Fatalf("ApplyStatusAction() error = %v", err)
+	}
+	if err := outputs.UpdateOutputStatus(ctx, &submitted, stale.Status); err != nil {
+		t.Fatalf("UpdateOutputStatus() error = %v", err)
+	}
+
+	stale.Title = "レビュー中の変更"
+	if err := outputs.UpdateOutput(ctx, stale); !errors.Is(err, value_obj.OutputNotEditableError) {
+		t.Fatalf("UpdateOutput() error = %v, want %v", err, value_obj.OutputNotEditableError)
+	}
+	got, err := outputs.FindByID(ctx, "output-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if got.Title != "Go応用" || got.Status != string(value_obj.InReview) {
+		t.Errorf("output = (%q, %q), want (%q, %q)", got.Title, got.Status, "Go応用", value_obj.InReview)
+	}
+}
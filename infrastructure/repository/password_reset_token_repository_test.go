@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestPasswordResetTokenRepository は、パスワード再設定トークンが一度しか使用できず、
+// ユーザー単位の無効化で未使用のトークンがすべて使えなくなることを検証します。
+func TestPasswordResetTokenRepository(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	repo := repository.NewPasswordResetTokenRepository(newTestDB(t))
+	now := time.Now()
+
+	for i, hash := range []string{"hash-1", "hash-2", "hash-3"} {
+		issuedAt := now.Add(time.Duration(i) * time.Minute)
+		token, err := entity.NewPasswordResetToken("user-1", hash, issuedAt, issuedAt.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("NewPasswordResetToken() error = %v", err)
+		}
+		if err := repo.CreatePasswordResetToken(ctx, token); err != nil {
+			t.Fatalf("CreatePasswordResetToken() error = %v", err)
+		}
+	}
+
+	// 新しい順に最大 limit 件
+	recent, err := repo.FindRecentByUserID(ctx, "user-1", 2)
+	if err != nil {
+		t.Fatalf("FindRecentByUserID() error = %v", err)
+	}
+	if len(recent) != 2 || recent[0].TokenHash != "hash-3" || recent[1].TokenHash != "hash-2" {
+		t.Errorf("FindRecentByUserID() = %+v, want hash-3, hash-2", recent)
+	}
+
+	// 一度目の使用のみ成功する
+	if err := repo.UsePasswordResetToken(ctx, "hash-1", now); err != nil {
+		t.Fatalf("first UsePasswordResetToken() error = %v", err)
+	}
+	if err := repo.UsePasswordResetToken(ctx, "hash-1", now); !errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+		t.Errorf("second UsePasswordResetToken() error = %v, want %v", err, value_obj.UserPasswordResetTokenInvalidError)
+	}
+
+	got, err := repo.FindByTokenHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("FindByTokenHash() error = %v", err)
+	}
+	if !got.IsUsed() || got.IsExpired(now) {
+		t.Errorf("token = %+v, want used and not expired", got)
+	}
+
+	// 無効化後は残りのトークンも使用できない
+	if err := repo.InvalidateByUserID(ctx, "user-1", now); err != nil {
+		t.Fatalf("InvalidateByUserID() error = %v", err)
+	}
+	for _, hash := range []string{"hash-2", "hash-3"} {
+		if err := repo.UsePasswordResetToken(ctx, hash, now); !errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+			t.Errorf("UsePasswordResetToken(%s) after invalidation error = %v, want %v", hash, err, value_obj.UserPasswordResetTokenInvalidError)
+		}
+	}
+
+	if _, err := repo.FindByTokenHash(ctx, "unknown"); !errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+		t.Errorf("FindByTokenHash(unknown) error = %v, want %v", err, value_obj.UserPasswordResetTokenInvalidError)
+	}
+}
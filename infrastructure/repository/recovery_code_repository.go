@@ -0,0 +1,61 @@
+package repository
+
+import (
+	userEntity "app/internal/domain/user/entity"
+	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// リカバリーコードリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: リカバリーコードリポジトリオブジェクト
+func NewRecoveryCodeRepository(db *gorm.DB) userRepository.RecoveryCodeRepository {
+	return &RecoveryCodeRepositoryImpl{db: db}
+}
+
+// ReplaceRecoveryCodes はユーザーのリカバリーコードを置き換えます。
+// 以前に発行したコードは使用済みかどうかにかかわらず削除し、新しいコードのみ使えるようにします。
+// 引数: コンテキスト, ユーザーID, 保存するリカバリーコードエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: リカバリーコードリポジトリオブジェクト
+func (r *RecoveryCodeRepositoryImpl) ReplaceRecoveryCodes(cxt context.Context, userID string, codes []*userEntity.RecoveryCode) error {
+
+	db := conn(cxt, r.db)
+	if err := db.Where("user_id = ?", userID).Delete(&userEntity.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	return db.Create(codes).Error
+}
+
+// UseRecoveryCode は未使用のリカバリーコードを使用済みにします。
+// 同じコードが同時に使われた場合も一方だけが成功するよう、未使用であることを更新の条件にします。
+// 引数: コンテキスト, ユーザーID, コードのハッシュ値, 使用日時
+// 返り値: 使用済み・存在しない場合は UserTwoFactorCodeInvalidError, 更新に失敗した場合はエラー
+// レシーバー: リカバリーコードリポジトリオブジェクト
+func (r *RecoveryCodeRepositoryImpl) UseRecoveryCode(cxt context.Context, userID string, codeHash string, usedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.RecoveryCode{}).
+		Where("code_hash = ? AND user_id = ? AND used_at IS NULL", codeHash, userID).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserTwoFactorCodeInvalidError
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	userEntity "app/internal/domain/user/entity"
+	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RefreshTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// リフレッシュトークンリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: リフレッシュトークンリポジトリオブジェクト
+func NewRefreshTokenRepository(db *gorm.DB) userRepository.RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{db: db}
+}
+
+// CreateRefreshToken はリフレッシュトークンを保存します。
+// 引数: コンテキスト, 保存するリフレッシュトークンエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: リフレッシュトークンリポジトリオブジェクト
+func (r *RefreshTokenRepositoryImpl) CreateRefreshToken(cxt context.Context, token *userEntity.RefreshToken) error {
+
+	return conn(cxt, r.db).Create(token).Error
+}
+
+// FindByTokenHash はハッシュ値に一致するリフレッシュトークンを取得します。
+// 失効済みのトークンも返却し、再利用検知の判断はユースケースに委ねます。
+// 引数: コンテキスト, トークンのハッシュ値
+// 返り値: 一致したトークン, 見つからない場合は UserRefreshTokenInvalidError, 取得に失敗した場合はエラー
+// レシーバー: リフレッシュトークンリポジトリオブジェクト
+func (r *RefreshTokenRepositoryImpl) FindByTokenHash(cxt context.Context, tokenHash string) (*userEntity.RefreshToken, error) {
+
+	var t userEntity.RefreshToken
+	if err := conn(cxt, r.db).
+		Where("token_hash = ?", tokenHash).
+		First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserRefreshTokenInvalidError
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// RevokeRefreshToken は未失効のトークンを失効させます。
+// 同じトークンで同時に再発行された場合も一方だけが成功するよう、未失効であることを更新の条件にします。
+// 引数: コンテキスト, 失効対象のハッシュ値, 後継トークンのハッシュ値, 失効日時
+// 返り値: 失効済み・存在しない場合は UserRefreshTokenInvalidError, 更新に失敗した場合はエラー
+// レシーバー: リフレッシュトークンリポジトリオブジェクト
+func (r *RefreshTokenRepositoryImpl) RevokeRefreshToken(cxt context.Context, tokenHash string, replacedBy string, revokedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.RefreshToken{}).
+		Where("token_hash = ? AND revoked_at IS NULL", tokenHash).
+		Updates(map[string]interface{}{
+			"revoked_at":  revokedAt,
+			"replaced_by": replacedBy,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserRefreshTokenInvalidError
+	}
+
+	return nil
+}
+
+// RevokeFamily は同一ファミリーの未失効トークンをすべて失効させます。
+// 引数: コンテキスト, ファミリーID, 失効日時
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: リフレッシュトークンリポジトリオブジェクト
+func (r *RefreshTokenRepositoryImpl) RevokeFamily(cxt context.Context, familyID string, revokedAt time.Time) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", revokedAt).Error
+}
+
+// RevokeByUserID はユーザーの未失効トークンをすべて失効させます。
+// パスワードの再設定時に、ほかの端末のログイン状態を解除するために使用します。
+// 引数: コンテキスト, ユーザーID, 失効日時
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: リフレッシュトークンリポジトリオブジェクト
+func (r *RefreshTokenRepositoryImpl) RevokeByUserID(cxt context.Context, userID string, revokedAt time.Time) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", revokedAt).Error
+}
@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestRefreshTokenRepository_RevokeRefreshToken は、同じトークンの失効が一度しか成功せず、
+// 二度目は UserRefreshTokenInvalidError になる（ローテーションの同時実行を検知できる）ことを検証します。
+func TestRefreshTokenRepository_RevokeRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	tokens := repository.NewRefreshTokenRepository(conn)
+	users := repository.NewUserRepository(conn)
+	now := time.Now()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	token, err := entity.NewRefreshToken("user-1", "hash-1", "", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewRefreshToken() error = %v", err)
+	}
+	if err := tokens.CreateRefreshToken(ctx, token); err != nil {
+		t.Fatalf("CreateRefreshToken() error = %v", err)
+	}
+
+	if err := tokens.RevokeRefreshToken(ctx, "hash-1", "hash-2", now); err != nil {
+		t.Fatalf("first RevokeRefreshToken() error = %v", err)
+	}
+	if err := tokens.RevokeRefreshToken(ctx, "hash-1", "hash-3", now); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+		t.Errorf("second RevokeRefreshToken() error = %v, want %v", err, value_obj.UserRefreshTokenInvalidError)
+	}
+	if err := tokens.RevokeRefreshToken(ctx, "unknown", "hash-4", now); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+		t.Errorf("RevokeRefreshToken(unknown) error = %v, want %v", err, value_obj.UserRefreshTokenInvalidError)
+	}
+
+	stored, err := tokens.FindByTokenHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("FindByTokenHash() error = %v", err)
+	}
+	if !stored.IsRevoked() || stored.ReplacedBy != "hash-2" {
+		t.Errorf("stored = %+v, want revoked and replaced by hash-2", stored)
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	userEntity "app/internal/domain/user/entity"
+	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TOTPCredentialRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// TOTP シークレットリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: TOTP シークレットリポジトリオブジェクト
+func NewTOTPCredentialRepository(db *gorm.DB) userRepository.TOTPCredentialRepository {
+	return &TOTPCredentialRepositoryImpl{db: db}
+}
+
+// SaveTOTPCredential は TOTP シークレットを保存します。
+// 同じユーザーの登録が既にある場合は、シークレット・確認日時・使用済みの時間ステップを置き換えます。
+// 引数: コンテキスト, 保存する TOTP シークレットエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: TOTP シークレットリポジトリオブジェクト
+func (r *TOTPCredentialRepositoryImpl) SaveTOTPCredential(cxt context.Context, credential *userEntity.TOTPCredential) error {
+
+	return conn(cxt, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"secret_ciphertext", "confirmed_at", "last_used_step", "created_at", "updated_at"}),
+		}).
+		Create(credential).Error
+}
+
+// FindByUserID はユーザーの TOTP シークレットを取得します。
+// 未確認の登録も返却し、使用できるかの判断はユースケースに委ねます。
+// 引数: コンテキスト, ユーザーID
+// 返り値: TOTP シークレット, 見つからない場合は UserTwoFactorNotEnrolledError, 取得に失敗した場合はエラー
+// レシーバー: TOTP シークレットリポジトリオブジェクト
+func (r *TOTPCredentialRepositoryImpl) FindByUserID(cxt context.Context, userID string) (*userEntity.TOTPCredential, error) {
+
+	var c userEntity.TOTPCredential
+	if err := conn(cxt, r.db).
+		Where("user_id = ?", userID).
+		First(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserTwoFactorNotEnrolledError
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// ConfirmTOTPCredential は未確認の登録を確認済みにします。
+// 確認に使用したコードを再度使えないよう、その時間ステップも記録します。
+// 引数: コンテキスト, ユーザーID, 確認に使用したコードの時間ステップ, 確認日時
+// 返り値: 未確認の登録が無い場合は UserTwoFactorNotEnrolledError, 更新に失敗した場合はエラー
+// レシーバー: TOTP シークレットリポジトリオブジェクト
+func (r *TOTPCredentialRepositoryImpl) ConfirmTOTPCredential(cxt context.Context, userID string, step int64, confirmedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.TOTPCredential{}).
+		Where("user_id = ? AND confirmed_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"confirmed_at":   confirmedAt,
+			"last_used_step": step,
+			"updated_at":     confirmedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserTwoFactorNotEnrolledError
+	}
+
+	return nil
+}
+
+// UseTOTPStep はコードの時間ステップを使用済みにします。
+// 同じコード（または以前のコード）が同時に使われた場合も一方だけが成功するよう、
+// 記録済みの時間ステップより新しいことを更新の条件にします。
+// 引数: コンテキスト, ユーザーID, 使用したコードの時間ステップ
+// 返り値: 使用済みの時間ステップ以前の場合は UserTwoFactorCodeInvalidError, 更新に失敗した場合はエラー
+// レシーバー: TOTP シークレットリポジトリオブジェクト
+func (r *TOTPCredentialRepositoryImpl) UseTOTPStep(cxt context.Context, userID string, step int64) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.TOTPCredential{}).
+		Where("user_id = ? AND confirmed_at IS NOT NULL AND last_used_step < ?", userID, step).
+		UpdateColumn("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserTwoFactorCodeInvalidError
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"app/internal/application/port"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey はコンテキストに実行中のトランザクションを格納するためのキーです。
+type txKey struct{}
+
+// GormTransactionManager は GORM のトランザクションで port.TransactionManager を実装します。
+//
+// 実行中のトランザクションはコンテキストで受け渡し、各リポジトリは conn でコンテキストの
+// トランザクションを優先して使用します。これにより、ユースケースはリポジトリの組み合わせを
+// 意識せずに複数の操作を 1 つのトランザクションにまとめられます。
+type GormTransactionManager struct {
+	db *gorm.DB
+}
+
+// トランザクションマネージャーコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: トランザクションマネージャーオブジェクト
+func NewTransactionManager(db *gorm.DB) *GormTransactionManager {
+	return &GormTransactionManager{db: db}
+}
+
+// Do は fn を 1 つのトランザクションで実行します。
+// fn がエラーを返した場合・パニックした場合はロールバックします。
+// 引数: コンテキスト, トランザクション内で実行する処理
+// 返り値: fn のエラー, トランザクションの開始・コミットに失敗した場合はエラー
+// レシーバー: トランザクションマネージャーオブジェクト
+func (m *GormTransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+
+	// 外側のトランザクションに参加
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn はコンテキストにトランザクションがあればそれを、無ければ db をコンテキスト付きで返します。
+// リポジトリはすべての操作でこの接続を使用します。
+// 引数: コンテキスト, リポジトリのデータベースオブジェクト
+// 返り値: 操作に使用するデータベースオブジェクト
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+var _ port.TransactionManager = (*GormTransactionManager)(nil)
@@ -0,0 +1,162 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"app/infrastructure/config"
+	"app/infrastructure/db"
+	"app/infrastructure/health"
+	"app/infrastructure/lifecycle"
+	"app/infrastructure/logger"
+	"app/infrastructure/metrics"
+	"app/infrastructure/repository"
+	outputEntity "app/internal/domain/output/entity"
+	outputValueObj "app/internal/domain/output/value_obj"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"gorm.io/gorm"
+)
+
+// newTestDB はマイグレーション適用済みのインメモリデータベースに接続します。
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	log, err := logger.New(io.Discard, config.LogConfig{Level: "error", Format: "json"})
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	lc := lifecycle.New(log)
+	t.Cleanup(func() { lc.Stop(context.Background()) })
+
+	conn, err := db.NewConnection(config.DatabaseConfig{URL: ":memory:"}, log, lc, health.NewRegistry(), metrics.NewMetrics())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	return conn
+}
+
+// newUser はテスト用のユーザーエンティティを生成します。
+func newUser(t *testing.T, id, email string) *entity.User {
+	t.Helper()
+
+	u, err := entity.NewUser(id, "Alice", email, "hashed", "")
+	if err != nil {
+		t.Fatalf("failed to build user: %v", err)
+	}
+	return u
+}
+
+// TestTransactionManager_Do は、トランザクション内の複数のリポジトリの操作が
+// 成功時はまとめてコミットされ、エラー時はまとめてロールバックされることを検証します。
+func TestTransactionManager_Do(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	errAbort := errors.New("abort")
+
+	tests := map[string]struct {
+		fnErr      error
+		wantErr    error
+		wantExists bool
+	}{
+		"commit on success": {
+			wantExists: true,
+		},
+		"rollback on error": {
+			fnErr:      errAbort,
+			wantErr:    errAbort,
+			wantExists: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newTestDB(t)
+			users := repository.NewUserRepository(conn)
+			outputs := repository.NewOutputRepository(conn)
+			tx := repository.NewTransactionManager(conn)
+			ctx := context.Background()
+
+			err := tx.Do(ctx, func(ctx context.Context) error {
+				if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+					return err
+				}
+				o, err := outputEntity.NewOutput("output-1", "user-1", "title", "", "https://example.com", string(outputValueObj.Blog))
+				if err != nil {
+					return err
+				}
+				if err := outputs.CreateOutput(ctx, o); err != nil {
+					return err
+				}
+
+				// 入れ子の呼び出しは外側のトランザクションに参加する
+				return tx.Do(ctx, func(ctx context.Context) error {
+					return tt.fnErr
+				})
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			_, userErr := users.FindByID(ctx, "user-1")
+			_, outputErr := outputs.FindByID(ctx, "output-1")
+			if gotExists := userErr == nil; gotExists != tt.wantExists {
+				t.Errorf("user exists = %v, want %v (err: %v)", gotExists, tt.wantExists, userErr)
+			}
+			if gotExists := outputErr == nil; gotExists != tt.wantExists {
+				t.Errorf("output exists = %v, want %v (err: %v)", gotExists, tt.wantExists, outputErr)
+			}
+		})
+	}
+}
+
+// TestUserRepository_UniqueActiveEmail は、有効なユーザーのメールアドレスの一意制約違反が
+// UserEmailAlreadyExistsError に変換され、論理削除済みのユーザーのメールアドレスは再利用できることを検証します。
+func TestUserRepository_UniqueActiveEmail(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	conn := newTestDB(t)
+	users := repository.NewUserRepository(conn)
+	ctx := context.Background()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 登録時の重複
+	if err := users.CreateUser(ctx, newUser(t, "user-2", "alice@example.com")); !errors.Is(err, value_obj.UserEmailAlreadyExistsError) {
+		t.Fatalf("expected error %v, got %v", value_obj.UserEmailAlreadyExistsError, err)
+	}
+
+	// 更新時の重複
+	bob := newUser(t, "user-3", "bob@example.com")
+	if err := users.CreateUser(ctx, bob); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bob.Email = "alice@example.com"
+	if err := users.UpdateUser(ctx, bob); !errors.Is(err, value_obj.UserEmailAlreadyExistsError) {
+		t.Fatalf("expected error %v, got %v", value_obj.UserEmailAlreadyExistsError, err)
+	}
+
+	// 論理削除済みのユーザーのメールアドレスでは再登録できる
+	if err := users.DeleteUser(ctx, "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := users.CreateUser(ctx, newUser(t, "user-4", "alice@example.com")); err != nil {
+		t.Fatalf("expected re-registration to succeed, got %v", err)
+	}
+}
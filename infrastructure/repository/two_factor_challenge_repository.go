@@ -0,0 +1,86 @@
+package repository
+
+import (
+	userEntity "app/internal/domain/user/entity"
+	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TwoFactorChallengeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// 2 段階認証チャレンジリポジトリコンストラクタ
+// 引数: データベースオブジェクト
+// 返り値: 2 段階認証チャレンジリポジトリオブジェクト
+func NewTwoFactorChallengeRepository(db *gorm.DB) userRepository.TwoFactorChallengeRepository {
+	return &TwoFactorChallengeRepositoryImpl{db: db}
+}
+
+// CreateTwoFactorChallenge はチャレンジを保存します。
+// 引数: コンテキスト, 保存するチャレンジエンティティ
+// 返り値: 永続化に失敗した場合はエラー
+// レシーバー: 2 段階認証チャレンジリポジトリオブジェクト
+func (r *TwoFactorChallengeRepositoryImpl) CreateTwoFactorChallenge(cxt context.Context, challenge *userEntity.TwoFactorChallenge) error {
+
+	return conn(cxt, r.db).Create(challenge).Error
+}
+
+// FindByTokenHash はハッシュ値に一致するチャレンジを取得します。
+// 使用済み・期限切れのチャレンジも返却し、使用できるかの判断はユースケースに委ねます。
+// 引数: コンテキスト, トークンのハッシュ値
+// 返り値: 一致したチャレンジ, 見つからない場合は UserTwoFactorChallengeInvalidError, 取得に失敗した場合はエラー
+// レシーバー: 2 段階認証チャレンジリポジトリオブジェクト
+func (r *TwoFactorChallengeRepositoryImpl) FindByTokenHash(cxt context.Context, tokenHash string) (*userEntity.TwoFactorChallenge, error) {
+
+	var c userEntity.TwoFactorChallenge
+	if err := conn(cxt, r.db).
+		Where("token_hash = ?", tokenHash).
+		First(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserTwoFactorChallengeInvalidError
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// IncrementAttempts は誤ったコードの入力回数を 1 加算します。
+// 同時に入力された場合も数え漏れないよう、データベース上で加算します。
+// 引数: コンテキスト, トークンのハッシュ値
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: 2 段階認証チャレンジリポジトリオブジェクト
+func (r *TwoFactorChallengeRepositoryImpl) IncrementAttempts(cxt context.Context, tokenHash string) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.TwoFactorChallenge{}).
+		Where("token_hash = ?", tokenHash).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// UseTwoFactorChallenge は未使用のチャレンジを使用済みにします。
+// 同じチャレンジで同時に認証された場合も一方だけが成功するよう、未使用であることを更新の条件にします。
+// 引数: コンテキスト, トークンのハッシュ値, 使用日時
+// 返り値: 使用済み・存在しない場合は UserTwoFactorChallengeInvalidError, 更新に失敗した場合はエラー
+// レシーバー: 2 段階認証チャレンジリポジトリオブジェクト
+func (r *TwoFactorChallengeRepositoryImpl) UseTwoFactorChallenge(cxt context.Context, tokenHash string, usedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.TwoFactorChallenge{}).
+		Where("token_hash = ? AND used_at IS NULL", tokenHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserTwoFactorChallengeInvalidError
+	}
+
+	return nil
+}
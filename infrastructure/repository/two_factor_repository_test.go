@@ -0,0 +1,150 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestTwoFactorRepositories は、TOTP の登録が確認前に置き換えられ、使用済みの時間ステップ以前のコードを拒否し、
+// リカバリーコードとチャレンジがそれぞれ一度しか使用できないことを検証します。
+func TestTwoFactorRepositories(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	users := repository.NewUserRepository(conn)
+	credentials := repository.NewTOTPCredentialRepository(conn)
+	codes := repository.NewRecoveryCodeRepository(conn)
+	challenges := repository.NewTwoFactorChallengeRepository(conn)
+	now := time.Now()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// TOTP: 未登録 → 登録の置き換え → 確認 → 時間ステップの使用
+	if _, err := credentials.FindByUserID(ctx, "user-1"); !errors.Is(err, value_obj.UserTwoFactorNotEnrolledError) {
+		t.Errorf("FindByUserID() before enrollment error = %v, want %v", err, value_obj.UserTwoFactorNotEnrolledError)
+	}
+	for _, secret := range []string{"cipher-1", "cipher-2"} {
+		c, err := entity.NewTOTPCredential("user-1", secret, now)
+		if err != nil {
+			t.Fatalf("NewTOTPCredential() error = %v", err)
+		}
+		if err := credentials.SaveTOTPCredential(ctx, c); err != nil {
+			t.Fatalf("SaveTOTPCredential(%s) error = %v", secret, err)
+		}
+	}
+	if err := credentials.ConfirmTOTPCredential(ctx, "user-1", 100, now); err != nil {
+		t.Fatalf("ConfirmTOTPCredential() error = %v", err)
+	}
+	if err := credentials.ConfirmTOTPCredential(ctx, "user-1", 101, now); !errors.Is(err, value_obj.UserTwoFactorNotEnrolledError) {
+		t.Errorf("second ConfirmTOTPCredential() error = %v, want %v", err, value_obj.UserTwoFactorNotEnrolledError)
+	}
+	c, err := credentials.FindByUserID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByUserID() error = %v", err)
+	}
+	if c.SecretCiphertext != "cipher-2" || !c.IsConfirmed() || c.LastUsedStep != 100 {
+		t.Errorf("FindByUserID() = %+v, want confirmed cipher-2 at step 100", c)
+	}
+	for _, tt := range []struct {
+		step    int64
+		wantErr error
+	}{
+		{step: 100, wantErr: value_obj.UserTwoFactorCodeInvalidError},
+		{step: 101},
+		{step: 101, wantErr: value_obj.UserTwoFactorCodeInvalidError},
+		{step: 99, wantErr: value_obj.UserTwoFactorCodeInvalidError},
+	} {
+		if err := credentials.UseTOTPStep(ctx, "user-1", tt.step); !errors.Is(err, tt.wantErr) {
+			t.Errorf("UseTOTPStep(%d) error = %v, want %v", tt.step, err, tt.wantErr)
+		}
+	}
+
+	// 2 段階認証の有効化
+	if err := users.EnableTwoFactor(ctx, "user-1", now); err != nil {
+		t.Fatalf("EnableTwoFactor() error = %v", err)
+	}
+	if err := users.EnableTwoFactor(ctx, "unknown", now); !errors.Is(err, value_obj.UserNotFoundError) {
+		t.Errorf("EnableTwoFactor(unknown) error = %v, want %v", err, value_obj.UserNotFoundError)
+	}
+	u, err := users.FindByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !u.IsTwoFactorEnabled() {
+		t.Errorf("user = %+v, want two-factor enabled", u)
+	}
+
+	// リカバリーコード: 置き換え後は以前のコードを使用できない
+	replace := func(hashes ...string) {
+		t.Helper()
+		var list []*entity.RecoveryCode
+		for _, hash := range hashes {
+			code, err := entity.NewRecoveryCode("user-1", hash, now)
+			if err != nil {
+				t.Fatalf("NewRecoveryCode() error = %v", err)
+			}
+			list = append(list, code)
+		}
+		if err := codes.ReplaceRecoveryCodes(ctx, "user-1", list); err != nil {
+			t.Fatalf("ReplaceRecoveryCodes() error = %v", err)
+		}
+	}
+	replace("old-1", "old-2")
+	replace("new-1", "new-2")
+	if err := codes.UseRecoveryCode(ctx, "user-1", "old-1", now); !errors.Is(err, value_obj.UserTwoFactorCodeInvalidError) {
+		t.Errorf("UseRecoveryCode(old) error = %v, want %v", err, value_obj.UserTwoFactorCodeInvalidError)
+	}
+	if err := codes.UseRecoveryCode(ctx, "user-2", "new-1", now); !errors.Is(err, value_obj.UserTwoFactorCodeInvalidError) {
+		t.Errorf("UseRecoveryCode(other user) error = %v, want %v", err, value_obj.UserTwoFactorCodeInvalidError)
+	}
+	if err := codes.UseRecoveryCode(ctx, "user-1", "new-1", now); err != nil {
+		t.Fatalf("first UseRecoveryCode() error = %v", err)
+	}
+	if err := codes.UseRecoveryCode(ctx, "user-1", "new-1", now); !errors.Is(err, value_obj.UserTwoFactorCodeInvalidError) {
+		t.Errorf("second UseRecoveryCode() error = %v, want %v", err, value_obj.UserTwoFactorCodeInvalidError)
+	}
+
+	// チャレンジ: 入力回数の加算と一度だけの使用
+	challenge, err := entity.NewTwoFactorChallenge("user-1", "challenge-1", now, now.Add(5*time.Minute))
+	if err != nil {
+		t.Fatalf("NewTwoFactorChallenge() error = %v", err)
+	}
+	if err := challenges.CreateTwoFactorChallenge(ctx, challenge); err != nil {
+		t.Fatalf("CreateTwoFactorChallenge() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := challenges.IncrementAttempts(ctx, "challenge-1"); err != nil {
+			t.Fatalf("IncrementAttempts() error = %v", err)
+		}
+	}
+	found, err := challenges.FindByTokenHash(ctx, "challenge-1")
+	if err != nil {
+		t.Fatalf("FindByTokenHash() error = %v", err)
+	}
+	if found.Attempts != 2 || found.IsUsed() {
+		t.Errorf("FindByTokenHash() = %+v, want 2 attempts and unused", found)
+	}
+	if err := challenges.UseTwoFactorChallenge(ctx, "challenge-1", now); err != nil {
+		t.Fatalf("first UseTwoFactorChallenge() error = %v", err)
+	}
+	if err := challenges.UseTwoFactorChallenge(ctx, "challenge-1", now); !errors.Is(err, value_obj.UserTwoFactorChallengeInvalidError) {
+		t.Errorf("second UseTwoFactorChallenge() error = %v, want %v", err, value_obj.UserTwoFactorChallengeInvalidError)
+	}
+	if _, err := challenges.FindByTokenHash(ctx, "unknown"); !errors.Is(err, value_obj.UserTwoFactorChallengeInvalidError) {
+		t.Errorf("FindByTokenHash(unknown) error = %v, want %v", err, value_obj.UserTwoFactorChallengeInvalidError)
+	}
+}
@@ -3,9 +3,11 @@ package repository
 import (
 	userEntity "app/internal/domain/user/entity"
 	userRepository "app/internal/domain/user/repository"
+	userValueObj "app/internal/domain/user/value_obj"
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -23,11 +25,19 @@ func NewUserRepository(db *gorm.DB) userRepository.UserRepository {
 
 // CreateUser はユーザーを新規登録します。
 // 引数: コンテキスト, 登録するユーザーエンティティ
-// 返り値: 永続化に失敗した場合はエラー
+// 返り値: メールアドレスが有効な他のユーザーと重複する場合は UserEmailAlreadyExistsError, 永続化に失敗した場合はエラー
 // レシーバー: ユーザーリポジトリオブジェクト
 func (r *UserRepositoryImpl) CreateUser(cxt context.Context, user *userEntity.User) error {
 
-	return r.db.WithContext(cxt).Create(user).Error
+	if err := conn(cxt, r.db).Create(user).Error; err != nil {
+		// 有効なユーザーのメールアドレスの一意制約違反
+		if isUniqueViolation(err, "users.email") {
+			return userValueObj.UserEmailAlreadyExistsError
+		}
+		return err
+	}
+
+	return nil
 }
 
 // ExistsByEmail はメールアドレスの重複を確認します。
@@ -39,7 +49,7 @@ func (r *UserRepositoryImpl) ExistsByEmail(cxt context.Context, email string) (b
 	var count int64
 
 	// メールアドレスが一致するEntityの数を取得
-	if err := r.db.WithContext(cxt).
+	if err := conn(cxt, r.db).
 		Model(&userEntity.User{}).
 		Where("email = ? AND delete_flag = ?", email, false).
 		Count(&count).Error; err != nil {
@@ -49,9 +59,47 @@ func (r *UserRepositoryImpl) ExistsByEmail(cxt context.Context, email string) (b
 	return count > 0, nil
 }
 
+// FindByID はIDに一致する論理削除されていないユーザーを取得します。
+// 引数: コンテキスト, ユーザーID
+// 返り値: 一致したユーザー, 見つからない場合は UserNotFoundError, 取得に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) FindByID(cxt context.Context, id string) (*userEntity.User, error) {
+
+	var u userEntity.User
+	if err := conn(cxt, r.db).
+		Where("id = ? AND delete_flag = ?", id, false).
+		First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// FindByEmail はメールアドレスに一致する論理削除されていないユーザーを取得します。
+// 引数: コンテキスト, メールアドレス
+// 返り値: 一致したユーザー, 見つからない場合は UserNotFoundError, 取得に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) FindByEmail(cxt context.Context, email string) (*userEntity.User, error) {
+
+	var u userEntity.User
+	if err := conn(cxt, r.db).
+		Where("email = ? AND delete_flag = ?", email, false).
+		First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	return &u, nil
+}
+
 // FindByUser は指定した条件のいずれかに一致するユーザーを取得します。
 // 引数: コンテキスト, 検索条件としてのID/名前/メールアドレス（空文字は無視）
-// 返り値: 一致したユーザー, 見つからない/検索に失敗した場合はエラー
+// 返り値: 一致したユーザー, 見つからない場合は UserNotFoundError, 検索に失敗した場合はエラー
 // レシーバー: ユーザーリポジトリオブジェクト
 func (r *UserRepositoryImpl) FindByUser(cxt context.Context, id string, name string, email string) (*userEntity.User, error) {
 
@@ -71,32 +119,284 @@ func (r *UserRepositoryImpl) FindByUser(cxt context.Context, id string, name str
 		values = append(values, email)
 	}
 
-	// 論理削除されていないユーザーのみ対象
-	conditions = append(conditions, "delete_flag = ?")
-	values = append(values, false)
-
 	if len(conditions) == 0 {
 		return nil, errors.New("no search criteria provided")
 	}
 
+	// 論理削除されていないユーザーのみ対象
+	// 検索条件の OR とは別に AND で絞り込む
 	var u userEntity.User
-	err := r.db.WithContext(cxt).
+	err := conn(cxt, r.db).
 		Model(&userEntity.User{}).
 		Where(strings.Join(conditions, " OR "), values...).
+		Where("delete_flag = ?", false).
 		First(&u).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, userValueObj.UserNotFoundError
+		}
 		return nil, err
 	}
 
 	return &u, nil
 }
 
-// UpdateUser は既存ユーザー情報を更新します。
+// ListUsers は検索条件に一致するユーザーを 1 ページ分取得します。
+// 条件はすべて AND で結合し、名前・メールアドレスは部分一致で検索します。
+// Cursor が指定された場合はキーセット方式、そうでなければ Offset 方式でページングします。
+// 引数: コンテキスト, 検索条件（並び替え項目・取得件数は補完・検証済みであること）
+// 返り値: 1 ページ分のユーザーと総件数・次ページのカーソル, カーソル不正の場合は UserListCursorInvalidError, 取得に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) ListUsers(cxt context.Context, criteria userRepository.UserListCriteria) (*userRepository.UserPage, error) {
+
+	query := conn(cxt, r.db).Model(&userEntity.User{})
+
+	// 絞り込み条件
+	if criteria.Name != "" {
+		query = query.Where("name LIKE ? ESCAPE '\\'", "%"+escapeLike(criteria.Name)+"%")
+	}
+	if criteria.Email != "" {
+		query = query.Where("email LIKE ? ESCAPE '\\'", "%"+escapeLike(criteria.Email)+"%")
+	}
+	if criteria.Role != "" {
+		query = query.Where("role = ?", criteria.Role)
+	}
+	if criteria.SkillLevel != "" {
+		query = query.Where("skill_level = ?", criteria.SkillLevel)
+	}
+	if criteria.MinYearsOfExperience != nil {
+		query = query.Where("years_of_experience >= ?", *criteria.MinYearsOfExperience)
+	}
+	if criteria.MaxYearsOfExperience != nil {
+		query = query.Where("years_of_experience <= ?", *criteria.MaxYearsOfExperience)
+	}
+	if criteria.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *criteria.CreatedFrom)
+	}
+	if criteria.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *criteria.CreatedTo)
+	}
+	if !criteria.IncludeDeleted {
+		query = query.Where("delete_flag = ?", false)
+	}
+
+	// 総件数(ページングの影響を受けないよう、カーソル条件を加える前に数える)
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// 並び順(同値の場合の順序を安定させるため ID を第 2 キーにする)
+	column := string(criteria.SortField)
+	direction, comparator := "ASC", ">"
+	if criteria.SortDesc {
+		direction, comparator = "DESC", "<"
+	}
+	query = query.Order(column + " " + direction).Order("id " + direction)
+
+	// ページング
+	if criteria.Cursor != "" {
+		value, lastID, err := decodeUserCursor(criteria.SortField, criteria.Cursor)
+		if err != nil {
+			return nil, userValueObj.UserListCursorInvalidError
+		}
+		query = query.Where(
+			"("+column+" "+comparator+" ?) OR ("+column+" = ? AND id "+comparator+" ?)",
+			value, value, lastID,
+		)
+	} else if criteria.Offset > 0 {
+		query = query.Offset(criteria.Offset)
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得
+	var users []*userEntity.User
+	if err := query.Limit(criteria.Limit + 1).Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	page := &userRepository.UserPage{Users: users, Total: total}
+	if len(users) > criteria.Limit {
+		page.Users = users[:criteria.Limit]
+		last := page.Users[len(page.Users)-1]
+		cursor, err := encodeCursor(userSortValue(criteria.SortField, last), last.ID)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = cursor
+	}
+
+	return page, nil
+}
+
+// userSortValue はカーソルに格納する並び替え項目の値を取り出します。
+func userSortValue(field userRepository.UserSortField, u *userEntity.User) interface{} {
+	switch field {
+	case userRepository.UserSortByUpdatedAt:
+		return u.UpdatedAt
+	case userRepository.UserSortByName:
+		return u.Name
+	case userRepository.UserSortByEmail:
+		return u.Email
+	case userRepository.UserSortByYearsOfExperience:
+		return u.YearsOfExperience
+	default:
+		return u.CreatedAt
+	}
+}
+
+// decodeUserCursor はカーソルから並び替え項目の値を、項目に応じた型で復元します。
+func decodeUserCursor(field userRepository.UserSortField, cursor string) (interface{}, string, error) {
+	switch field {
+	case userRepository.UserSortByName, userRepository.UserSortByEmail:
+		var v string
+		id, err := decodeCursor(cursor, &v)
+		return v, id, err
+	case userRepository.UserSortByYearsOfExperience:
+		var v int
+		id, err := decodeCursor(cursor, &v)
+		return v, id, err
+	default:
+		var v time.Time
+		id, err := decodeCursor(cursor, &v)
+		return v, id, err
+	}
+}
+
+// escapeLike は LIKE 検索のワイルドカード文字をエスケープします。
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
+// UpdateUser は既存ユーザーのプロフィール（名前・メールアドレス・自己紹介・権限・スキルレベル・経験年数）を更新します。
+// パスワード・メールアドレスの確認・2 段階認証の状態はそれぞれ専用のメソッドで更新するため、ここでは書き戻しません
+// （取得後に別のリクエストで再設定されたパスワードなどを、取得時の古い値で上書きしないため）。
+// ただし、メールアドレスが変わる場合のみ確認済みの状態を解除します。
 // 引数: コンテキスト, 更新後のユーザーエンティティ（ID必須）
-// 返り値: 更新に失敗した場合はエラー
+// 返り値: 対象が存在しない場合は UserNotFoundError, メールアドレスが有効な他のユーザーと重複する場合は UserEmailAlreadyExistsError, 更新に失敗した場合はエラー
 // レシーバー: ユーザーリポジトリオブジェクト
 func (r *UserRepositoryImpl) UpdateUser(cxt context.Context, user *userEntity.User) error {
-	return r.db.WithContext(cxt).Model(&userEntity.User{}).Where("id = ?", user.ID).Updates(user).Error
+	// 空文字や 0 への更新も反映するため、構造体ではなくカラム名を指定して更新する
+	result := conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ? AND delete_flag = ?", user.ID, false).
+		Updates(map[string]interface{}{
+			"name":                user.Name,
+			"email":               user.Email,
+			"bio":                 user.Bio,
+			"role":                user.Role,
+			"skill_level":         user.SkillLevel,
+			"years_of_experience": user.YearsOfExperience,
+			"updated_at":          user.UpdatedAt,
+			"email_verified_at":   gorm.Expr("CASE WHEN email = ? THEN email_verified_at ELSE NULL END", user.Email),
+		})
+	if result.Error != nil {
+		if isUniqueViolation(result.Error, "users.email") {
+			return userValueObj.UserEmailAlreadyExistsError
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserNotFoundError
+	}
+
+	return nil
+}
+
+// UpdatePassword はユーザーのパスワードハッシュのみを更新します。
+// ハッシュの作り直しはユーザー情報の変更ではないため、更新日時は変更しません。
+// 引数: コンテキスト, ユーザーID, ハッシュ化されたパスワード
+// 返り値: 対象が存在しない場合は UserNotFoundError, 更新に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) UpdatePassword(cxt context.Context, id string, hashedPassword string) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ? AND delete_flag = ?", id, false).
+		UpdateColumn("password", hashedPassword)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserNotFoundError
+	}
+
+	return nil
+}
+
+// MarkEmailVerified はユーザーのメールアドレスを確認済みにします。
+// 確認メールの送信後にメールアドレスが変更されていた場合は更新しません。
+// 引数: コンテキスト, ユーザーID, 確認したメールアドレス, 確認日時
+// 返り値: 対象が存在しない・メールアドレスが変更されている場合は UserNotFoundError, 更新に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) MarkEmailVerified(cxt context.Context, id string, email string, verifiedAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ? AND email = ? AND delete_flag = ?", id, email, false).
+		UpdateColumn("email_verified_at", verifiedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserNotFoundError
+	}
+
+	return nil
+}
+
+// EnableTwoFactor はユーザーの 2 段階認証を有効にします。
+// 引数: コンテキスト, ユーザーID, 有効にした日時
+// 返り値: 対象が存在しない場合は UserNotFoundError, 更新に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) EnableTwoFactor(cxt context.Context, id string, enabledAt time.Time) error {
+
+	result := conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ? AND delete_flag = ?", id, false).
+		UpdateColumn("two_factor_enabled_at", enabledAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return userValueObj.UserNotFoundError
+	}
+
+	return nil
+}
+
+// RecordLoginFailure はログインの失敗回数を 1 加算し、失敗した日時を記録します。
+// 直前の失敗が resetBefore より前（または未記録）の場合は、1 回目として数え直します。
+// 同時に失敗した場合も数え漏れないよう、データベース上で加算します。
+// 引数: コンテキスト, ユーザーID, 失敗した日時, 失敗回数を数え直す基準の日時
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) RecordLoginFailure(cxt context.Context, id string, failedAt time.Time, resetBefore time.Time) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ? AND delete_flag = ?", id, false).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_count": gorm.Expr(
+				"CASE WHEN last_failed_login_at IS NULL OR last_failed_login_at < ? THEN 1 ELSE failed_login_count + 1 END",
+				resetBefore,
+			),
+			"last_failed_login_at": failedAt,
+		}).Error
+}
+
+// ResetLoginFailures はログインの失敗回数を 0 に戻します。
+// 引数: コンテキスト, ユーザーID
+// 返り値: 更新に失敗した場合はエラー
+// レシーバー: ユーザーリポジトリオブジェクト
+func (r *UserRepositoryImpl) ResetLoginFailures(cxt context.Context, id string) error {
+
+	return conn(cxt, r.db).
+		Model(&userEntity.User{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_count":   0,
+			"last_failed_login_at": nil,
+		}).Error
 }
 
 // DeleteUser は指定したユーザーを削除します。
@@ -105,8 +405,16 @@ func (r *UserRepositoryImpl) UpdateUser(cxt context.Context, user *userEntity.Us
 // レシーバー: ユーザーリポジトリオブジェクト
 func (r *UserRepositoryImpl) DeleteUser(cxt context.Context, id string) error {
 	// 物理削除ではなく論理削除（delete_flag を立てる）のみに変更
-	return r.db.WithContext(cxt).
+	return conn(cxt, r.db).
 		Model(&userEntity.User{}).
 		Where("id = ?", id).
 		Update("delete_flag", true).Error
 }
+
+// isUniqueViolation は指定したカラムの一意制約違反のエラーかを判定します。
+// SQLite と libsql でドライバーのエラーの型が異なるため、メッセージで判定します。
+// 引数: データベースのエラー, "テーブル名.カラム名"
+// 返り値: true=一意制約違反
+func isUniqueViolation(err error, column string) bool {
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: "+column)
+}
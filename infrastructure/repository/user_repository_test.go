@@ -0,0 +1,139 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"app/infrastructure/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestUserRepository_UpdateUser は、プロフィールの更新が取得後に別の操作で変更された
+// パスワード・2 段階認証の状態を上書きせず、メールアドレスが変わる場合のみ確認済みの状態を解除することを検証します。
+func TestUserRepository_UpdateUser(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	users := repository.NewUserRepository(conn)
+	now := time.Now()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := users.MarkEmailVerified(ctx, "user-1", "alice@example.com", now); err != nil {
+		t.Fatalf("MarkEmailVerified() error = %v", err)
+	}
+
+	// プロフィールの編集画面で取得した時点の値
+	stale, err := users.FindByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	// 取得後に別のリクエストでパスワードの再設定と 2 段階認証の有効化が行われる
+	if err := users.UpdatePassword(ctx, "user-1", "reset-hash"); err != nil {
+		t.Fatalf("UpdatePassword() error = %v", err)
+	}
+	if err := users.EnableTwoFactor(ctx, "user-1", now); err != nil {
+		t.Fatalf("EnableTwoFactor() error = %v", err)
+	}
+
+	// メールアドレスを変えないプロフィールの更新
+	stale.Name = "Alice Smith"
+	stale.Bio = ""
+	stale.UpdatedAt = now
+	if err := users.UpdateUser(ctx, stale); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	u, err := users.FindByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if u.Name != "Alice Smith" || u.Bio != "" {
+		t.Errorf("profile = (%q, %q), want updated", u.Name, u.Bio)
+	}
+	if u.Password != "reset-hash" {
+		t.Errorf("Password = %q, want the reset hash to be kept", u.Password)
+	}
+	if !u.IsTwoFactorEnabled() || !u.IsEmailVerified() {
+		t.Errorf("user = %+v, want two-factor and email verification to be kept", u)
+	}
+
+	// メールアドレスを変える更新は確認済みの状態のみ解除する
+	stale.ChangeEmail("alice.smith@example.com")
+	if err := users.UpdateUser(ctx, stale); err != nil {
+		t.Fatalf("UpdateUser(email) error = %v", err)
+	}
+	u, err = users.FindByID(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if u.Email != "alice.smith@example.com" || u.IsEmailVerified() {
+		t.Errorf("user = %+v, want new unverified email", u)
+	}
+	if u.Password != "reset-hash" || !u.IsTwoFactorEnabled() {
+		t.Errorf("user = %+v, want password and two-factor to be kept", u)
+	}
+}
+
+// TestUserRepository_RecordLoginFailure は、ログインの失敗が連続している間は回数を加算し、
+// 直前の失敗が基準より前の場合とリセット後は 1 回目として数え直すことを検証します。
+func TestUserRepository_RecordLoginFailure(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	conn := newTestDB(t)
+	users := repository.NewUserRepository(conn)
+	now := time.Now()
+
+	if err := users.CreateUser(ctx, newUser(t, "user-1", "alice@example.com")); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// reset が true の手順は失敗の記録の代わりにリセットする
+	steps := []struct {
+		name        string
+		failedAt    time.Time
+		resetBefore time.Time
+		reset       bool
+		wantCount   int
+	}{
+		{name: "first failure", failedAt: now, resetBefore: now.Add(-time.Hour), wantCount: 1},
+		{name: "consecutive failure", failedAt: now.Add(time.Minute), resetBefore: now.Add(-time.Hour), wantCount: 2},
+		{name: "failure after the window", failedAt: now.Add(2 * time.Hour), resetBefore: now.Add(time.Hour), wantCount: 1},
+		{name: "reset", reset: true, wantCount: 0},
+		{name: "failure after reset", failedAt: now, resetBefore: now.Add(-time.Hour), wantCount: 1},
+	}
+	for _, step := range steps {
+		var err error
+		if step.reset {
+			err = users.ResetLoginFailures(ctx, "user-1")
+		} else {
+			err = users.RecordLoginFailure(ctx, "user-1", step.failedAt, step.resetBefore)
+		}
+		if err != nil {
+			t.Fatalf("%s: error = %v", step.name, err)
+		}
+		u, err := users.FindByID(ctx, "user-1")
+		if err != nil {
+			t.Fatalf("%s: FindByID() error = %v", step.name, err)
+		}
+		if u.FailedLoginCount != step.wantCount {
+			t.Errorf("%s: FailedLoginCount = %d, want %d", step.name, u.FailedLoginCount, step.wantCount)
+		}
+		if (u.LastFailedLoginAt != nil) != (step.wantCount > 0) {
+			t.Errorf("%s: LastFailedLoginAt = %v, want set only while failures remain", step.name, u.LastFailedLoginAt)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id のソルト・ハッシュの長さ（バイト）
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// argon2Params は Argon2id のパラメーターです。
+// ハッシュから読み取ったパラメーターと現在の設定を == で比較し、作り直しが必要かを判定します。
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+// hashArgon2id は Argon2id でハッシュ化し、PHC 形式の文字列を返します。
+//
+//	$argon2id$v=19$m=65536,t=3,p=4$<ソルト>$<ハッシュ>
+//
+// ペッパーを使用する場合は、パラメーターに keyid=<ペッパーの識別子> を追加します。
+// 引数: ハッシュ化の入力, パラメーター, ペッパーの識別子（使用しない場合は空文字）
+// 返り値: PHC 形式のハッシュ, ソルトの生成に失敗した場合はエラー
+func hashArgon2id(input []byte, p argon2Params, keyID string) (string, error) {
+
+	salt := make([]byte, p.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey(input, salt, p.iterations, p.memory, p.parallelism, p.keyLength)
+
+	params := fmt.Sprintf("m=%d,t=%d,p=%d", p.memory, p.iterations, p.parallelism)
+	if keyID != "" {
+		params += ",keyid=" + keyID
+	}
+
+	return fmt.Sprintf("$argon2id$v=%d$%s$%s$%s",
+		argon2.Version,
+		params,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// parseArgon2id は PHC 形式のハッシュからパラメーターとペッパーの識別子を読み取ります。
+// 引数: PHC 形式のハッシュ
+// 返り値: パラメーター, ペッパーの識別子（使用していない場合は空文字）, 形式が正しいか
+func parseArgon2id(hash string) (argon2Params, string, bool) {
+
+	salt, key, p, keyID, ok := decodeArgon2id(hash)
+	if !ok {
+		return argon2Params{}, "", false
+	}
+	p.saltLength = uint32(len(salt))
+	p.keyLength = uint32(len(key))
+
+	return p, keyID, true
+}
+
+// compareArgon2id は入力を同じソルト・パラメーターでハッシュ化し、保存済みのハッシュと定数時間で比較します。
+// 引数: ハッシュ化の入力, PHC 形式のハッシュ
+// 返り値: 一致したか
+func compareArgon2id(input []byte, hash string) bool {
+
+	salt, key, p, _, ok := decodeArgon2id(hash)
+	if !ok {
+		return false
+	}
+
+	got := argon2.IDKey(input, salt, p.iterations, p.memory, p.parallelism, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(got, key) == 1
+}
+
+// decodeArgon2id は PHC 形式のハッシュを分解します。
+// 引数: PHC 形式のハッシュ
+// 返り値: ソルト, ハッシュ, パラメーター（ソルト・ハッシュの長さを除く）, ペッパーの識別子, 形式が正しいか
+func decodeArgon2id(hash string) (salt, key []byte, p argon2Params, keyID string, ok bool) {
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", ソルト, ハッシュ
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != algorithmArgon2id || parts[2] != fmt.Sprintf("v=%d", argon2.Version) {
+		return nil, nil, argon2Params{}, "", false
+	}
+
+	for _, kv := range strings.Split(parts[3], ",") {
+		name, value, found := strings.Cut(kv, "=")
+		if !found || value == "" {
+			return nil, nil, argon2Params{}, "", false
+		}
+		if name == "keyid" {
+			keyID = value
+			continue
+		}
+
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || n == 0 {
+			return nil, nil, argon2Params{}, "", false
+		}
+		switch name {
+		case "m":
+			p.memory = uint32(n)
+		case "t":
+			p.iterations = uint32(n)
+		case "p":
+			if n > 255 {
+				return nil, nil, argon2Params{}, "", false
+			}
+			p.parallelism = uint8(n)
+		default:
+			return nil, nil, argon2Params{}, "", false
+		}
+	}
+	if p.memory == 0 || p.iterations == 0 || p.parallelism == 0 {
+		return nil, nil, argon2Params{}, "", false
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return nil, nil, argon2Params{}, "", false
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, argon2Params{}, "", false
+	}
+
+	return salt, key, p, keyID, true
+}
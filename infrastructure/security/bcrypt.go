@@ -1,34 +1,39 @@
 package security
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"golang.org/x/crypto/bcrypt"
+)
 
-type BcryptPasswordHasher struct{}
-
-// パスワードハッシュ化コンストラクタ
-func NewBcryptPasswordHasher() *BcryptPasswordHasher {
-	return &BcryptPasswordHasher{}
+// bcryptParams は bcrypt のパラメーターです。
+type bcryptParams struct {
+	cost int
 }
 
-// パスワードハッシュ化
-// 引数: 平文パスワード
+// hashBcrypt は bcrypt でハッシュ化します。
+// 引数: 平文パスワード, パラメーター
 // 返り値: ハッシュ化されたパスワード, エラー
-// レシーバー: パスワードハッシュ化オブジェクト
-func (h *BcryptPasswordHasher) Hash(plainPassword string) (string, error) {
+func hashBcrypt(plainPassword string, p bcryptParams) (string, error) {
 
 	// bcrypt.GenerateFromPassword関数を使用してパスワードをハッシュ化
-	bytes, err := bcrypt.GenerateFromPassword([]byte(plainPassword), bcrypt.DefaultCost)
+	bytes, err := bcrypt.GenerateFromPassword([]byte(plainPassword), p.cost)
 
 	return string(bytes), err
 }
 
-// パスワード検証
+// compareBcrypt は bcrypt のハッシュを検証し、ハッシュのパラメーターを返します。
 // 引数: 平文パスワード, ハッシュ化されたパスワード
-// 返り値: 検証結果
-// レシーバー: パスワードハッシュ化オブジェクト
-func (h *BcryptPasswordHasher) Compare(plainPassword, hash string) bool {
+// 返り値: ハッシュのパラメーター, 一致したか
+func compareBcrypt(plainPassword, hash string) (bcryptParams, bool) {
 
 	// bcrypt.CompareHashAndPassword関数を使用してパスワードを検証
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(plainPassword))
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(plainPassword)); err != nil {
+		return bcryptParams{}, false
+	}
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return bcryptParams{}, false
+	}
 
-	return err == nil
+	return bcryptParams{cost: cost}, true
 }
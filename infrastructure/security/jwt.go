@@ -0,0 +1,107 @@
+package security
+
+import (
+	"app/infrastructure/config"
+	"app/infrastructure/logger"
+	"app/internal/application/port"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// アクセストークンの有効期間
+const AccessTokenTTL = 15 * time.Minute
+
+// JWT の発行者名
+const jwtIssuer = "outbook"
+
+type JWTTokenIssuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// accessClaims は JWT に埋め込むクレームです。
+type accessClaims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// JWT トークン発行コンストラクタ
+// 署名鍵は設定 security.jwt_secret（環境変数 JWT_SECRET）から取得します。
+// 署名鍵は必須で、未設定の場合はエラーを返します（通常は設定の検証 config.Validate で先に検出されます）。
+// 開発用に security.jwt_ephemeral_secret を指定した場合のみ、起動ごとにランダムな鍵を生成します
+// （再起動で発行済みトークンは無効になります）。
+func NewJWTTokenIssuer(cfg config.SecurityConfig) (*JWTTokenIssuer, error) {
+	secret := []byte(cfg.JWTSecret.Value())
+	if len(secret) == 0 {
+		if !cfg.JWTEphemeralSecret {
+			return nil, errors.New("security.jwt_secret is required")
+		}
+		logger.WarnJp("security.jwt_ephemeral_secret が指定されているため、一時的な署名鍵を生成します（開発用）")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate jwt secret: %w", err)
+		}
+	}
+
+	return &JWTTokenIssuer{secret: secret, ttl: AccessTokenTTL}, nil
+}
+
+// アクセストークン発行
+// 引数: ユーザーID, 権限
+// 返り値: 署名済みトークン, 有効期限, エラー
+// レシーバー: JWT トークン発行オブジェクト
+func (i *JWTTokenIssuer) IssueAccessToken(userID string, role string) (string, time.Time, error) {
+
+	now := time.Now()
+	expiresAt := now.Add(i.ttl)
+
+	claims := accessClaims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    jwtIssuer,
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	// HS256 で署名
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// アクセストークン検証
+// 引数: 署名済みトークン
+// 返り値: 認証情報, 署名不正・期限切れの場合はエラー
+// レシーバー: JWT トークン発行オブジェクト
+func (i *JWTTokenIssuer) ParseAccessToken(token string) (*port.AccessTokenClaims, error) {
+
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return i.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(jwtIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse access token: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("access token has no subject")
+	}
+
+	return &port.AccessTokenClaims{
+		UserID:    claims.Subject,
+		Role:      claims.Role,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package security
+
+import (
+	"testing"
+
+	"app/infrastructure/config"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestNewJWTTokenIssuer は、署名鍵が未設定の場合はエラーになり、
+// 設定した鍵または開発用の一時的な鍵でトークンを発行・検証できることを検証します。
+func TestNewJWTTokenIssuer(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		cfg     config.SecurityConfig
+		wantErr bool
+	}{
+		"configured secret": {cfg: config.SecurityConfig{JWTSecret: "test-secret"}},
+		"ephemeral secret":  {cfg: config.SecurityConfig{JWTEphemeralSecret: true}},
+		"missing secret":    {cfg: config.SecurityConfig{}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			issuer, err := NewJWTTokenIssuer(tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewJWTTokenIssuer() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewJWTTokenIssuer() error = %v", err)
+			}
+
+			token, _, err := issuer.IssueAccessToken("user-1", "user")
+			if err != nil {
+				t.Fatalf("IssueAccessToken() error = %v", err)
+			}
+			if _, err := issuer.ParseAccessToken(token); err != nil {
+				t.Errorf("ParseAccessToken() error = %v", err)
+			}
+		})
+	}
+}
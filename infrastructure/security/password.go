@@ -0,0 +1,162 @@
+package security
+
+import (
+	"app/infrastructure/config"
+	"app/infrastructure/metrics"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// パスワードハッシュのアルゴリズム
+const (
+	algorithmArgon2id = "argon2id"
+	algorithmBcrypt   = "bcrypt"
+)
+
+// PasswordHasher は複数のアルゴリズムに対応したパスワードハッシュ化オブジェクトです。
+//
+// 新しいハッシュは設定のアルゴリズム（既定は Argon2id）で作成し、
+// 保存済みのハッシュは形式からアルゴリズムを判別して検証します。
+// 保存済みのハッシュのアルゴリズム・パラメーター・ペッパーが現在の設定と異なる場合は
+// 検証結果とあわせて「作り直しが必要」と返し、ログイン時に新しい設定のハッシュへ置き換えられるようにします。
+type PasswordHasher struct {
+	algorithm string
+	bcrypt    bcryptParams
+	argon2    argon2Params
+	pepper    []byte
+	pepperID  string
+	metrics   *metrics.Metrics
+}
+
+// パスワードハッシュ化コンストラクタ
+// アルゴリズム・パラメーター・ペッパーは設定 security（環境変数 PASSWORD_HASH_ALGORITHM など）から取得します。
+// ハッシュ化・検証にかかった時間はメトリクスとトレースのスパンに記録します。
+// 引数: 認証まわりの設定, メトリクス
+// 返り値: パスワードハッシュ化オブジェクト
+func NewPasswordHasher(cfg config.SecurityConfig, m *metrics.Metrics) *PasswordHasher {
+
+	h := &PasswordHasher{
+		algorithm: cfg.PasswordHashAlgorithm,
+		bcrypt:    bcryptParams{cost: cfg.BcryptCost},
+		argon2: argon2Params{
+			memory:      uint32(cfg.Argon2.MemoryKiB),
+			iterations:  uint32(cfg.Argon2.Iterations),
+			parallelism: uint8(cfg.Argon2.Parallelism),
+			saltLength:  argon2SaltLength,
+			keyLength:   argon2KeyLength,
+		},
+		metrics: m,
+	}
+	if cfg.PasswordPepper != "" {
+		h.pepper = []byte(cfg.PasswordPepper.Value())
+		h.pepperID = pepperID(h.pepper)
+	}
+
+	return h
+}
+
+// パスワードハッシュ化
+// 引数: コンテキスト, 平文パスワード
+// 返り値: ハッシュ化されたパスワード, エラー
+// レシーバー: パスワードハッシュ化オブジェクト
+func (h *PasswordHasher) Hash(ctx context.Context, plainPassword string) (_ string, err error) {
+
+	_, span := tracing.Start(ctx, "PasswordHasher.Hash")
+	span.SetAttributes(attribute.String("hasher.algorithm", h.algorithm))
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	defer func() { h.metrics.ObservePasswordHash("hash", h.algorithm, time.Since(start)) }()
+
+	if h.algorithm == algorithmBcrypt {
+		return hashBcrypt(plainPassword, h.bcrypt)
+	}
+
+	return hashArgon2id(h.peppered(plainPassword, h.pepperID), h.argon2, h.pepperID)
+}
+
+// パスワード検証
+// 引数: コンテキスト, 平文パスワード, ハッシュ化されたパスワード
+// 返り値: 検証結果, 一致した場合にハッシュの作り直しが必要か
+// レシーバー: パスワードハッシュ化オブジェクト
+func (h *PasswordHasher) Compare(ctx context.Context, plainPassword, hash string) (match bool, needsRehash bool) {
+
+	algorithm := detectAlgorithm(hash)
+
+	_, span := tracing.Start(ctx, "PasswordHasher.Compare")
+	span.SetAttributes(attribute.String("hasher.algorithm", algorithm))
+	defer func() {
+		span.SetAttributes(attribute.Bool("hasher.needs_rehash", needsRehash))
+		span.End()
+	}()
+
+	start := time.Now()
+	defer func() { h.metrics.ObservePasswordHash("compare", algorithm, time.Since(start)) }()
+
+	switch algorithm {
+	case algorithmBcrypt:
+		params, ok := compareBcrypt(plainPassword, hash)
+		if !ok {
+			return false, false
+		}
+		return true, h.algorithm != algorithmBcrypt || params != h.bcrypt
+
+	case algorithmArgon2id:
+		params, keyID, ok := parseArgon2id(hash)
+		// 現在と異なるペッパーで作成されたハッシュは検証できない
+		if !ok || (keyID != "" && keyID != h.pepperID) {
+			return false, false
+		}
+		if !compareArgon2id(h.peppered(plainPassword, keyID), hash) {
+			return false, false
+		}
+		return true, h.algorithm != algorithmArgon2id || params != h.argon2 || keyID != h.pepperID
+	}
+
+	return false, false
+}
+
+// peppered はペッパーを使用する場合、パスワードにペッパーを HMAC-SHA256 で混ぜた値を返します。
+// ペッパーを使用せずに作成されたハッシュ（keyID が空）の検証では、パスワードをそのまま返します。
+// 引数: 平文パスワード, ハッシュに記録するペッパーの識別子
+// 返り値: ハッシュ化の入力
+// レシーバー: パスワードハッシュ化オブジェクト
+func (h *PasswordHasher) peppered(plainPassword, keyID string) []byte {
+	if keyID == "" {
+		return []byte(plainPassword)
+	}
+
+	mac := hmac.New(sha256.New, h.pepper)
+	mac.Write([]byte(plainPassword))
+	return mac.Sum(nil)
+}
+
+// pepperID はハッシュに記録するペッパーの識別子を返します。
+// ペッパーを変更した場合に、古いペッパーで作成されたハッシュを判別するために使用します。
+// 識別子からペッパーを推測できないよう、SHA-256 の先頭 6 バイトのみを使用します。
+func pepperID(pepper []byte) string {
+	sum := sha256.Sum256(pepper)
+	return base64.RawStdEncoding.EncodeToString(sum[:6])
+}
+
+// detectAlgorithm はハッシュの形式からアルゴリズムを判別します。
+// 判別できない場合は unknown を返します。
+func detectAlgorithm(hash string) string {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return algorithmArgon2id
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return algorithmBcrypt
+	}
+	return "unknown"
+}
+
+var _ port.PasswordHasher = (*PasswordHasher)(nil)
@@ -0,0 +1,91 @@
+package security
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"app/infrastructure/config"
+	"app/internal/domain/user/services"
+)
+
+// BreachedPasswordList は漏えい済みパスワードの一覧ファイルを読み込んだものです。
+// 大文字・小文字を区別せずに照会します。
+type BreachedPasswordList struct {
+	passwords map[string]struct{}
+}
+
+// パスワードポリシーコンストラクタ
+// 設定 security.password_policy からドメインのパスワードポリシーを組み立て、
+// 漏えい済みパスワードの一覧ファイルが指定されていれば起動時に読み込みます。
+// 引数: 認証まわりの設定
+// 返り値: パスワードポリシー, 一覧ファイルの読み込みに失敗した場合はエラー
+func NewPasswordPolicy(cfg config.SecurityConfig) (*services.PasswordPolicy, error) {
+
+	p := cfg.PasswordPolicy
+	policy := &services.PasswordPolicy{
+		MinLength:        p.MinLength,
+		MaxLength:        p.MaxLength,
+		RequireUppercase: p.RequireUppercase,
+		RequireLowercase: p.RequireLowercase,
+		RequireDigit:     p.RequireDigit,
+		RequireSymbol:    p.RequireSymbol,
+		RejectSimilar:    p.RejectSimilar,
+	}
+
+	if p.BreachedListFile != "" {
+		list, err := LoadBreachedPasswordList(p.BreachedListFile)
+		if err != nil {
+			return nil, err
+		}
+		policy.Breached = list
+	}
+
+	return policy, nil
+}
+
+// LoadBreachedPasswordList は漏えい済みパスワードの一覧ファイルを読み込みます。
+// 1 行に 1 つのパスワードを記載し、空行と # で始まる行は無視します。
+// 引数: 一覧ファイルのパス
+// 返り値: 漏えい済みパスワードの一覧, 読み込みに失敗した場合はエラー
+func LoadBreachedPasswordList(path string) (*BreachedPasswordList, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer f.Close()
+
+	list := &BreachedPasswordList{passwords: map[string]struct{}{}}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list.passwords[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	return list, nil
+}
+
+// IsBreached は一覧に含まれるパスワードかを判定します。
+// 引数: 平文パスワード
+// 返り値: true=漏えい済み
+// レシーバー: 漏えい済みパスワードの一覧
+func (l *BreachedPasswordList) IsBreached(password string) bool {
+	_, ok := l.passwords[strings.ToLower(password)]
+	return ok
+}
+
+// Len は一覧に含まれるパスワードの件数を返します。
+// レシーバー: 漏えい済みパスワードの一覧
+func (l *BreachedPasswordList) Len() int {
+	return len(l.passwords)
+}
+
+var _ services.BreachedPasswordChecker = (*BreachedPasswordList)(nil)
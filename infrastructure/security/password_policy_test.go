@@ -0,0 +1,81 @@
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"app/infrastructure/config"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestNewPasswordPolicy は、漏えい済みパスワードの一覧ファイルを読み込んだポリシーで
+// 一覧に含まれるパスワードが大文字・小文字を区別せずに拒否されることを検証します。
+func TestNewPasswordPolicy(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	content := "# 漏えい済みパスワード\nPassword123\n\nqwerty\r\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write breached list: %v", err)
+	}
+
+	list, err := LoadBreachedPasswordList(path)
+	if err != nil {
+		t.Fatalf("LoadBreachedPasswordList() error = %v", err)
+	}
+	if list.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", list.Len())
+	}
+
+	cfg := config.SecurityConfig{
+		PasswordPolicy: config.PasswordPolicyConfig{
+			MinLength:        8,
+			MaxLength:        128,
+			RequireUppercase: true,
+			RequireLowercase: true,
+			RequireDigit:     true,
+			BreachedListFile: path,
+		},
+	}
+	policy, err := NewPasswordPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewPasswordPolicy() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		password string
+		want     []value_obj.ErrorMessage
+	}{
+		"breached password":        {password: "Password123", want: []value_obj.ErrorMessage{value_obj.UserPasswordBreachedError}},
+		"not listed (extra char)":  {password: "PASSWORD123a", want: nil},
+		"breached password (case)": {password: "pAssword123", want: []value_obj.ErrorMessage{value_obj.UserPasswordBreachedError}},
+		"not breached":             {password: "Tr0ubadour", want: nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.Validate(tt.password, "", ""); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		missing := cfg
+		missing.PasswordPolicy.BreachedListFile = filepath.Join(t.TempDir(), "missing.txt")
+		if _, err := NewPasswordPolicy(missing); err == nil {
+			t.Error("NewPasswordPolicy() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package security
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"app/infrastructure/config"
+	"app/infrastructure/metrics"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// testSecurityConfig はテスト用に計算量を小さくした設定を返します。
+func testSecurityConfig(algorithm string, pepper config.Secret) config.SecurityConfig {
+	return config.SecurityConfig{
+		PasswordHashAlgorithm: algorithm,
+		BcryptCost:            4,
+		Argon2:                config.Argon2Config{MemoryKiB: 64, Iterations: 1, Parallelism: 1},
+		PasswordPepper:        pepper,
+	}
+}
+
+// TestPasswordHasher_Compare は、保存済みのハッシュのアルゴリズム・パラメーター・ペッパーと
+// 現在の設定の組み合わせごとに、検証結果と作り直しの要否を検証します。
+func TestPasswordHasher_Compare(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	argon2Cfg := testSecurityConfig("argon2id", "")
+	stronger := argon2Cfg
+	stronger.Argon2.Iterations = 2
+
+	tests := map[string]struct {
+		stored     config.SecurityConfig
+		current    config.SecurityConfig
+		password   string
+		wantMatch  bool
+		wantRehash bool
+		wantPrefix string
+	}{
+		"argon2id up to date": {
+			stored:     argon2Cfg,
+			current:    argon2Cfg,
+			password:   "Password1",
+			wantMatch:  true,
+			wantPrefix: "$argon2id$v=19$m=64,t=1,p=1$",
+		},
+		"argon2id wrong password": {
+			stored:     argon2Cfg,
+			current:    argon2Cfg,
+			password:   "Wrong1234",
+			wantPrefix: "$argon2id$",
+		},
+		"bcrypt is upgraded to argon2id": {
+			stored:     testSecurityConfig("bcrypt", ""),
+			current:    argon2Cfg,
+			password:   "Password1",
+			wantMatch:  true,
+			wantRehash: true,
+			wantPrefix: "$2a$04$",
+		},
+		"bcrypt cost change": {
+			stored:     testSecurityConfig("bcrypt", ""),
+			current:    config.SecurityConfig{PasswordHashAlgorithm: "bcrypt", BcryptCost: 5},
+			password:   "Password1",
+			wantMatch:  true,
+			wantRehash: true,
+			wantPrefix: "$2a$04$",
+		},
+		"argon2id parameter change": {
+			stored:     argon2Cfg,
+			current:    stronger,
+			password:   "Password1",
+			wantMatch:  true,
+			wantRehash: true,
+			wantPrefix: "$argon2id$",
+		},
+		"peppered argon2id up to date": {
+			stored:     testSecurityConfig("argon2id", "pepper-1"),
+			current:    testSecurityConfig("argon2id", "pepper-1"),
+			password:   "Password1",
+			wantMatch:  true,
+			wantPrefix: "$argon2id$v=19$m=64,t=1,p=1,keyid=",
+		},
+		"pepper added": {
+			stored:     argon2Cfg,
+			current:    testSecurityConfig("argon2id", "pepper-1"),
+			password:   "Password1",
+			wantMatch:  true,
+			wantRehash: true,
+			wantPrefix: "$argon2id$",
+		},
+		"pepper changed": {
+			stored:     testSecurityConfig("argon2id", "pepper-1"),
+			current:    testSecurityConfig("argon2id", "pepper-2"),
+			password:   "Password1",
+			wantPrefix: "$argon2id$",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			m := metrics.NewMetrics()
+
+			hash, err := NewPasswordHasher(tt.stored, m).Hash(ctx, "Password1")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(hash, tt.wantPrefix) {
+				t.Fatalf("hash = %q, want prefix %q", hash, tt.wantPrefix)
+			}
+
+			match, needsRehash := NewPasswordHasher(tt.current, m).Compare(ctx, tt.password, hash)
+			if match != tt.wantMatch || needsRehash != tt.wantRehash {
+				t.Errorf("Compare = (%v, %v), want (%v, %v)", match, needsRehash, tt.wantMatch, tt.wantRehash)
+			}
+		})
+	}
+}
+
+// TestPasswordHasher_CompareMalformed は、形式が不正なハッシュが一致しないと判定されることを検証します。
+func TestPasswordHasher_CompareMalformed(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	h := NewPasswordHasher(testSecurityConfig("argon2id", ""), metrics.NewMetrics())
+
+	for _, hash := range []string{
+		"",
+		"plain-text",
+		"$argon2id$v=19$m=64,t=1,p=1$",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=300$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1,x=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$!!$a2V5",
+	} {
+		if match, needsRehash := h.Compare(context.Background(), "Password1", hash); match || needsRehash {
+			t.Errorf("Compare(%q) = (%v, %v), want (false, false)", hash, match, needsRehash)
+		}
+	}
+}
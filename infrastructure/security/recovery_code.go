@@ -0,0 +1,73 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// リカバリーコードに使用する文字（読み違えやすい 0/o・1/l/i を除いた 31 文字）
+const recoveryCodeAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// リカバリーコードの桁数（ハイフンで半分に区切って表示する）
+const recoveryCodeLength = 10
+
+type RandomRecoveryCodeGenerator struct{}
+
+// リカバリーコード生成コンストラクタ
+func NewRandomRecoveryCodeGenerator() *RandomRecoveryCodeGenerator {
+	return &RandomRecoveryCodeGenerator{}
+}
+
+// コード生成
+// 引数: 生成する件数
+// 返り値: "xxxxx-xxxxx" 形式の平文コード, エラー
+// レシーバー: リカバリーコード生成オブジェクト
+func (g *RandomRecoveryCodeGenerator) Generate(n int) ([]string, error) {
+
+	codes := make([]string, 0, n)
+	buf := make([]byte, recoveryCodeLength)
+	for len(codes) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		// 剰余による偏りが出ないよう、256 を割り切れる範囲の値のみ使用する
+		var b strings.Builder
+		for _, v := range buf {
+			if int(v) >= 256-256%len(recoveryCodeAlphabet) {
+				break
+			}
+			b.WriteByte(recoveryCodeAlphabet[int(v)%len(recoveryCodeAlphabet)])
+		}
+		if b.Len() < recoveryCodeLength {
+			continue
+		}
+
+		code := b.String()
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+	}
+
+	return codes, nil
+}
+
+// コードのハッシュ化
+// 大文字・小文字、ハイフン・空白の有無を区別しないよう正規化した上で SHA-256 を求めます。
+// 引数: 平文コード
+// 返り値: 16進数表記のハッシュ値
+// レシーバー: リカバリーコード生成オブジェクト
+func (g *RandomRecoveryCodeGenerator) Hash(code string) string {
+
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,95 @@
+package security
+
+import (
+	"app/infrastructure/config"
+	"app/infrastructure/logger"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 暗号文の形式のバージョン（鍵・方式を変更した場合に古い暗号文と区別するための接頭辞）
+const secretCipherVersion = "v1:"
+
+type AESSecretCipher struct {
+	aead cipher.AEAD
+}
+
+// 暗号化コンストラクタ
+// 鍵は設定 security.two_factor.encryption_key（環境変数 TWO_FACTOR_ENCRYPTION_KEY）の SHA-256 から求め、AES-256-GCM で暗号化します。
+// 鍵は必須で、未設定の場合はエラーを返します（通常は設定の検証 config.Validate で先に検出されます）。
+// 開発用に security.two_factor.ephemeral_key を指定した場合のみ、起動ごとにランダムな鍵を生成します
+// （再起動で暗号化済みの値は復号できなくなります）。
+func NewAESSecretCipher(cfg config.SecurityConfig) (*AESSecretCipher, error) {
+	key := []byte(cfg.TwoFactor.EncryptionKey.Value())
+	if len(key) == 0 {
+		if !cfg.TwoFactor.EphemeralKey {
+			return nil, errors.New("security.two_factor.encryption_key is required")
+		}
+		logger.WarnJp("security.two_factor.ephemeral_key が指定されているため、一時的な暗号化鍵を生成します（開発用）")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate encryption key: %w", err)
+		}
+	}
+
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cipher: %w", err)
+	}
+
+	return &AESSecretCipher{aead: aead}, nil
+}
+
+// 暗号化
+// 引数: 平文, 関連データ（値の持ち主の ID など）
+// 返り値: "v1:" + Base64 表記の（ノンス + 暗号文）, エラー
+// レシーバー: 暗号化オブジェクト
+func (c *AESSecretCipher) Encrypt(plaintext string, associatedData string) (string, error) {
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), []byte(associatedData))
+
+	return secretCipherVersion + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// 復号
+// 引数: Encrypt で求めた暗号文, 暗号化時と同じ関連データ
+// 返り値: 平文, 形式の不正・改ざん・鍵や関連データの不一致の場合はエラー
+// レシーバー: 暗号化オブジェクト
+func (c *AESSecretCipher) Decrypt(ciphertext string, associatedData string) (string, error) {
+
+	encoded, ok := strings.CutPrefix(ciphertext, secretCipherVersion)
+	if !ok {
+		return "", errors.New("unsupported ciphertext version")
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("ciphertext too short")
+	}
+
+	nonce, body := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, body, []byte(associatedData))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt ciphertext: %w", err)
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,47 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// 生成するトークンのバイト長
+const secureTokenBytes = 32
+
+type RandomTokenGenerator struct{}
+
+// ランダムトークン生成コンストラクタ
+func NewRandomTokenGenerator() *RandomTokenGenerator {
+	return &RandomTokenGenerator{}
+}
+
+// トークン生成
+// 引数: なし
+// 返り値: URL セーフな平文トークン, SHA-256 ハッシュ値, エラー
+// レシーバー: ランダムトークン生成オブジェクト
+func (g *RandomTokenGenerator) Generate() (string, string, error) {
+
+	buf := make([]byte, secureTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return token, g.Hash(token), nil
+}
+
+// トークンのハッシュ化
+// 十分なエントロピーを持つトークンのため、ソルト無しの SHA-256 で保存用の値を求めます。
+// 引数: 平文トークン
+// 返り値: 16進数表記のハッシュ値
+// レシーバー: ランダムトークン生成オブジェクト
+func (g *RandomTokenGenerator) Hash(token string) string {
+
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
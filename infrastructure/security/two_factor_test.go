@@ -0,0 +1,190 @@
+package security
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"app/infrastructure/config"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestTOTPAuthenticator_Validate は RFC 6238 の付録 B のテストベクター（SHA-1 の下 6 桁）で
+// コードの検証と時間ステップを確認し、前後 1 ステップを超えるずれや桁数の違うコードを拒否することを検証します。
+func TestTOTPAuthenticator_Validate(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	totp := NewTOTPAuthenticator(config.SecurityConfig{TwoFactor: config.TwoFactorConfig{Issuer: "outbook"}})
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := map[string]struct {
+		at       int64
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		"t=59":                    {at: 59, code: "287082", wantStep: 1, wantOK: true},
+		"t=1111111109":            {at: 1111111109, code: "081804", wantStep: 37037036, wantOK: true},
+		"t=1111111111":            {at: 1111111111, code: "050471", wantStep: 37037037, wantOK: true},
+		"t=1234567890":            {at: 1234567890, code: "005924", wantStep: 41152263, wantOK: true},
+		"t=2000000000":            {at: 2000000000, code: "279037", wantStep: 66666666, wantOK: true},
+		"t=20000000000":           {at: 20000000000, code: "353130", wantStep: 666666666, wantOK: true},
+		"previous step":           {at: 1111111111 + 30, code: "050471", wantStep: 37037037, wantOK: true},
+		"two steps later":         {at: 1111111111 + 60, code: "050471"},
+		"spaces are ignored":      {at: 59, code: " 287 082 ", wantStep: 1, wantOK: true},
+		"wrong code":              {at: 59, code: "287083"},
+		"eight digits are denied": {at: 59, code: "94287082"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step, ok := totp.Validate(secret, tt.code, time.Unix(tt.at, 0))
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate() = (%d, %v), want (%d, %v)", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestTOTPAuthenticator_ProvisioningURI は、生成したシークレットで登録用 URI を組み立て、
+// 認証アプリが読み取るラベル・パラメーターが含まれることを検証します。
+func TestTOTPAuthenticator_ProvisioningURI(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	totp := NewTOTPAuthenticator(config.SecurityConfig{TwoFactor: config.TwoFactorConfig{Issuer: "outbook"}})
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("len(secret) = %d, want 32 (160 bits in Base32)", len(secret))
+	}
+
+	u, err := url.Parse(totp.ProvisioningURI(secret, "alice@example.com"))
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/outbook:alice@example.com" {
+		t.Errorf("ProvisioningURI() = %s, want otpauth://totp/outbook:alice@example.com", u)
+	}
+	q := u.Query()
+	for key, want := range map[string]string{"secret": secret, "issuer": "outbook", "algorithm": "SHA1", "digits": "6", "period": "30"} {
+		if got := q.Get(key); got != want {
+			t.Errorf("query %s = %q, want %q", key, got, want)
+		}
+	}
+}
+
+// TestAESSecretCipher は、暗号化した値が同じ鍵・関連データでのみ復号でき、
+// 鍵や関連データが異なる場合・改ざんされた場合は復号に失敗することを検証します。
+func TestAESSecretCipher(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	newCipher := func(key config.Secret) *AESSecretCipher {
+		c, err := NewAESSecretCipher(config.SecurityConfig{TwoFactor: config.TwoFactorConfig{EncryptionKey: key}})
+		if err != nil {
+			t.Fatalf("NewAESSecretCipher() error = %v", err)
+		}
+		return c
+	}
+	c := newCipher("test-key")
+
+	// 鍵が未設定で一時的な鍵も指定していない場合はエラー
+	if _, err := NewAESSecretCipher(config.SecurityConfig{}); err == nil {
+		t.Error("NewAESSecretCipher() without key error = nil, want error")
+	}
+
+	ciphertext, err := c.Encrypt("JBSWY3DPEHPK3PXP", "user-1")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if !strings.HasPrefix(ciphertext, "v1:") || strings.Contains(ciphertext, "JBSWY3DPEHPK3PXP") {
+		t.Errorf("Encrypt() = %q, want versioned ciphertext without plaintext", ciphertext)
+	}
+	if again, _ := c.Encrypt("JBSWY3DPEHPK3PXP", "user-1"); again == ciphertext {
+		t.Error("Encrypt() must use a fresh nonce for each call")
+	}
+
+	plaintext, err := c.Decrypt(ciphertext, "user-1")
+	if err != nil || plaintext != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("Decrypt() = (%q, %v), want original plaintext", plaintext, err)
+	}
+
+	// 暗号文の末尾 1 バイトを反転させる
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(ciphertext, "v1:"))
+	if err != nil {
+		t.Fatalf("failed to decode ciphertext: %v", err)
+	}
+	sealed[len(sealed)-1] ^= 0xff
+	tampered := "v1:" + base64.RawStdEncoding.EncodeToString(sealed)
+	tests := map[string]struct {
+		cipher         *AESSecretCipher
+		ciphertext     string
+		associatedData string
+	}{
+		"other user":     {cipher: c, ciphertext: ciphertext, associatedData: "user-2"},
+		"other key":      {cipher: newCipher("other-key"), ciphertext: ciphertext, associatedData: "user-1"},
+		"tampered":       {cipher: c, ciphertext: tampered, associatedData: "user-1"},
+		"unknown format": {cipher: c, ciphertext: "JBSWY3DPEHPK3PXP", associatedData: "user-1"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := tt.cipher.Decrypt(tt.ciphertext, tt.associatedData); err == nil {
+				t.Error("Decrypt() error = nil, want error")
+			}
+		})
+	}
+}
+
+// TestRandomRecoveryCodeGenerator は、生成したコードが重複せず、
+// 大文字・小文字やハイフン・空白の有無にかかわらず同じハッシュ値になることを検証します。
+func TestRandomRecoveryCodeGenerator(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	g := NewRandomRecoveryCodeGenerator()
+	codes, err := g.Generate(10)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		if len(code) != recoveryCodeLength+1 || code[recoveryCodeLength/2] != '-' {
+			t.Errorf("code %q, want xxxxx-xxxxx", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicated code %q", code)
+		}
+		seen[code] = true
+	}
+
+	want := g.Hash(codes[0])
+	compact := strings.ReplaceAll(codes[0], "-", "")
+	for _, input := range []string{strings.ToUpper(codes[0]), compact, " " + compact[:3] + " " + compact[3:] + " "} {
+		if got := g.Hash(input); got != want {
+			t.Errorf("Hash(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"errors"
+
+	apptracing "app/internal/application/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// spanKey はステートメントに実行中のスパンを保持するキーです。
+const spanKey = "tracing:span"
+
+// GormPlugin は SQL ごとにスパンを記録する GORM のプラグインです。
+// リポジトリから渡された context.Context のスパンの子として記録します。
+//
+//	db.Use(tracing.NewGormPlugin())
+type GormPlugin struct{}
+
+// GORM プラグインコンストラクタ
+// 返り値: GORM プラグイン
+func NewGormPlugin() *GormPlugin {
+	return &GormPlugin{}
+}
+
+// Name はプラグイン名を返します。
+// レシーバー: GORM プラグインオブジェクト
+func (p *GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize は各操作の前後にスパンの開始・終了のコールバックを登録します。
+// レシーバー: GORM プラグインオブジェクト
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+
+	cb := db.Callback()
+	register := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, r := range register {
+		if err := r.before("tracing:before_"+r.operation, p.before(r.operation)); err != nil {
+			return err
+		}
+		if err := r.after("tracing:after_"+r.operation, p.after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// before はスパンを開始するコールバックを返します。
+func (p *GormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		_, span := apptracing.Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", "sqlite"),
+				attribute.String("db.operation", operation),
+			),
+		)
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+// after は SQL とテーブル名を記録してスパンを終了します。
+// SQL はプレースホルダーのまま記録し、パラメーターの値は記録しません。
+func (p *GormPlugin) after(db *gorm.DB) {
+
+	v, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("db.statement", db.Statement.SQL.String()),
+		attribute.String("db.sql.table", db.Statement.Table),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	// レコードが見つからないことはリポジトリで扱う正常な結果のため、エラーにしない
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	apptracing.End(span, err)
+}
+
+var _ gorm.Plugin = (*GormPlugin)(nil)
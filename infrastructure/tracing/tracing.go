@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"app/infrastructure/buildinfo"
+	"app/infrastructure/config"
+	"app/infrastructure/lifecycle"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// トレースプロバイダーコンストラクタ
+//
+// 設定の送信先（none / stdout / otlp）でスパンを送信する TracerProvider を作成し、
+// otel のグローバルな TracerProvider・伝播形式（W3C Trace Context / Baggage）として設定します。
+// ユースケース・リポジトリ・パスワードハッシュのスパンはグローバルな TracerProvider に送られます。
+// 停止時は未送信のスパンを送信してから終了します。
+// 引数: トレースの設定, ライフサイクル
+// 返り値: トレースプロバイダー, 送信先の作成に失敗した場合はエラー
+func NewTracerProvider(cfg config.TracingConfig, lc *lifecycle.Lifecycle) (*sdktrace.TracerProvider, error) {
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(buildinfo.Get().Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	switch cfg.Exporter {
+	case "stdout":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	case "otlp":
+		var otlpOpts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithInsecure())
+		}
+		exp, err := otlptracehttp.New(context.Background(), otlpOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	case "none", "":
+		// スパンを記録しない
+		opts = append(opts, sdktrace.WithSampler(sdktrace.NeverSample()))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: %s", cfg.Exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	lc.Append(lifecycle.Hook{
+		Name:   "tracing",
+		OnStop: tp.Shutdown,
+	})
+
+	return tp, nil
+}
@@ -0,0 +1,125 @@
+package tracing_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"app/infrastructure/config"
+	"app/infrastructure/db"
+	"app/infrastructure/health"
+	"app/infrastructure/idgen"
+	"app/infrastructure/lifecycle"
+	"app/infrastructure/logger"
+	"app/infrastructure/metrics"
+	"app/infrastructure/repository"
+	"app/infrastructure/security"
+	userdto "app/internal/application/dto/user"
+	usecase "app/internal/application/usecase/user"
+	usersvc "app/internal/domain/user/services"
+	testverification "app/internal/test/verification"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// TestSpanTree は POST /users 相当の処理で、ユースケースのスパンの子として
+// メールアドレスの重複確認・パスワードのハッシュ化・ユーザー作成のスパンが記録されることを検証します。
+// グローバルな TracerProvider を差し替えるため、並行実行しません。
+func TestSpanTree(t *testing.T) {
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	log, err := logger.New(io.Discard, config.LogConfig{Level: "error", Format: "json"})
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	lc := lifecycle.New(log)
+	defer lc.Stop(context.Background())
+
+	conn, err := db.NewConnection(config.DatabaseConfig{URL: ":memory:"}, log, lc, health.NewRegistry(), metrics.NewMetrics())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	hasher := security.NewPasswordHasher(config.SecurityConfig{PasswordHashAlgorithm: "bcrypt", BcryptCost: 4}, metrics.NewMetrics())
+	uc := usecase.NewCreateUserUsecase(repository.NewUserRepository(conn), hasher, idgen.NewUUIDv7Generator(), repository.NewTransactionManager(conn), usersvc.DefaultPasswordPolicy(), testverification.NewFake(), log)
+
+	// ルートのスパン（HTTP サーバーのスパンの代わり）
+	ctx, root := tp.Tracer("test").Start(context.Background(), "POST /users")
+	_, err = uc.CreateUser(ctx, userdto.CreateUserCommand{Name: "Alice", Email: "alice@example.com", Password: "Password1"})
+	root.End()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	byName := map[string]tracetest.SpanStub{}
+	for _, s := range spans {
+		byName[s.Name] = s
+	}
+
+	usecaseSpan, ok := byName["CreateUserUsecase.CreateUser"]
+	if !ok {
+		t.Fatalf("usecase span not found: %v", spanNames(spans))
+	}
+	if usecaseSpan.Parent.SpanID() != root.SpanContext().SpanID() {
+		t.Errorf("usecase span must be a child of the root span")
+	}
+
+	// ユースケースの直下に記録されるスパン
+	children := map[string]int{}
+	for _, s := range spans {
+		if s.Parent.SpanID() == usecaseSpan.SpanContext.SpanID() {
+			children[s.Name]++
+		}
+	}
+	for _, name := range []string{"PasswordHasher.Hash", "gorm.create"} {
+		if children[name] == 0 {
+			t.Errorf("span %s not found under usecase span: %v", name, children)
+		}
+	}
+	if children["gorm.query"]+children["gorm.row"] == 0 {
+		t.Errorf("email duplication check span not found under usecase span: %v", children)
+	}
+	if s := byName["gorm.create"]; s.Status.Code == codes.Error {
+		t.Errorf("gorm.create must not be an error: %v", s.Status)
+	}
+}
+
+// TestSpanTree_Error はユースケースが失敗した場合にスパンがエラーとして記録されることを検証します。
+func TestSpanTree_Error(t *testing.T) {
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(prev)
+
+	uc := usecase.NewCreateUserUsecase(nil, nil, nil, nil, nil, nil, nil)
+	if _, err := uc.CreateUser(context.Background(), userdto.CreateUserCommand{}); err == nil {
+		t.Fatalf("expected validation error")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "CreateUserUsecase.CreateUser" {
+		t.Fatalf("unexpected spans: %v", spanNames(spans))
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("expected error status, got %v", spans[0].Status.Code)
+	}
+}
+
+// spanNames はエラーメッセージ用にスパン名を列挙します。
+func spanNames(spans tracetest.SpanStubs) []string {
+	names := make([]string, 0, len(spans))
+	for _, s := range spans {
+		names = append(names, s.Name)
+	}
+	return names
+}
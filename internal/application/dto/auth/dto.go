@@ -0,0 +1,99 @@
+package auth
+
+import "time"
+
+// LoginCommand はログイン時の入力データを保持します。
+type LoginCommand struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// VerifyTwoFactorCommand はログインの 2 段階目（コードの入力）の入力データを保持します。
+// TwoFactorToken はログイン時に返却したトークンです。Code（認証アプリのコード）と RecoveryCode のいずれか一方を指定します。
+type VerifyTwoFactorCommand struct {
+	TwoFactorToken string `json:"two_factor_token"`
+	Code           string `json:"code"`
+	RecoveryCode   string `json:"recovery_code"`
+}
+
+// ConfirmTOTPCommand は 2 段階認証の登録確認時の入力データを保持します。
+// Code は認証アプリに表示されたコードです。
+type ConfirmTOTPCommand struct {
+	Code string `json:"code"`
+}
+
+// RefreshTokenCommand はトークン再発行時の入力データを保持します。
+type RefreshTokenCommand struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LogoutCommand はログアウト時の入力データを保持します。
+type LogoutCommand struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ForgotPasswordCommand はパスワード再設定の申請時の入力データを保持します。
+type ForgotPasswordCommand struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordCommand はパスワード再設定時の入力データを保持します。
+// Token は再設定メールのリンクに含まれるトークンです。
+type ResetPasswordCommand struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// VerifyEmailQuery はメールアドレス確認時の入力データを保持します。
+// Token は確認メールのリンクに含まれるトークンです。
+type VerifyEmailQuery struct {
+	Token string `query:"token"`
+}
+
+// VerifyEmailResponse はメールアドレス確認の結果です。
+// 確認後の権限はトークンの再発行（POST /auth/refresh）またはログインし直した後に反映されます。
+type VerifyEmailResponse struct {
+	UserID          string    `json:"user_id"`
+	Email           string    `json:"email"`
+	EmailVerifiedAt time.Time `json:"email_verified_at"`
+}
+
+// TokenResponse はログイン・トークン再発行の結果として返却するトークンの組です。
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// LoginResponse はログインの結果です。
+//
+// 2 段階認証を有効にしているユーザーの場合はトークンの組を発行せず、TwoFactorRequired と
+// 2 段階目（POST /auth/login/two-factor）で使用する TwoFactorToken を返却します。
+// 2 段階認証が必須の権限で未設定の場合は、メンバーの権限でトークンの組を発行し、TwoFactorSetupRequired を返却します。
+type LoginResponse struct {
+	*TokenResponse
+	TwoFactorRequired      bool   `json:"two_factor_required,omitempty"`
+	TwoFactorToken         string `json:"two_factor_token,omitempty"`
+	TwoFactorExpiresIn     int64  `json:"two_factor_expires_in,omitempty"`
+	TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty"`
+}
+
+// EnrollTOTPResponse は 2 段階認証の登録開始の結果です。
+// Secret または ProvisioningURI（QR コードにして読み取る）を認証アプリに登録し、表示されたコードで登録を確認します。
+type EnrollTOTPResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// ConfirmTOTPResponse は 2 段階認証の登録確認の結果です。
+// RecoveryCodes は認証アプリを使用できない場合に 1 回ずつ使用できるコードで、この応答でのみ返却します。
+type ConfirmTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MeResponse は認証済みの操作者自身の情報です。
+type MeResponse struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
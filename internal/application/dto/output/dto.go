@@ -0,0 +1,128 @@
+package output
+
+import (
+	"time"
+
+	"app/internal/domain/output/entity"
+)
+
+// CreateOutputCommand はアウトプット作成時の入力データを保持します。
+// 所有者（UserID）はリクエストボディではなく、認証済みの操作者から決定します。
+// Type を省略した場合は other として扱い、EventDate は YYYY-MM-DD 形式で受け取ります。
+type CreateOutputCommand struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Type        string `json:"type"`
+	EventDate   string `json:"event_date"`
+	ISBN        string `json:"isbn"`
+}
+
+// GetOutputQuery はID指定によるアウトプット取得時の条件を表します。
+type GetOutputQuery struct {
+	ID string `param:"id"`
+}
+
+// ListOutputsQuery はアウトプット一覧取得時の検索条件とページングを表します。
+// UserID を省略した場合は操作者自身のアウトプットを対象にします。
+type ListOutputsQuery struct {
+	UserID string `query:"user_id"`
+	Type   string `query:"type"`
+	Status string `query:"status"`
+	Limit  int    `query:"limit"`
+	Offset int    `query:"offset"`
+}
+
+// UpdateOutputCommand はアウトプット更新時の入力データを保持します。
+// 部分更新のため、指定されなかった項目は nil のまま既存の値を維持します。
+// EventDate / ISBN に空文字を指定した場合は値を消去します。
+type UpdateOutputCommand struct {
+	ID          string  `param:"id" json:"-"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	URL         *string `json:"url"`
+	Type        *string `json:"type"`
+	EventDate   *string `json:"event_date"`
+	ISBN        *string `json:"isbn"`
+}
+
+// TransitionOutputStatusCommand はアウトプットのステータス変更時の入力データを保持します。
+// Action はリクエストボディではなくルート（POST /outputs/:id/publish など）から決定します。
+type TransitionOutputStatusCommand struct {
+	ID     string `param:"id"`
+	Action string `json:"-"`
+}
+
+// DeleteOutputCommand はアウトプット削除時の入力データを保持します。
+type DeleteOutputCommand struct {
+	ID string `param:"id"`
+}
+
+// OutputListResponse はアウトプット一覧のレスポンスエンベロープです。
+// 件数が 0 の場合も items は空配列として返却します。
+type OutputListResponse struct {
+	Items      []*OutputResponse `json:"items"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// Pagination はページング情報を表します。
+type Pagination struct {
+	Total   int64 `json:"total"`
+	Limit   int   `json:"limit"`
+	Offset  int   `json:"offset"`
+	HasNext bool  `json:"has_next"`
+}
+
+// OutputResponse は API レスポンスとして返却するアウトプット情報です。
+type OutputResponse struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	// 種別ごとの付加情報(該当しない種別では空文字)
+	EventDate string `json:"event_date"`
+	ISBN      string `json:"isbn"`
+	// ステータスを一度も変更していない場合は空文字 / null
+	StatusChangedBy string     `json:"status_changed_by"`
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// NewOutputResponse はアウトプットエンティティをレスポンス用の DTO に変換します。
+func NewOutputResponse(o *entity.Output) *OutputResponse {
+	var eventDate string
+	if o.EventDate != nil {
+		eventDate = o.EventDate.Format(time.DateOnly)
+	}
+
+	return &OutputResponse{
+		ID:              o.ID,
+		UserID:          o.UserID,
+		Title:           o.Title,
+		Description:     o.Description,
+		URL:             o.URL,
+		Type:            o.Type,
+		Status:          o.Status,
+		EventDate:       eventDate,
+		ISBN:            o.ISBN,
+		StatusChangedBy: o.StatusChangedBy,
+		StatusChangedAt: o.StatusChangedAt,
+		CreatedAt:       o.CreatedAt,
+		UpdatedAt:       o.UpdatedAt,
+	}
+}
+
+// OutputTypeResponse はアウトプット種別とその入力ルールを表します。
+// フロントエンドが種別の一覧や必須項目をハードコードせずに済むよう API で公開します。
+type OutputTypeResponse struct {
+	Type              string `json:"type"`
+	Label             string `json:"label"`
+	RequiresURL       bool   `json:"requires_url"`
+	RequiresCodeHost  bool   `json:"requires_code_host"`
+	RequiresEventDate bool   `json:"requires_event_date"`
+	RequiresISBN      bool   `json:"requires_isbn"`
+}
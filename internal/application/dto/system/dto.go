@@ -0,0 +1,23 @@
+package system
+
+// HealthResponse は稼働確認・準備確認の結果です。
+type HealthResponse struct {
+	Status string          `json:"status"`
+	Checks []CheckResponse `json:"checks,omitempty"`
+}
+
+// CheckResponse は依存先ごとの確認結果です。
+// 内部の構成が外部に漏れないよう、エラーの詳細はログにのみ出力します。
+type CheckResponse struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// VersionResponse はビルド情報です。
+type VersionResponse struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+	GoVersion string `json:"go_version"`
+}
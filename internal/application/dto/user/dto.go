@@ -1,5 +1,11 @@
 package user
 
+import (
+	"time"
+
+	"app/internal/domain/user/entity"
+)
+
 // CreateUserCommand はユーザー作成時の入力データを保持します。
 type CreateUserCommand struct {
 	Name     string `json:"name"`
@@ -8,9 +14,97 @@ type CreateUserCommand struct {
 	Bio      string `json:"bio"`
 }
 
-// FindUserQuery はユーザー検索時の条件を表します。
-type FindUserQuery struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+// GetUserQuery はID指定によるユーザー取得時の条件を表します。
+type GetUserQuery struct {
+	ID string `param:"id"`
+}
+
+// ListUsersQuery はユーザー一覧取得時の検索条件・並び順・ページングを表します。
+// 日時は YYYY-MM-DD または RFC3339 形式の文字列で受け取ります。
+type ListUsersQuery struct {
+	Name           string `query:"name"`
+	Email          string `query:"email"`
+	Role           string `query:"role"`
+	SkillLevel     string `query:"skill_level"`
+	MinYears       *int   `query:"min_years"`
+	MaxYears       *int   `query:"max_years"`
+	CreatedFrom    string `query:"created_from"`
+	CreatedTo      string `query:"created_to"`
+	IncludeDeleted bool   `query:"include_deleted"`
+	Sort           string `query:"sort"`
+	Order          string `query:"order"`
+	Limit          int    `query:"limit"`
+	Offset         int    `query:"offset"`
+	Cursor         string `query:"cursor"`
+}
+
+// UserListResponse はユーザー一覧のレスポンスエンベロープです。
+// 件数が 0 の場合も items は空配列として返却します。
+type UserListResponse struct {
+	Items      []*UserResponse `json:"items"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+// Pagination はページング情報を表します。
+// カーソル方式で取得した場合は Offset は 0、次ページが無い場合は NextCursor は空文字です。
+type Pagination struct {
+	Total      int64  `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor"`
+	HasNext    bool   `json:"has_next"`
+}
+
+// UpdateUserCommand はユーザー更新時の入力データを保持します。
+// 部分更新のため、指定されなかった項目は nil のまま既存の値を維持します。
+type UpdateUserCommand struct {
+	ID                string  `param:"id" json:"-"`
+	Name              *string `json:"name"`
+	Email             *string `json:"email"`
+	Bio               *string `json:"bio"`
+	Role              *string `json:"role"`
+	SkillLevel        *string `json:"skill_level"`
+	YearsOfExperience *int    `json:"years_of_experience"`
+}
+
+// DeleteUserCommand はユーザー削除時の入力データを保持します。
+type DeleteUserCommand struct {
+	ID string `param:"id"`
+}
+
+// UserResponse は API レスポンスとして返却するユーザー情報です。
+// パスワードハッシュなどの秘匿情報は含めません。
+type UserResponse struct {
+	ID                string     `json:"id"`
+	Name              string     `json:"name"`
+	Email             string     `json:"email"`
+	Role              string     `json:"role"`
+	Bio               string     `json:"bio"`
+	SkillLevel        string     `json:"skill_level"`
+	YearsOfExperience int        `json:"years_of_experience"`
+	EmailVerified     bool       `json:"email_verified"`
+	EmailVerifiedAt   *time.Time `json:"email_verified_at"`
+	TwoFactorEnabled  bool       `json:"two_factor_enabled"`
+	Deleted           bool       `json:"deleted"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// NewUserResponse はユーザーエンティティをレスポンス用の DTO に変換します。
+func NewUserResponse(u *entity.User) *UserResponse {
+	return &UserResponse{
+		ID:                u.ID,
+		Name:              u.Name,
+		Email:             u.Email,
+		Role:              u.Role,
+		Bio:               u.Bio,
+		SkillLevel:        u.SkillLevel,
+		YearsOfExperience: u.YearsOfExperience,
+		EmailVerified:     u.IsEmailVerified(),
+		EmailVerifiedAt:   u.EmailVerifiedAt,
+		TwoFactorEnabled:  u.IsTwoFactorEnabled(),
+		Deleted:           u.DeleteFlag,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
 }
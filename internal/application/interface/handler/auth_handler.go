@@ -0,0 +1,233 @@
+package handler
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/interface/httperror"
+	"app/internal/application/policy"
+	usecase "app/internal/application/usecase/auth"
+	"app/internal/domain/user/value_obj"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuthHandler は HTTP レイヤから認証関連のユースケースを呼び出すためのハンドラです。
+//
+// ログイン・トークン再発行・ログアウト・パスワード再設定・メールアドレス確認と 2 段階認証のエンドポイントを提供し、
+// トークンの発行や失効といった具体的な処理は各ユースケースに委譲します。
+type AuthHandler struct {
+	login     *usecase.LoginUsecase
+	twoFactor *usecase.VerifyTwoFactorUsecase
+	refresh   *usecase.RefreshTokenUsecase
+	logout    *usecase.LogoutUsecase
+	forgot    *usecase.ForgotPasswordUsecase
+	reset     *usecase.ResetPasswordUsecase
+	verify    *usecase.VerifyEmailUsecase
+	resend    *usecase.ResendVerificationUsecase
+	enroll    *usecase.EnrollTOTPUsecase
+	confirm   *usecase.ConfirmTOTPUsecase
+}
+
+// NewAuthHandler は AuthHandler のコンストラクタです。
+func NewAuthHandler(
+	login *usecase.LoginUsecase,
+	twoFactor *usecase.VerifyTwoFactorUsecase,
+	refresh *usecase.RefreshTokenUsecase,
+	logout *usecase.LogoutUsecase,
+	forgot *usecase.ForgotPasswordUsecase,
+	reset *usecase.ResetPasswordUsecase,
+	verify *usecase.VerifyEmailUsecase,
+	resend *usecase.ResendVerificationUsecase,
+	enroll *usecase.EnrollTOTPUsecase,
+	confirm *usecase.ConfirmTOTPUsecase,
+) *AuthHandler {
+	return &AuthHandler{login: login, twoFactor: twoFactor, refresh: refresh, logout: logout, forgot: forgot, reset: reset, verify: verify, resend: resend, enroll: enroll, confirm: confirm}
+}
+
+// Login は POST /auth/login を処理します。
+//
+//  1. リクエストボディを LoginCommand にバインド（失敗時は 400）
+//  2. 必須入力が欠けている場合は 400、認証に失敗した場合は 401 を返却
+//  3. 成功時は 200 OK とトークンの組を返却
+//     （2 段階認証を有効にしている場合はトークンの組の代わりに 2 段階目で使用するトークンを返却）
+func (h *AuthHandler) Login(c echo.Context) error {
+
+	var cmd authdto.LoginCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.login.Login(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// VerifyTwoFactor は POST /auth/login/two-factor を処理します。
+//
+//  1. リクエストボディを VerifyTwoFactorCommand にバインド（失敗時は 400）
+//  2. 必須入力が欠けている場合・コードが誤っている場合は 400、
+//     ログイン時のトークンが無効・使用済み・期限切れ・入力回数の上限に達している場合は 401 を返却
+//  3. 成功時は 200 OK とトークンの組を返却
+func (h *AuthHandler) VerifyTwoFactor(c echo.Context) error {
+
+	var cmd authdto.VerifyTwoFactorCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.twoFactor.VerifyTwoFactor(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// Refresh は POST /auth/refresh を処理します。
+// 無効・失効済み・期限切れのリフレッシュトークンには 401 を返却します。
+func (h *AuthHandler) Refresh(c echo.Context) error {
+
+	var cmd authdto.RefreshTokenCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.refresh.Refresh(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// Logout は POST /auth/logout を処理します。
+// トークンが未知・失効済みであっても 204 No Content を返却します。
+func (h *AuthHandler) Logout(c echo.Context) error {
+
+	var cmd authdto.LogoutCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	if err := h.logout.Logout(c.Request().Context(), cmd); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ForgotPassword は POST /auth/password/forgot を処理します。
+// 登録済みのメールアドレスかどうかを推測できないよう、未登録のメールアドレスでも 204 No Content を返却します。
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+
+	var cmd authdto.ForgotPasswordCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	if err := h.forgot.ForgotPassword(c.Request().Context(), cmd); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResetPassword は POST /auth/password/reset を処理します。
+//
+//  1. リクエストボディを ResetPasswordCommand にバインド（失敗時は 400）
+//  2. トークンが無効・使用済み・期限切れの場合は 400、パスワードがポリシーに違反する場合は 422 を返却
+//  3. 成功時は 204 No Content を返却（再度ログインが必要）
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+
+	var cmd authdto.ResetPasswordCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	if err := h.reset.ResetPassword(c.Request().Context(), cmd); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// VerifyEmail は GET /auth/verify を処理します。
+//
+//  1. クエリパラメーター token を VerifyEmailQuery にバインド（失敗時は 400）
+//  2. トークンが無効・使用済み・期限切れの場合は 400 を返却
+//  3. 成功時は 200 OK と確認したメールアドレスを返却（権限はトークンの再発行後に反映）
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+
+	var query authdto.VerifyEmailQuery
+	if err := c.Bind(&query); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.verify.VerifyEmail(c.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ResendVerification は POST /auth/verify/resend を処理します。
+// 確認済みの場合は 409、再送の間隔・回数の上限に達している場合は 429 を返却し、
+// 成功時は 204 No Content を返却します。
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+
+	if err := h.resend.ResendVerification(c.Request().Context()); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// EnrollTOTP は POST /auth/two-factor/totp を処理します。
+// 2 段階認証を有効にしている場合は 409 を返却し、
+// 成功時は 200 OK と認証アプリに登録するシークレット・URI を返却します（確認するまでは有効になりません）。
+func (h *AuthHandler) EnrollTOTP(c echo.Context) error {
+
+	res, err := h.enroll.EnrollTOTP(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ConfirmTOTP は POST /auth/two-factor/totp/confirm を処理します。
+//
+//  1. リクエストボディを ConfirmTOTPCommand にバインド（失敗時は 400）
+//  2. 登録を開始していない場合・コードが誤っている場合は 400、有効にしている場合は 409 を返却
+//  3. 成功時は 200 OK とリカバリーコードを返却（発行済みのリフレッシュトークンは失効するため、再度ログインが必要）
+func (h *AuthHandler) ConfirmTOTP(c echo.Context) error {
+
+	var cmd authdto.ConfirmTOTPCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.confirm.ConfirmTOTP(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// Me は GET /auth/me を処理します。
+// Authenticate ミドルウェアで格納された操作者の ID と権限を返却し、
+// フロントエンドが表示する画面を切り替えられるようにします。
+func (h *AuthHandler) Me(c echo.Context) error {
+
+	p, ok := policy.PrincipalFromContext(c.Request().Context())
+	if !ok {
+		return value_obj.UserUnauthenticatedError
+	}
+
+	return c.JSON(http.StatusOK, authdto.MeResponse{UserID: p.UserID, Role: string(p.Role)})
+}
@@ -0,0 +1,444 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	authUsecase "app/internal/application/usecase/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// testRefreshTokenRepository は認証ハンドラーのテスト用に、リフレッシュトークンをメモリ上で保持します。
+type testRefreshTokenRepository struct {
+	tokens map[string]*entity.RefreshToken
+}
+
+func (m *testRefreshTokenRepository) CreateRefreshToken(_ context.Context, t *entity.RefreshToken) error {
+	m.tokens[t.TokenHash] = t
+	return nil
+}
+
+func (m *testRefreshTokenRepository) FindByTokenHash(_ context.Context, hash string) (*entity.RefreshToken, error) {
+	if t, ok := m.tokens[hash]; ok {
+		return t, nil
+	}
+	return nil, value_obj.UserRefreshTokenInvalidError
+}
+
+func (m *testRefreshTokenRepository) RevokeRefreshToken(_ context.Context, hash string, replacedBy string, at time.Time) error {
+	t, ok := m.tokens[hash]
+	if !ok || t.RevokedAt != nil {
+		return value_obj.UserRefreshTokenInvalidError
+	}
+	t.RevokedAt = &at
+	t.ReplacedBy = replacedBy
+	return nil
+}
+
+func (m *testRefreshTokenRepository) RevokeFamily(_ context.Context, familyID string, at time.Time) error {
+	for _, t := range m.tokens {
+		if t.FamilyID == familyID {
+			t.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+func (m *testRefreshTokenRepository) RevokeByUserID(_ context.Context, userID string, at time.Time) error {
+	for _, t := range m.tokens {
+		if t.UserID == userID {
+			t.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+var _ repository.RefreshTokenRepository = (*testRefreshTokenRepository)(nil)
+
+// testTokenIssuer / testTokenGenerator は署名や乱数を使わない決定的なテスト実装です。
+type testTokenIssuer struct{}
+
+func (testTokenIssuer) IssueAccessToken(userID string, role string) (string, time.Time, error) {
+	return "access-" + userID, time.Now().Add(time.Minute), nil
+}
+
+func (testTokenIssuer) ParseAccessToken(string) (*port.AccessTokenClaims, error) {
+	return nil, value_obj.UserRefreshTokenInvalidError
+}
+
+type testTokenGenerator struct{}
+
+func (testTokenGenerator) Generate() (string, string, error) {
+	return "refresh-token", "hash-refresh-token", nil
+}
+
+func (testTokenGenerator) Hash(token string) string {
+	return "hash-" + token
+}
+
+// TestAuthHandler_Login はログインハンドラーのステータスコードを検証します。
+//
+// - Bind 失敗時に 400
+// - 認証失敗時に 401
+// - 正常系で 200 とトークンの組
+func TestAuthHandler_Login(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	newHandler := func() *AuthHandler {
+		repoMock := &testUserRepository{
+			findByEmailFn: func(_ context.Context, email string) (*entity.User, error) {
+				if email == "alice@example.com" {
+					return &entity.User{ID: "user-1", Email: email, Password: "hashed-Password1"}, nil
+				}
+				return nil, value_obj.UserNotFoundError
+			},
+		}
+		tokens := &testRefreshTokenRepository{tokens: map[string]*entity.RefreshToken{}}
+		login := authUsecase.NewLoginUsecase(repoMock, tokens, nil, &testPasswordHasher{}, testTokenIssuer{}, testTokenGenerator{}, testlogger.NewPortLogger(t), authUsecase.TwoFactorOptions{}, authUsecase.LoginLockoutOptions{})
+		return NewAuthHandler(login, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	tests := map[string]struct {
+		body     string
+		wantCode int
+	}{
+		"bind error returns 400": {
+			body:     `invalid-json`,
+			wantCode: http.StatusBadRequest,
+		},
+		"wrong password returns 401": {
+			body:     `{"email":"alice@example.com","password":"Wrong1234"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		"unknown email returns 401": {
+			body:     `{"email":"bob@example.com","password":"Password1"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		"success returns 200": {
+			body:     `{"email":"alice@example.com","password":"Password1"}`,
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("AuthHandler Login テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			serve(c, newHandler().Login)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rec.Code, tt.wantCode)
+			}
+
+			if tt.wantCode == http.StatusOK {
+				var res authdto.TokenResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if res.AccessToken != "access-user-1" || res.RefreshToken != "refresh-token" {
+					t.Fatalf("unexpected tokens: %+v", res)
+				}
+			}
+		})
+	}
+}
+
+// TestAuthHandler_PasswordReset はパスワード再設定のハンドラーのステータスコードを検証します。
+//
+// - Bind 失敗時に 400
+// - 必須入力の不足・無効なトークンで 400
+// - 未登録のメールアドレスでも 204（登録の有無を推測させない）
+func TestAuthHandler_PasswordReset(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	newHandler := func(t *testing.T) *AuthHandler {
+		repoMock := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return nil, value_obj.UserNotFoundError
+			},
+		}
+		forgot := authUsecase.NewForgotPasswordUsecase(repoMock, nil, testTokenGenerator{}, nil, nil, testlogger.NewPortLogger(t), authUsecase.PasswordResetOptions{})
+		// 申請は応答の後に処理されるため、テストの終了前に完了を待つ
+		t.Cleanup(func() { _ = forgot.Wait(context.Background()) })
+		reset := authUsecase.NewResetPasswordUsecase(repoMock, nil, nil, &testPasswordHasher{}, testTokenGenerator{}, nil, nil, testlogger.NewPortLogger(t))
+		return NewAuthHandler(nil, nil, nil, nil, forgot, reset, nil, nil, nil, nil)
+	}
+
+	tests := map[string]struct {
+		path     string
+		body     string
+		wantCode int
+	}{
+		"forgot bind error returns 400": {
+			path:     "/auth/password/forgot",
+			body:     `invalid-json`,
+			wantCode: http.StatusBadRequest,
+		},
+		"forgot without email returns 400": {
+			path:     "/auth/password/forgot",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+		"forgot unknown email returns 204": {
+			path:     "/auth/password/forgot",
+			body:     `{"email":"bob@example.com"}`,
+			wantCode: http.StatusNoContent,
+		},
+		"reset bind error returns 400": {
+			path:     "/auth/password/reset",
+			body:     `invalid-json`,
+			wantCode: http.StatusBadRequest,
+		},
+		"reset without token returns 400": {
+			path:     "/auth/password/reset",
+			body:     `{"password":"NewPassw0rd"}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("AuthHandler PasswordReset テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := newHandler(t)
+			fn := h.ForgotPassword
+			if tt.path == "/auth/password/reset" {
+				fn = h.ResetPassword
+			}
+			serve(c, fn)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body: %s)", rec.Code, tt.wantCode, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestAuthHandler_EmailVerification はメールアドレス確認のハンドラーのステータスコードを検証します。
+//
+// - トークンの指定が無い確認で 400
+// - 未認証の再送で 401
+// - 確認済みのユーザーの再送で 409
+func TestAuthHandler_EmailVerification(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+	verifiedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newHandler := func(t *testing.T) *AuthHandler {
+		repoMock := &testUserRepository{
+			findByIDFn: func(context.Context, string) (*entity.User, error) {
+				return &entity.User{ID: "user-1", Email: "alice@example.com", Role: "member", EmailVerifiedAt: &verifiedAt}, nil
+			},
+		}
+		verify := authUsecase.NewVerifyEmailUsecase(repoMock, nil, testTokenGenerator{}, nil, testlogger.NewPortLogger(t))
+		resend := authUsecase.NewResendVerificationUsecase(repoMock, nil, nil, authUsecase.EmailVerificationOptions{})
+		return NewAuthHandler(nil, nil, nil, nil, nil, nil, verify, resend, nil, nil)
+	}
+
+	tests := map[string]struct {
+		method    string
+		path      string
+		principal *policy.Principal
+		wantCode  int
+	}{
+		"verify without token returns 400": {
+			method:   http.MethodGet,
+			path:     "/auth/verify",
+			wantCode: http.StatusBadRequest,
+		},
+		"resend unauthenticated returns 401": {
+			method:   http.MethodPost,
+			path:     "/auth/verify/resend",
+			wantCode: http.StatusUnauthorized,
+		},
+		"resend already verified returns 409": {
+			method:    http.MethodPost,
+			path:      "/auth/verify/resend",
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			wantCode:  http.StatusConflict,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("AuthHandler EmailVerification テストケース開始: %s", name)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.principal != nil {
+				req = req.WithContext(policy.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := newHandler(t)
+			fn := h.VerifyEmail
+			if tt.method == http.MethodPost {
+				fn = h.ResendVerification
+			}
+			serve(c, fn)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body: %s)", rec.Code, tt.wantCode, rec.Body.String())
+			}
+		})
+	}
+}
+
+// testTwoFactorChallengeRepository はログインの 2 段階目のチャレンジが 1 件も無い状態を表すテストリポジトリです。
+type testTwoFactorChallengeRepository struct{}
+
+func (testTwoFactorChallengeRepository) CreateTwoFactorChallenge(context.Context, *entity.TwoFactorChallenge) error {
+	return nil
+}
+
+func (testTwoFactorChallengeRepository) FindByTokenHash(context.Context, string) (*entity.TwoFactorChallenge, error) {
+	return nil, value_obj.UserTwoFactorChallengeInvalidError
+}
+
+func (testTwoFactorChallengeRepository) IncrementAttempts(context.Context, string) error {
+	return nil
+}
+
+func (testTwoFactorChallengeRepository) UseTwoFactorChallenge(context.Context, string, time.Time) error {
+	return value_obj.UserTwoFactorChallengeInvalidError
+}
+
+var _ repository.TwoFactorChallengeRepository = testTwoFactorChallengeRepository{}
+
+// TestAuthHandler_TwoFactor は 2 段階認証のハンドラーのステータスコードを検証します。
+//
+// - コードの指定が無いログインの 2 段階目・登録確認で 400
+// - 無効なトークンでのログインの 2 段階目で 401
+// - 未認証の登録開始で 401
+// - 2 段階認証を有効にしているユーザーの登録開始で 409
+func TestAuthHandler_TwoFactor(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+	enabledAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newHandler := func(t *testing.T) *AuthHandler {
+		repoMock := &testUserRepository{
+			findByIDFn: func(context.Context, string) (*entity.User, error) {
+				return &entity.User{ID: "user-1", Email: "alice@example.com", Role: "admin", EmailVerifiedAt: &enabledAt, TwoFactorEnabledAt: &enabledAt}, nil
+			},
+		}
+		twoFactor := authUsecase.NewVerifyTwoFactorUsecase(repoMock, nil, testTwoFactorChallengeRepository{}, nil, nil, nil, nil, nil, testTokenIssuer{}, testTokenGenerator{}, nil, testlogger.NewPortLogger(t), authUsecase.TwoFactorOptions{}, authUsecase.LoginLockoutOptions{})
+		enroll := authUsecase.NewEnrollTOTPUsecase(repoMock, nil, nil, nil)
+		confirm := authUsecase.NewConfirmTOTPUsecase(repoMock, nil, nil, nil, nil, nil, nil, nil, testlogger.NewPortLogger(t), authUsecase.TwoFactorOptions{})
+		return NewAuthHandler(nil, twoFactor, nil, nil, nil, nil, nil, nil, enroll, confirm)
+	}
+
+	tests := map[string]struct {
+		route     func(h *AuthHandler) echo.HandlerFunc
+		body      string
+		principal *policy.Principal
+		wantCode  int
+	}{
+		"login two-factor without code returns 400": {
+			route:    func(h *AuthHandler) echo.HandlerFunc { return h.VerifyTwoFactor },
+			body:     `{"two_factor_token":"token"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		"login two-factor with unknown token returns 401": {
+			route:    func(h *AuthHandler) echo.HandlerFunc { return h.VerifyTwoFactor },
+			body:     `{"two_factor_token":"token","code":"123456"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		"enroll unauthenticated returns 401": {
+			route:    func(h *AuthHandler) echo.HandlerFunc { return h.EnrollTOTP },
+			wantCode: http.StatusUnauthorized,
+		},
+		"enroll already enabled returns 409": {
+			route:     func(h *AuthHandler) echo.HandlerFunc { return h.EnrollTOTP },
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Admin},
+			wantCode:  http.StatusConflict,
+		},
+		"confirm without code returns 400": {
+			route:     func(h *AuthHandler) echo.HandlerFunc { return h.ConfirmTOTP },
+			body:      `{}`,
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Admin},
+			wantCode:  http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("AuthHandler TwoFactor テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.principal != nil {
+				req = req.WithContext(policy.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			serve(c, tt.route(newHandler(t)))
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d (body: %s)", rec.Code, tt.wantCode, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"app/internal/application/dto/output"
+	"app/internal/application/interface/httperror"
+	usecase "app/internal/application/usecase/output"
+	outputValueObj "app/internal/domain/output/value_obj"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OutputHandler は HTTP レイヤからアウトプット関連のユースケースを呼び出すためのハンドラです。
+//
+// アウトプットの所有者はユースケース側でコンテキストの操作者から決定するため、
+// ハンドラはリクエストの詰め替えと HTTP ステータスコードの決定のみを担当します。
+type OutputHandler struct {
+	create     *usecase.CreateOutputUsecase
+	get        *usecase.GetOutputUsecase
+	list       *usecase.ListOutputsUsecase
+	update     *usecase.UpdateOutputUsecase
+	remove     *usecase.DeleteOutputUsecase
+	transition *usecase.TransitionOutputStatusUsecase
+	types      *usecase.ListOutputTypesUsecase
+}
+
+// NewOutputHandler は OutputHandler のコンストラクタです。
+func NewOutputHandler(
+	create *usecase.CreateOutputUsecase,
+	get *usecase.GetOutputUsecase,
+	list *usecase.ListOutputsUsecase,
+	update *usecase.UpdateOutputUsecase,
+	remove *usecase.DeleteOutputUsecase,
+	transition *usecase.TransitionOutputStatusUsecase,
+	types *usecase.ListOutputTypesUsecase,
+) *OutputHandler {
+	return &OutputHandler{create: create, get: get, list: list, update: update, remove: remove, transition: transition, types: types}
+}
+
+// CreateOutput は POST /outputs を処理します。
+// 作成に成功した場合は作成したアウトプットを 201 Created で返却し、Location ヘッダーに /outputs/{id} を設定します。
+func (h *OutputHandler) CreateOutput(c echo.Context) error {
+
+	var cmd output.CreateOutputCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.create.CreateOutput(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, "/outputs/"+url.PathEscape(res.ID))
+	return c.JSON(http.StatusCreated, res)
+}
+
+// GetOutput は GET /outputs/:id を処理します。
+func (h *OutputHandler) GetOutput(c echo.Context) error {
+
+	res, err := h.get.GetOutput(c.Request().Context(), output.GetOutputQuery{ID: c.Param("id")})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ListOutputs は GET /outputs を処理します。
+// { items, pagination } 形式のエンベロープで 200 OK を返却します。
+func (h *OutputHandler) ListOutputs(c echo.Context) error {
+
+	var query output.ListOutputsQuery
+	if err := c.Bind(&query); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.list.ListOutputs(c.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateOutput は PATCH /outputs/:id を処理します。
+// ボディで指定された項目のみを更新し、更新後の OutputResponse を 200 OK で返却します。
+func (h *OutputHandler) UpdateOutput(c echo.Context) error {
+
+	var cmd output.UpdateOutputCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+	cmd.ID = c.Param("id")
+
+	res, err := h.update.UpdateOutput(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteOutput は DELETE /outputs/:id を処理します。
+// 論理削除に成功した場合は 204 No Content を返却します。
+func (h *OutputHandler) DeleteOutput(c echo.Context) error {
+
+	if err := h.remove.DeleteOutput(c.Request().Context(), output.DeleteOutputCommand{ID: c.Param("id")}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// TransitionStatus は POST /outputs/:id/{action} を処理するハンドラを返します。
+// 操作はルートごとに固定し、変更後の OutputResponse を 200 OK で返却します。
+// 現在のステータスから実行できない操作の場合は 409 Conflict を返却します。
+func (h *OutputHandler) TransitionStatus(action outputValueObj.StatusAction) echo.HandlerFunc {
+	return func(c echo.Context) error {
+
+		cmd := output.TransitionOutputStatusCommand{ID: c.Param("id"), Action: string(action)}
+
+		res, err := h.transition.TransitionStatus(c.Request().Context(), cmd)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, res)
+	}
+}
+
+// ListOutputTypes は GET /outputs/types を処理します。
+// アウトプット種別と種別ごとの入力ルールを { items } 形式で 200 OK で返却します（admin 権限以上）。
+func (h *OutputHandler) ListOutputTypes(c echo.Context) error {
+
+	items, err := h.types.ListOutputTypes(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{"items": items})
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	outputUsecase "app/internal/application/usecase/output"
+	"app/internal/domain/output/entity"
+	outputRepository "app/internal/domain/output/repository"
+	outputValueObj "app/internal/domain/output/value_obj"
+	"app/internal/domain/user/value_obj"
+	testidgen "app/internal/test/idgen"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// testOutputRepository はアウトプットハンドラーのテスト用に、アウトプットをメモリ上で保持します。
+type testOutputRepository struct {
+	outputs map[string]*entity.Output
+}
+
+func (m *testOutputRepository) CreateOutput(_ context.Context, o *entity.Output) error {
+	m.outputs[o.ID] = o
+	return nil
+}
+
+func (m *testOutputRepository) FindByID(_ context.Context, id string) (*entity.Output, error) {
+	if o, ok := m.outputs[id]; ok && !o.DeleteFlag {
+		copied := *o
+		return &copied, nil
+	}
+	return nil, outputValueObj.OutputNotFoundError
+}
+
+func (m *testOutputRepository) ListOutputs(context.Context, outputRepository.OutputListCriteria) (*outputRepository.OutputPage, error) {
+	return &outputRepository.OutputPage{}, nil
+}
+
+func (m *testOutputRepository) UpdateOutput(_ context.Context, o *entity.Output) error {
+	m.outputs[o.ID] = o
+	return nil
+}
+
+func (m *testOutputRepository) UpdateOutputStatus(_ context.Context, o *entity.Output, from string) error {
+	if stored, ok := m.outputs[o.ID]; !ok || stored.Status != from {
+		return outputValueObj.OutputStatusTransitionError
+	}
+	m.outputs[o.ID] = o
+	return nil
+}
+
+func (m *testOutputRepository) DeleteOutput(_ context.Context, id string) error {
+	m.outputs[id].DeleteFlag = true
+	return nil
+}
+
+var _ outputRepository.OutputRepository = (*testOutputRepository)(nil)
+
+// TestOutputHandler_CreateOutput はアウトプット作成ハンドラーのステータスコードと、
+// 所有者が認証済みの操作者になることを検証します。
+func TestOutputHandler_CreateOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(outputValueObj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(outputValueObj.OutputUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	tests := map[string]struct {
+		principal *policy.Principal
+		body      string
+		wantCode  int
+	}{
+		"unauthenticated returns 401": {
+			body:     `{"title":"Go入門"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		"invalid json returns 400": {
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			body:      `{"title":`,
+			wantCode:  http.StatusBadRequest,
+		},
+		"validation error returns 422": {
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			body:      `{"title":"Go入門","url":"not-a-url"}`,
+			wantCode:  http.StatusUnprocessableEntity,
+		},
+		"success returns 201": {
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			body:      `{"title":"Go入門","url":"https://example.com","type":"blog"}`,
+			wantCode:  http.StatusCreated,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("OutputHandler CreateOutput テストケース開始: %s", name)
+
+			repoMock := &testOutputRepository{outputs: map[string]*entity.Output{}}
+			h := NewOutputHandler(outputUsecase.NewCreateOutputUsecase(repoMock, testidgen.NewFake("output")), nil, nil, nil, nil, nil, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/outputs", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.principal != nil {
+				req = req.WithContext(policy.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+
+			serve(e.NewContext(req, rec), h.CreateOutput)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+
+			if tt.wantCode == http.StatusCreated {
+				var res outputdto.OutputResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if res.UserID != tt.principal.UserID || res.Status != "draft" {
+					t.Fatalf("unexpected response: %+v", res)
+				}
+				if got := rec.Header().Get(echo.HeaderLocation); got != "/outputs/"+res.ID {
+					t.Fatalf("Location = %q, want %q", got, "/outputs/"+res.ID)
+				}
+			}
+		})
+	}
+}
+
+// TestOutputHandler_DeleteOutput はアウトプット削除ハンドラーのステータスコードを検証します。
+func TestOutputHandler_DeleteOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(outputValueObj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(outputValueObj.OutputUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	tests := map[string]struct {
+		principal policy.Principal
+		id        string
+		wantCode  int
+	}{
+		"other member returns 403": {principal: policy.Principal{UserID: "user-2", Role: value_obj.Member}, id: "output-1", wantCode: http.StatusForbidden},
+		"not found returns 404":    {principal: policy.Principal{UserID: "user-1", Role: value_obj.Member}, id: "missing", wantCode: http.StatusNotFound},
+		"owner returns 204":        {principal: policy.Principal{UserID: "user-1", Role: value_obj.Member}, id: "output-1", wantCode: http.StatusNoContent},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("OutputHandler DeleteOutput テストケース開始: %s", name)
+
+			repoMock := &testOutputRepository{outputs: map[string]*entity.Output{
+				"output-1": {ID: "output-1", UserID: "user-1", Title: "Go入門"},
+			}}
+			h := NewOutputHandler(nil, nil, nil, nil, outputUsecase.NewDeleteOutputUsecase(repoMock), nil, nil)
+
+			req := httptest.NewRequest(http.MethodDelete, "/outputs/"+tt.id, nil)
+			req = req.WithContext(policy.WithPrincipal(req.Context(), tt.principal))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			serve(c, h.DeleteOutput)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+// TestOutputHandler_TransitionStatus はステータス変更ハンドラーのステータスコードを検証します。
+// 現在のステータスから実行できない操作は 409 Conflict になることを確認します。
+func TestOutputHandler_TransitionStatus(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(outputValueObj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(outputValueObj.OutputUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+	owner := policy.Principal{UserID: "user-1", Role: value_obj.Member}
+	admin := policy.Principal{UserID: "admin-1", Role: value_obj.Admin}
+
+	tests := map[string]struct {
+		principal  policy.Principal
+		action     outputValueObj.StatusAction
+		wantCode   int
+		wantStatus string
+	}{
+		"owner submits draft":       {principal: owner, action: outputValueObj.Submit, wantCode: http.StatusOK, wantStatus: "in_review"},
+		"owner cannot publish":      {principal: owner, action: outputValueObj.Publish, wantCode: http.StatusForbidden},
+		"publish draft returns 409": {principal: admin, action: outputValueObj.Publish, wantCode: http.StatusConflict},
+		"archive draft returns 409": {principal: owner, action: outputValueObj.Archive, wantCode: http.StatusConflict},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("OutputHandler TransitionStatus テストケース開始: %s", name)
+
+			repoMock := &testOutputRepository{outputs: map[string]*entity.Output{
+				"output-1": {ID: "output-1", UserID: "user-1", Title: "Go入門", Status: "draft"},
+			}}
+			h := NewOutputHandler(nil, nil, nil, nil, nil, outputUsecase.NewTransitionOutputStatusUsecase(repoMock, testlogger.NewPortLogger(t)), nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/outputs/output-1/"+string(tt.action), nil)
+			req = req.WithContext(policy.WithPrincipal(req.Context(), tt.principal))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("output-1")
+
+			serve(c, h.TransitionStatus(tt.action))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+
+			if tt.wantCode == http.StatusOK {
+				var res outputdto.OutputResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if res.Status != tt.wantStatus || res.StatusChangedBy != tt.principal.UserID || res.StatusChangedAt == nil {
+					t.Fatalf("unexpected response: %+v", res)
+				}
+			}
+		})
+	}
+}
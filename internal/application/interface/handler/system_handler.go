@@ -0,0 +1,58 @@
+package handler
+
+import (
+	systemdto "app/internal/application/dto/system"
+	"app/internal/application/port"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SystemHandler はオーケストレーター・監視向けの稼働確認・準備確認・ビルド情報のハンドラです。
+// いずれのエンドポイントも認証を必要としません。
+type SystemHandler struct {
+	checker port.HealthChecker
+	logger  port.Logger
+	version systemdto.VersionResponse
+}
+
+// NewSystemHandler は SystemHandler のコンストラクタです。
+func NewSystemHandler(checker port.HealthChecker, logger port.Logger, version systemdto.VersionResponse) *SystemHandler {
+	return &SystemHandler{checker: checker, logger: logger, version: version}
+}
+
+// Healthz は GET /healthz を処理します。
+// プロセスがリクエストに応答できることのみを確認し、依存先は確認しません（依存先の障害で再起動させないため）。
+func (h *SystemHandler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, systemdto.HealthResponse{Status: "ok"})
+}
+
+// Readyz は GET /readyz を処理します。
+//
+//  1. 登録済みの依存先（データベースの接続・マイグレーションの適用状況など）をすべて確認
+//  2. すべて利用可能な場合は 200 OK、1 つでも利用できない場合は 503 を返却
+//  3. 利用できない依存先のエラーはログにのみ出力
+func (h *SystemHandler) Readyz(c echo.Context) error {
+
+	ctx := c.Request().Context()
+
+	res := systemdto.HealthResponse{Status: "ok"}
+	code := http.StatusOK
+	for _, r := range h.checker.Check(ctx) {
+		check := systemdto.CheckResponse{Name: r.Name, Status: "up", DurationMs: r.Duration.Milliseconds()}
+		if r.Err != nil {
+			check.Status = "down"
+			res.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+			h.logger.Warn(ctx, "readiness check failed", "check", r.Name, "error", r.Err)
+		}
+		res.Checks = append(res.Checks, check)
+	}
+
+	return c.JSON(code, res)
+}
+
+// Version は GET /version を処理します。ビルド時に埋め込まれたバージョン情報を返却します。
+func (h *SystemHandler) Version(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.version)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	systemdto "app/internal/application/dto/system"
+	"app/internal/application/port"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// testHealthChecker は固定の確認結果を返すテスト用の実装です。
+type testHealthChecker struct {
+	results []port.HealthCheckResult
+}
+
+func (c testHealthChecker) Check(context.Context) []port.HealthCheckResult {
+	return c.results
+}
+
+// TestSystemHandler_Readyz は依存先の確認結果に応じたステータスコードとレスポンスを検証します。
+//
+// - すべて利用可能な場合に 200
+// - 1 つでも利用できない場合に 503（エラーの詳細はレスポンスに含めない）
+func TestSystemHandler_Readyz(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		results    []port.HealthCheckResult
+		wantCode   int
+		wantStatus string
+		wantChecks map[string]string
+	}{
+		"all checks up returns 200": {
+			results: []port.HealthCheckResult{
+				{Name: "database"},
+				{Name: "migrations"},
+			},
+			wantCode:   http.StatusOK,
+			wantStatus: "ok",
+			wantChecks: map[string]string{"database": "up", "migrations": "up"},
+		},
+		"failed check returns 503": {
+			results: []port.HealthCheckResult{
+				{Name: "database"},
+				{Name: "migrations", Err: errors.New("secret detail")},
+			},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "unavailable",
+			wantChecks: map[string]string{"database": "up", "migrations": "down"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewSystemHandler(testHealthChecker{results: tt.results}, testlogger.NewPortLogger(t), systemdto.VersionResponse{})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			rec := httptest.NewRecorder()
+			if err := h.Readyz(e.NewContext(req, rec)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d", tt.wantCode, rec.Code)
+			}
+
+			var res systemdto.HealthResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("invalid response body: %v", err)
+			}
+			if res.Status != tt.wantStatus {
+				t.Errorf("expected status %q, got %q", tt.wantStatus, res.Status)
+			}
+			got := map[string]string{}
+			for _, c := range res.Checks {
+				got[c.Name] = c.Status
+			}
+			for name, want := range tt.wantChecks {
+				if got[name] != want {
+					t.Errorf("check %s: expected %q, got %q", name, want, got[name])
+				}
+			}
+			if strings.Contains(rec.Body.String(), "secret detail") {
+				t.Errorf("error detail must not be exposed: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestSystemHandler_HealthzAndVersion は稼働確認とビルド情報が常に 200 で返ることを検証します。
+func TestSystemHandler_HealthzAndVersion(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	failing := testHealthChecker{results: []port.HealthCheckResult{{Name: "database", Err: errors.New("down")}}}
+	h := NewSystemHandler(failing, testlogger.NewPortLogger(t), systemdto.VersionResponse{Version: "v1.2.3", GoVersion: "go1.24"})
+	e := echo.New()
+
+	// 依存先が利用できなくても稼働確認は 200
+	rec := httptest.NewRecorder()
+	if err := h.Healthz(e.NewContext(httptest.NewRequest(http.MethodGet, "/healthz", nil), rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	if err := h.Version(e.NewContext(httptest.NewRequest(http.MethodGet, "/version", nil), rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var res systemdto.VersionResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("invalid response body: %v", err)
+	}
+	if res.Version != "v1.2.3" {
+		t.Errorf("expected version v1.2.3, got %q", res.Version)
+	}
+}
+
+// TestSystemHandler_Version は /version がビルド情報をそのまま JSON で返し、
+// 埋め込まれていないコミット・ビルド日時は省略することを検証します。
+func TestSystemHandler_Version(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		version  systemdto.VersionResponse
+		wantBody string
+	}{
+		"release build": {
+			version:  systemdto.VersionResponse{Version: "v1.2.3", Commit: "0123abc", BuildTime: "2025-01-01T00:00:00Z", GoVersion: "go1.24.5"},
+			wantBody: `{"version":"v1.2.3","commit":"0123abc","build_time":"2025-01-01T00:00:00Z","go_version":"go1.24.5"}`,
+		},
+		"dev build": {
+			version:  systemdto.VersionResponse{Version: "dev", GoVersion: "go1.24.5"},
+			wantBody: `{"version":"dev","go_version":"go1.24.5"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewSystemHandler(testHealthChecker{}, testlogger.NewPortLogger(t), tt.version)
+			rec := httptest.NewRecorder()
+			if err := h.Version(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/version", nil), rec)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tt.wantBody {
+				t.Errorf("expected body %s, got %s", tt.wantBody, got)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"app/internal/application/dto/user"
+	"app/internal/application/interface/httperror"
 	usecase "app/internal/application/usecase/user"
 	"net/http"
+	"net/url"
 
 	"github.com/labstack/echo/v4"
 )
@@ -11,15 +13,25 @@ import (
 // UserHandler は HTTP レイヤからユーザー関連のユースケースを呼び出すためのハンドラです。
 //
 // この構造体自体は Echo の詳細（Context など）とアプリケーションユースケースの橋渡し役を担い、
-// 具体的なビジネスロジックは CreateUserUsecase に委譲します。
+// 具体的なビジネスロジックは各ユースケース（作成・取得・一覧・更新・削除）に委譲します。
 type UserHandler struct {
 	usecase *usecase.CreateUserUsecase
+	get     *usecase.GetUserUsecase
+	list    *usecase.ListUsersUsecase
+	update  *usecase.UpdateUserUsecase
+	remove  *usecase.DeleteUserUsecase
 }
 
 // NewUserHandler は UserHandler のコンストラクタです。
 // ルーティング設定時にユースケースを注入して利用します。
-func NewUserHandler(uc *usecase.CreateUserUsecase) *UserHandler {
-	return &UserHandler{usecase: uc}
+func NewUserHandler(
+	uc *usecase.CreateUserUsecase,
+	get *usecase.GetUserUsecase,
+	list *usecase.ListUsersUsecase,
+	update *usecase.UpdateUserUsecase,
+	remove *usecase.DeleteUserUsecase,
+) *UserHandler {
+	return &UserHandler{usecase: uc, get: get, list: list, update: update, remove: remove}
 }
 
 // CreateUser は HTTP 経由の「ユーザー作成リクエスト」を受け付けるハンドラです。
@@ -28,8 +40,8 @@ func NewUserHandler(uc *usecase.CreateUserUsecase) *UserHandler {
 //  1. リクエストボディ(JSON)を CreateUserCommand DTO にバインド
 //  2. バインドに失敗した場合は 400 Bad Request を返却
 //  3. ユースケース CreateUserUsecase.CreateUser を呼び出し
-//  4. ユースケース側でエラーが発生した場合は 500 Internal Server Error を返却
-//  5. 正常に作成できた場合は 201 Created（ボディ無し）を返却
+//  4. ユースケース側でエラーが発生した場合はそのまま返却し、httperror.Handler でステータスコードを決定
+//  5. 正常に作成できた場合は作成したユーザーを 201 Created で返却し、Location ヘッダーに /users/{id} を設定
 //
 // ここでは「リクエスト/レスポンスの形式」と「HTTP ステータスコードの決定」のみを担当し、
 // 具体的なバリデーションやビジネスルールはユースケース・ドメイン層に任せています。
@@ -41,15 +53,78 @@ func (h *UserHandler) CreateUser(c echo.Context) error {
 	// エラーハンドリング
 	// Echoがバインド失敗時、エラーコードを返す
 	if err := c.Bind(&cmd); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request"})
+		return httperror.InvalidRequest(err)
 	}
 
 	// 実行 + エラーハンドリング
-	// Usecaseが実行失敗時、エラーコードを返す
-	if err := h.usecase.CreateUser(c.Request().Context(), cmd); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	// Usecaseが実行失敗時、エラーをそのまま返しエラーハンドラに変換を任せる
+	res, err := h.usecase.CreateUser(c.Request().Context(), cmd)
+	if err != nil {
+		return err
 	}
 
-	// NoContentで201 Createdを返す
-	return c.NoContent(http.StatusCreated)
+	// 作成したリソースの URL を Location ヘッダーで返す
+	c.Response().Header().Set(echo.HeaderLocation, "/users/"+url.PathEscape(res.ID))
+
+	// 作成したユーザーを201 Createdで返す
+	return c.JSON(http.StatusCreated, res)
+}
+
+// GetUser は GET /users/:id を処理します。
+// 本人または root 権限の操作者に対して、パスワードを含まない UserResponse を 200 OK で返却します。
+func (h *UserHandler) GetUser(c echo.Context) error {
+
+	res, err := h.get.GetUser(c.Request().Context(), user.GetUserQuery{ID: c.Param("id")})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ListUsers は GET /users を処理します。
+// クエリパラメータの検索条件・並び順・ページングに従い、
+// { items, pagination } 形式のエンベロープで 200 OK を返却します（admin 権限以上）。
+func (h *UserHandler) ListUsers(c echo.Context) error {
+
+	var query user.ListUsersQuery
+	if err := c.Bind(&query); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+
+	res, err := h.list.ListUsers(c.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateUser は PATCH /users/:id を処理します。
+// ボディで指定された項目のみを更新し、更新後の UserResponse を 200 OK で返却します。
+func (h *UserHandler) UpdateUser(c echo.Context) error {
+
+	var cmd user.UpdateUserCommand
+	if err := c.Bind(&cmd); err != nil {
+		return httperror.InvalidRequest(err)
+	}
+	cmd.ID = c.Param("id")
+
+	res, err := h.update.UpdateUser(c.Request().Context(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteUser は DELETE /users/:id を処理します。
+// 論理削除に成功した場合は 204 No Content を返却します（root 権限のみ）。
+func (h *UserHandler) DeleteUser(c echo.Context) error {
+
+	if err := h.remove.DeleteUser(c.Request().Context(), user.DeleteUserCommand{ID: c.Param("id")}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
 }
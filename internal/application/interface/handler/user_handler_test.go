@@ -4,16 +4,25 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
+	"time"
 
 	userdto "app/internal/application/dto/user"
+	"app/internal/application/interface/httperror"
+	"app/internal/application/policy"
 	usecase "app/internal/application/usecase/user"
 	"app/internal/domain/user/entity"
 	"app/internal/domain/user/repository"
+	"app/internal/domain/user/services"
 	"app/internal/domain/user/value_obj"
+	testidgen "app/internal/test/idgen"
 	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	testverification "app/internal/test/verification"
 
 	"github.com/labstack/echo/v4"
 )
@@ -26,6 +35,9 @@ import (
 type testUserRepository struct {
 	existsByEmailFn func(ctx context.Context, email string) (bool, error)
 	createUserFn    func(ctx context.Context, u *entity.User) error
+	findByEmailFn   func(ctx context.Context, email string) (*entity.User, error)
+	findByIDFn      func(ctx context.Context, id string) (*entity.User, error)
+	listUsersFn     func(ctx context.Context, criteria repository.UserListCriteria) (*repository.UserPage, error)
 }
 
 func (m *testUserRepository) CreateUser(ctx context.Context, u *entity.User) error {
@@ -42,14 +54,55 @@ func (m *testUserRepository) ExistsByEmail(ctx context.Context, email string) (b
 	return false, nil
 }
 
+func (m *testUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	if m.findByIDFn != nil {
+		return m.findByIDFn(ctx, id)
+	}
+	return nil, value_obj.UserNotFoundError
+}
+
+func (m *testUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	if m.findByEmailFn != nil {
+		return m.findByEmailFn(ctx, email)
+	}
+	return nil, value_obj.UserNotFoundError
+}
+
 func (m *testUserRepository) FindByUser(context.Context, string, string, string) (*entity.User, error) {
 	return nil, nil
 }
 
+func (m *testUserRepository) ListUsers(ctx context.Context, criteria repository.UserListCriteria) (*repository.UserPage, error) {
+	if m.listUsersFn != nil {
+		return m.listUsersFn(ctx, criteria)
+	}
+	return &repository.UserPage{}, nil
+}
+
 func (m *testUserRepository) UpdateUser(context.Context, *entity.User) error {
 	return nil
 }
 
+func (m *testUserRepository) UpdatePassword(context.Context, string, string) error {
+	return nil
+}
+
+func (m *testUserRepository) MarkEmailVerified(context.Context, string, string, time.Time) error {
+	return nil
+}
+
+func (m *testUserRepository) EnableTwoFactor(context.Context, string, time.Time) error {
+	return nil
+}
+
+func (m *testUserRepository) RecordLoginFailure(context.Context, string, time.Time, time.Time) error {
+	return nil
+}
+
+func (m *testUserRepository) ResetLoginFailures(context.Context, string) error {
+	return nil
+}
+
 func (m *testUserRepository) DeleteUser(context.Context, string) error {
 	return nil
 }
@@ -63,15 +116,22 @@ type testPasswordHasher struct {
 	hashFn func(password string) (string, error)
 }
 
-func (m *testPasswordHasher) Hash(password string) (string, error) {
+func (m *testPasswordHasher) Hash(_ context.Context, password string) (string, error) {
 	if m.hashFn != nil {
 		return m.hashFn(password)
 	}
 	return "hashed-" + password, nil
 }
 
-func (m *testPasswordHasher) Compare(password, hash string) bool {
-	return true
+func (m *testPasswordHasher) Compare(_ context.Context, password, hash string) (bool, bool) {
+	return hash == "hashed-"+password, false
+}
+
+// serve はハンドラーを実行し、返されたエラーを本番と同じ httperror.Handler でレスポンスに変換します。
+func serve(c echo.Context, h echo.HandlerFunc) {
+	if err := h(c); err != nil {
+		httperror.Handler(err, c)
+	}
 }
 
 // TestUserHandler_CreateUser はユーザー作成ハンドラーの挙動をテストします。
@@ -103,22 +163,20 @@ func TestUserHandler_CreateUser(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		// Bind エラーのケースではユースケースは呼ばれないため nil でも問題ありません。
-		h := NewUserHandler((*usecase.CreateUserUsecase)(nil))
+		h := NewUserHandler((*usecase.CreateUserUsecase)(nil), nil, nil, nil, nil)
 
-		if err := h.CreateUser(c); err != nil {
-			t.Fatalf("unexpected error: %v", err)
-		}
+		serve(c, h.CreateUser)
 
 		if rec.Code != http.StatusBadRequest {
 			t.Fatalf("status code = %d, want %d", rec.Code, http.StatusBadRequest)
 		}
 	})
 
-	t.Run("usecase error returns 500", func(t *testing.T) {
+	t.Run("duplicate email returns 409", func(t *testing.T) {
 		t.Parallel()
 
 		logger := testlogger.New(t)
-		logger.Info("UserHandler CreateUser ユースケースエラーケース開始")
+		logger.Info("UserHandler CreateUser メールアドレス重複ケース開始")
 
 		body, _ := json.Marshal(userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -140,13 +198,101 @@ func TestUserHandler_CreateUser(t *testing.T) {
 		}
 		hasherMock := &testPasswordHasher{}
 
-		uc := usecase.NewCreateUserUsecase(repoMock, hasherMock)
-		h := NewUserHandler(uc)
+		uc := usecase.NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
+		h := NewUserHandler(uc, nil, nil, nil, nil)
+
+		serve(c, h.CreateUser)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("status code = %d, want %d", rec.Code, http.StatusConflict)
+		}
+
+		var res httperror.ErrorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if res.Code != value_obj.UserEmailAlreadyExistsError.Code() {
+			t.Fatalf("code = %q, want %q", res.Code, value_obj.UserEmailAlreadyExistsError.Code())
+		}
+	})
+
+	t.Run("weak password returns 422 with every violation", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("UserHandler CreateUser パスワードポリシー違反ケース開始")
+
+		body, _ := json.Marshal(userdto.CreateUserCommand{
+			Name:     "Alice",
+			Email:    "alice@example.com",
+			Password: "alice",
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
 
-		if err := h.CreateUser(c); err != nil {
-			t.Fatalf("unexpected error: %v", err)
+		uc := usecase.NewCreateUserUsecase(&testUserRepository{}, &testPasswordHasher{}, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
+		h := NewUserHandler(uc, nil, nil, nil, nil)
+
+		serve(c, h.CreateUser)
+
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("status code = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
 		}
 
+		var res httperror.ErrorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		want := []string{
+			value_obj.UserPasswordTooShortError.Code(),
+			value_obj.UserPasswordUppercaseRequiredError.Code(),
+			value_obj.UserPasswordDigitRequiredError.Code(),
+			value_obj.UserPasswordSimilarToProfileError.Code(),
+		}
+		var got []string
+		for _, d := range res.Details {
+			if d.Field != "password" {
+				t.Fatalf("unexpected field in details: %+v", d)
+			}
+			got = append(got, d.Code)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("password codes = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("repository error returns 500", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("UserHandler CreateUser リポジトリエラーケース開始")
+
+		body, _ := json.Marshal(userdto.CreateUserCommand{
+			Name:     "Alice",
+			Email:    "alice@example.com",
+			Password: "Password1",
+			Bio:      "hello",
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		repoMock := &testUserRepository{
+			existsByEmailFn: func(_ context.Context, _ string) (bool, error) {
+				return false, errors.New("db error")
+			},
+		}
+
+		uc := usecase.NewCreateUserUsecase(repoMock, &testPasswordHasher{}, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
+		h := NewUserHandler(uc, nil, nil, nil, nil)
+
+		serve(c, h.CreateUser)
+
 		if rec.Code != http.StatusInternalServerError {
 			t.Fatalf("status code = %d, want %d", rec.Code, http.StatusInternalServerError)
 		}
@@ -180,17 +326,157 @@ func TestUserHandler_CreateUser(t *testing.T) {
 		}
 		hasherMock := &testPasswordHasher{}
 
-		uc := usecase.NewCreateUserUsecase(repoMock, hasherMock)
-		h := NewUserHandler(uc)
+		uc := usecase.NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
+		h := NewUserHandler(uc, nil, nil, nil, nil)
 
-		if err := h.CreateUser(c); err != nil {
-			t.Fatalf("unexpected error: %v", err)
-		}
+		serve(c, h.CreateUser)
 
 		if rec.Code != http.StatusCreated {
 			t.Fatalf("status code = %d, want %d", rec.Code, http.StatusCreated)
 		}
+		if got := rec.Header().Get(echo.HeaderLocation); got != "/users/user-0001" {
+			t.Fatalf("Location = %q, want %q", got, "/users/user-0001")
+		}
+
+		var res map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if res["id"] != "user-0001" || res["email"] != "alice@example.com" {
+			t.Fatalf("unexpected response: %v", res)
+		}
+		if _, ok := res["password"]; ok {
+			t.Fatalf("response must not contain password: %v", res)
+		}
 	})
 }
 
+// TestUserHandler_GetUser はユーザー取得ハンドラーのステータスコードと、
+// レスポンスにパスワードハッシュが含まれないことを検証します。
+func TestUserHandler_GetUser(t *testing.T) {
+	t.Parallel()
 
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+	repoMock := &testUserRepository{
+		findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+			if id != "user-1" {
+				return nil, value_obj.UserNotFoundError
+			}
+			return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed-Password1"}, nil
+		},
+	}
+	h := NewUserHandler(nil, usecase.NewGetUserUsecase(repoMock), nil, nil, nil)
+
+	tests := map[string]struct {
+		principal *policy.Principal
+		id        string
+		wantCode  int
+	}{
+		"unauthenticated returns 401": {id: "user-1", wantCode: http.StatusUnauthorized},
+		"other member returns 403": {
+			principal: &policy.Principal{UserID: "user-2", Role: value_obj.Member},
+			id:        "user-1",
+			wantCode:  http.StatusForbidden,
+		},
+		"not found returns 404": {
+			principal: &policy.Principal{UserID: "root-1", Role: value_obj.Root},
+			id:        "missing",
+			wantCode:  http.StatusNotFound,
+		},
+		"self returns 200": {
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			id:        "user-1",
+			wantCode:  http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("UserHandler GetUser テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.id, nil)
+			if tt.principal != nil {
+				req = req.WithContext(policy.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			serve(c, h.GetUser)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rec.Code, tt.wantCode)
+			}
+
+			if tt.wantCode == http.StatusOK {
+				var body map[string]interface{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if _, ok := body["password"]; ok {
+					t.Fatal("response must not contain password")
+				}
+				if body["email"] != "alice@example.com" {
+					t.Fatalf("email = %v, want %q", body["email"], "alice@example.com")
+				}
+			}
+		})
+	}
+}
+
+// TestUserHandler_ListUsers はクエリパラメータが検索条件にバインドされ、
+// 一覧が { items, pagination } のエンベロープで返却されることを検証します。
+func TestUserHandler_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	var got repository.UserListCriteria
+	repoMock := &testUserRepository{
+		listUsersFn: func(_ context.Context, c repository.UserListCriteria) (*repository.UserPage, error) {
+			got = c
+			return &repository.UserPage{Total: 0}, nil
+		},
+	}
+	h := NewUserHandler(nil, nil, usecase.NewListUsersUsecase(repoMock), nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?name=ali&role=member&min_years=2&sort=name&order=asc&limit=5&offset=10", nil)
+	req = req.WithContext(policy.WithPrincipal(req.Context(), policy.Principal{UserID: "admin-1", Role: value_obj.Admin}))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	serve(c, h.ListUsers)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	if got.Name != "ali" || got.Role != "member" || got.SortField != "name" || got.SortDesc || got.Limit != 5 || got.Offset != 10 {
+		t.Fatalf("unexpected criteria: %+v", got)
+	}
+	if got.MinYearsOfExperience == nil || *got.MinYearsOfExperience != 2 || got.MaxYearsOfExperience != nil {
+		t.Fatalf("unexpected years range: min=%v max=%v", got.MinYearsOfExperience, got.MaxYearsOfExperience)
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if string(body["items"]) != "[]" {
+		t.Fatalf("items = %s, want empty array", body["items"])
+	}
+	if _, ok := body["pagination"]; !ok {
+		t.Fatal("response must contain pagination")
+	}
+}
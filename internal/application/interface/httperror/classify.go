@@ -0,0 +1,152 @@
+package httperror
+
+import (
+	outputValueObj "app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// Kind はエラーの分類です。分類ごとに HTTP ステータスコードが 1 つに決まります。
+type Kind string
+
+// 分類定義
+const (
+	KindValidation      Kind = "validation"
+	KindUnprocessable   Kind = "unprocessable"
+	KindUnauthenticated Kind = "unauthenticated"
+	KindForbidden       Kind = "forbidden"
+	KindNotFound        Kind = "not_found"
+	KindConflict        Kind = "conflict"
+	KindTooManyRequests Kind = "too_many_requests"
+	KindInternal        Kind = "internal"
+)
+
+// 分類に対応する HTTP ステータスコード
+func (k Kind) Status() int {
+	switch k {
+	case KindValidation:
+		return http.StatusBadRequest
+	case KindUnprocessable:
+		return http.StatusUnprocessableEntity
+	case KindUnauthenticated:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindTooManyRequests:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
+
+// classifiedError はドメインメッセージと分類の対応です。
+type classifiedError struct {
+	err  error
+	kind Kind
+}
+
+// classifiedErrors は入力値エラー以外に分類するドメインメッセージの一覧です。
+// ここに無いドメインメッセージ（ErrorMessage）はすべて入力値エラーとして扱います。
+var classifiedErrors = []classifiedError{
+	// 認証
+	{err: userValueObj.UserUnauthenticatedError, kind: KindUnauthenticated},
+	{err: userValueObj.UserLoginFailedError, kind: KindUnauthenticated},
+	{err: userValueObj.UserRefreshTokenInvalidError, kind: KindUnauthenticated},
+	{err: userValueObj.UserTwoFactorChallengeInvalidError, kind: KindUnauthenticated},
+
+	// 認可
+	{err: userValueObj.UserForbiddenError, kind: KindForbidden},
+
+	// 存在しないリソース
+	{err: userValueObj.UserNotFoundError, kind: KindNotFound},
+	{err: outputValueObj.OutputNotFoundError, kind: KindNotFound},
+
+	// 現在の状態と競合する操作
+	{err: userValueObj.UserEmailAlreadyExistsError, kind: KindConflict},
+	{err: outputValueObj.OutputStatusTransitionError, kind: KindConflict},
+	{err: outputValueObj.OutputNotEditableError, kind: KindConflict},
+	{err: userValueObj.UserEmailAlreadyVerifiedError, kind: KindConflict},
+	{err: userValueObj.UserTwoFactorAlreadyEnabledError, kind: KindConflict},
+
+	// 回数制限
+	{err: userValueObj.UserEmailVerificationThrottledError, kind: KindTooManyRequests},
+}
+
+// codedError はドメインメッセージ（user / output の ErrorMessage）に共通するメソッドです。
+type codedError interface {
+	error
+	Code() string
+	Message() string
+}
+
+// fieldErrors は項目ごとの違反をまとめたバリデーション結果（user / output の ValidationErrors）に共通するメソッドです。
+type fieldErrors interface {
+	codedError
+	EachField(fn func(field string, code string, message string))
+}
+
+// Classify はエラーを分類します。
+//
+//  1. 項目ごとの違反をまとめたバリデーション結果は KindUnprocessable
+//  2. 分類表に登録されたドメインメッセージ（ラップされていても可）
+//  3. リポジトリから返された gorm.ErrRecordNotFound は KindNotFound
+//  4. Echo の HTTPError はステータスコードから分類
+//  5. それ以外のドメインメッセージは KindValidation
+//  6. 上記のいずれでもないエラーは KindInternal
+func Classify(err error) Kind {
+
+	var fe fieldErrors
+	if errors.As(err, &fe) {
+		return KindUnprocessable
+	}
+
+	for _, c := range classifiedErrors {
+		if errors.Is(err, c.err) {
+			return c.kind
+		}
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return KindNotFound
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return kindFromStatus(he.Code)
+	}
+
+	var ce codedError
+	if errors.As(err, &ce) {
+		return KindValidation
+	}
+
+	return KindInternal
+}
+
+// kindFromStatus は HTTP ステータスコードから分類を決定します。
+func kindFromStatus(status int) Kind {
+	switch {
+	case status == http.StatusUnauthorized:
+		return KindUnauthenticated
+	case status == http.StatusForbidden:
+		return KindForbidden
+	case status == http.StatusNotFound:
+		return KindNotFound
+	case status == http.StatusConflict:
+		return KindConflict
+	case status == http.StatusUnprocessableEntity:
+		return KindUnprocessable
+	case status == http.StatusTooManyRequests:
+		return KindTooManyRequests
+	case status >= 500:
+		return KindInternal
+	}
+	return KindValidation
+}
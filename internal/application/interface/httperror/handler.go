@@ -0,0 +1,161 @@
+package httperror
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// エラーレスポンスのコード・メッセージ（ドメインメッセージ以外のエラー向け）
+const (
+	levelError = "error"
+
+	codeInvalidRequest   = "request.invalid"
+	codeNotFound         = "resource.not_found"
+	codeRouteNotFound    = "route.not_found"
+	codeMethodNotAllowed = "route.method_not_allowed"
+	codeRequestError     = "request.error"
+	codeInternal         = "internal.error"
+
+	messageInvalidRequest = "リクエストの形式が正しくありません。"
+	messageNotFound       = "対象のデータが見つかりません。"
+	messageInternal       = "サーバー内部でエラーが発生しました。"
+)
+
+// ErrorResponse はすべてのエラーレスポンスに共通するボディです。
+// フロントエンドはメッセージ（日本語）ではなく Code で分岐します。
+type ErrorResponse struct {
+	Code    string        `json:"code"`
+	Message string        `json:"message"`
+	Level   string        `json:"level"`
+	Details []ErrorDetail `json:"details"`
+}
+
+// ErrorDetail は項目単位のエラーを表します。
+// 項目ごとのバリデーション結果（422）の場合のみ設定され、それ以外は空配列です。
+type ErrorDetail struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// InvalidRequest はリクエストボディ・クエリのバインドに失敗したことを表すエラーを返します。
+// 元のエラーは Internal に保持し、レスポンスには含めません。
+func InvalidRequest(err error) error {
+	return echo.NewHTTPError(http.StatusBadRequest, messageInvalidRequest).SetInternal(err)
+}
+
+// Code はエラーレスポンスに設定するエラーコードを返します。
+// メトリクスなど、レスポンス以外でエラーを分類する場合に使用します。
+func Code(err error) string {
+	return newErrorResponse(err, Classify(err)).Code
+}
+
+// Handler は Echo の HTTPErrorHandler です。
+//
+// ハンドラ・ミドルウェアから返されたエラーを Classify で分類してステータスコードを決め、
+// { code, message, level, details } 形式のボディで返却します。
+// 内部エラーは詳細をレスポンスに含めず、slog のデフォルトロガーにのみ出力します。
+//
+//	e.HTTPErrorHandler = httperror.Handler
+func Handler(err error, c echo.Context) {
+
+	if c.Response().Committed {
+		return
+	}
+
+	kind := Classify(err)
+	res := newErrorResponse(err, kind)
+
+	// 内部エラーの詳細はログにのみ出力する(リクエストIDはコンテキストから付与される)
+	if kind == KindInternal {
+		slog.ErrorContext(c.Request().Context(), "internal error", "error", err)
+	}
+
+	status := statusOf(err, kind)
+
+	var writeErr error
+	if c.Request().Method == http.MethodHead {
+		writeErr = c.NoContent(status)
+	} else {
+		writeErr = c.JSON(status, res)
+	}
+	if writeErr != nil {
+		slog.ErrorContext(c.Request().Context(), "failed to write error response", "error", writeErr)
+	}
+}
+
+// newErrorResponse はエラーと分類からレスポンスボディを組み立てます。
+func newErrorResponse(err error, kind Kind) ErrorResponse {
+
+	res := ErrorResponse{Code: codeInternal, Message: messageInternal, Level: levelError, Details: []ErrorDetail{}}
+
+	if kind == KindInternal {
+		return res
+	}
+
+	var fe fieldErrors
+	if errors.As(err, &fe) {
+		res.Code = fe.Code()
+		res.Message = fe.Message()
+		fe.EachField(func(field string, code string, message string) {
+			res.Details = append(res.Details, ErrorDetail{Field: field, Code: code, Message: message})
+		})
+		return res
+	}
+
+	var ce codedError
+	if errors.As(err, &ce) {
+		res.Code = ce.Code()
+		res.Message = ce.Message()
+		return res
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		res.Code = httpErrorCode(he.Code)
+		if msg, ok := he.Message.(string); ok {
+			res.Message = msg
+		} else {
+			res.Message = http.StatusText(he.Code)
+		}
+		return res
+	}
+
+	if kind == KindNotFound {
+		res.Code = codeNotFound
+		res.Message = messageNotFound
+	}
+
+	return res
+}
+
+// statusOf はレスポンスのステータスコードを決定します。
+// Echo の HTTPError は 405 や 413 などの細かいステータスをそのまま返します。
+func statusOf(err error, kind Kind) int {
+
+	var he *echo.HTTPError
+	if kind != KindInternal && errors.As(err, &he) {
+		var ce codedError
+		if !errors.As(err, &ce) {
+			return he.Code
+		}
+	}
+
+	return kind.Status()
+}
+
+// httpErrorCode は Echo の HTTPError のステータスコードからエラーコードを決定します。
+func httpErrorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return codeInvalidRequest
+	case http.StatusNotFound:
+		return codeRouteNotFound
+	case http.StatusMethodNotAllowed:
+		return codeMethodNotAllowed
+	}
+	return codeRequestError
+}
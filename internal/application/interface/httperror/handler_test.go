@@ -0,0 +1,190 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	outputValueObj "app/internal/domain/output/value_obj"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// TestHandler はエラーの分類ごとに、ステータスコードと { code, message, level, details } の
+// ボディが期待通りになることを表形式で検証します。
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+
+	tests := map[string]struct {
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+	}{
+		"domain validation error": {
+			err:         value_obj.UserPasswordTooShortError,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    value_obj.UserPasswordTooShortError.Code(),
+			wantMessage: value_obj.UserPasswordTooShortError.Message(),
+		},
+		"output validation error": {
+			err:        outputValueObj.OutputURLFormatError,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   outputValueObj.OutputURLFormatError.Code(),
+		},
+		"unauthenticated": {
+			err:        value_obj.UserLoginFailedError,
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   value_obj.UserLoginFailedError.Code(),
+		},
+		"forbidden": {
+			err:        value_obj.UserForbiddenError,
+			wantStatus: http.StatusForbidden,
+			wantCode:   value_obj.UserForbiddenError.Code(),
+		},
+		"wrapped not found": {
+			err:        fmt.Errorf("failed to find output: %w", outputValueObj.OutputNotFoundError),
+			wantStatus: http.StatusNotFound,
+			wantCode:   outputValueObj.OutputNotFoundError.Code(),
+		},
+		"wrapped gorm not found": {
+			err:         fmt.Errorf("failed to find user: %w", gorm.ErrRecordNotFound),
+			wantStatus:  http.StatusNotFound,
+			wantCode:    codeNotFound,
+			wantMessage: messageNotFound,
+		},
+		"conflict": {
+			err:        value_obj.UserEmailAlreadyExistsError,
+			wantStatus: http.StatusConflict,
+			wantCode:   value_obj.UserEmailAlreadyExistsError.Code(),
+		},
+		"status transition conflict": {
+			err:        outputValueObj.OutputStatusTransitionError,
+			wantStatus: http.StatusConflict,
+			wantCode:   outputValueObj.OutputStatusTransitionError.Code(),
+		},
+		"too many requests": {
+			err:        value_obj.UserEmailVerificationThrottledError,
+			wantStatus: http.StatusTooManyRequests,
+			wantCode:   value_obj.UserEmailVerificationThrottledError.Code(),
+		},
+		"invalid request": {
+			err:         InvalidRequest(errors.New("unexpected EOF")),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    codeInvalidRequest,
+			wantMessage: messageInvalidRequest,
+		},
+		"unknown route": {
+			err:        echo.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+			wantCode:   codeRouteNotFound,
+		},
+		"method not allowed": {
+			err:        echo.ErrMethodNotAllowed,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantCode:   codeMethodNotAllowed,
+		},
+		"internal error hides details": {
+			err:         fmt.Errorf("failed to create user: %w", errors.New("database is locked")),
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    codeInternal,
+			wantMessage: messageInternal,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("HTTPErrorHandler テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			Handler(tt.err, c)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status code = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			var res ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if res.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", res.Code, tt.wantCode)
+			}
+			if tt.wantMessage != "" && res.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", res.Message, tt.wantMessage)
+			}
+			if res.Level != "error" {
+				t.Errorf("level = %q, want %q", res.Level, "error")
+			}
+			if res.Details == nil {
+				t.Errorf("details = nil, want empty array")
+			}
+		})
+	}
+}
+
+// TestHandler_FieldErrors は項目ごとの違反をまとめたバリデーション結果が 422 となり、
+// 違反した項目が details にすべて含まれることを検証します。
+func TestHandler_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	v := &value_obj.ValidationErrors{}
+	v.Add("name", value_obj.UserRequiredError)
+	v.Add("email", value_obj.UserEmailFormatError)
+	v.Add("password", value_obj.UserPasswordTooShortError)
+
+	req := httptest.NewRequest(http.MethodPost, "/users", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	Handler(fmt.Errorf("failed to validate user: %w", v.Err()), c)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status code = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	var res ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if res.Code != value_obj.UserValidationError.Code() {
+		t.Errorf("code = %q, want %q", res.Code, value_obj.UserValidationError.Code())
+	}
+
+	want := []ErrorDetail{
+		{Field: "name", Code: value_obj.UserRequiredError.Code(), Message: value_obj.UserRequiredError.Message()},
+		{Field: "email", Code: value_obj.UserEmailFormatError.Code(), Message: value_obj.UserEmailFormatError.Message()},
+		{Field: "password", Code: value_obj.UserPasswordTooShortError.Code(), Message: value_obj.UserPasswordTooShortError.Message()},
+	}
+	if len(res.Details) != len(want) {
+		t.Fatalf("details = %+v, want %+v", res.Details, want)
+	}
+	for i := range want {
+		if res.Details[i] != want[i] {
+			t.Errorf("details[%d] = %+v, want %+v", i, res.Details[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// bearerPrefix は Authorization ヘッダーのスキーム部分です。
+const bearerPrefix = "Bearer "
+
+// Authenticate は Authorization ヘッダーのアクセストークンを検証し、
+// 操作者（policy.Principal）をリクエストの context.Context に格納するミドルウェアです。
+//
+// トークンが無い・不正・期限切れの場合は UserUnauthenticatedError（401 Unauthorized）を返却し、後続のハンドラは呼び出しません。
+func Authenticate(issuer port.TokenIssuer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if !strings.HasPrefix(header, bearerPrefix) {
+				return value_obj.UserUnauthenticatedError
+			}
+
+			claims, err := issuer.ParseAccessToken(strings.TrimPrefix(header, bearerPrefix))
+			if err != nil {
+				return value_obj.UserUnauthenticatedError
+			}
+
+			// 操作者を context に格納してユースケースへ引き渡す
+			p := policy.Principal{UserID: claims.UserID, Role: value_obj.ParseRole(claims.Role)}
+			c.SetRequest(c.Request().WithContext(policy.WithPrincipal(c.Request().Context(), p)))
+
+			return next(c)
+		}
+	}
+}
+
+// RequireRole はルートごとに必要な権限を宣言するためのミドルウェアです。
+// Authenticate の後段で使用し、権限が不足している場合は UserForbiddenError（403 Forbidden）を返却します。
+//
+//	e.DELETE("/users/:id", h.DeleteUser, middleware.Authenticate(issuer), middleware.RequireRole(value_obj.Root))
+func RequireRole(required value_obj.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			p, ok := policy.PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return value_obj.UserUnauthenticatedError
+			}
+
+			if err := services.AuthorizeRole(p.Role, required); err != nil {
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
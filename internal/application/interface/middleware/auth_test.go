@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"app/internal/application/interface/httperror"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// testTokenIssuer はトークン文字列をそのまま権限として解釈するテスト用の実装です。
+// "valid-<role>" 形式のトークンのみを有効とみなします。
+type testTokenIssuer struct{}
+
+func (testTokenIssuer) IssueAccessToken(string, string) (string, time.Time, error) {
+	return "", time.Time{}, errors.New("not implemented")
+}
+
+func (testTokenIssuer) ParseAccessToken(token string) (*port.AccessTokenClaims, error) {
+	switch token {
+	case "valid-root":
+		return &port.AccessTokenClaims{UserID: "root-1", Role: "root"}, nil
+	case "valid-member":
+		return &port.AccessTokenClaims{UserID: "member-1", Role: "member"}, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+// TestRequireRole は Authenticate と RequireRole を組み合わせたルートで、
+// トークンの有無・権限に応じて 401 / 403 / 200 が返ることを検証します。
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	e := echo.New()
+	e.HTTPErrorHandler = httperror.Handler
+	e.GET("/root-only", func(c echo.Context) error {
+		p, _ := policy.PrincipalFromContext(c.Request().Context())
+		return c.String(http.StatusOK, p.UserID)
+	}, Authenticate(testTokenIssuer{}), RequireRole(value_obj.Root))
+
+	tests := map[string]struct {
+		authorization string
+		wantCode      int
+		wantErrCode   string
+	}{
+		"missing token returns 401": {
+			wantCode:    http.StatusUnauthorized,
+			wantErrCode: value_obj.UserUnauthenticatedError.Code(),
+		},
+		"invalid token returns 401": {
+			authorization: "Bearer broken",
+			wantCode:      http.StatusUnauthorized,
+			wantErrCode:   value_obj.UserUnauthenticatedError.Code(),
+		},
+		"insufficient role returns 403": {
+			authorization: "Bearer valid-member",
+			wantCode:      http.StatusForbidden,
+			wantErrCode:   value_obj.UserForbiddenError.Code(),
+		},
+		"sufficient role returns 200": {
+			authorization: "Bearer valid-root",
+			wantCode:      http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("RequireRole テストケース開始: %s", name)
+
+			req := httptest.NewRequest(http.MethodGet, "/root-only", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", rec.Code, tt.wantCode)
+			}
+
+			if tt.wantErrCode != "" {
+				var body httperror.ErrorResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if body.Code != tt.wantErrCode {
+					t.Fatalf("code = %q, want %q", body.Code, tt.wantErrCode)
+				}
+			} else if rec.Body.String() != "root-1" {
+				t.Fatalf("body = %q, want principal user id", rec.Body.String())
+			}
+		})
+	}
+}
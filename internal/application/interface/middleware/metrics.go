@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"app/internal/application/interface/httperror"
+	"app/internal/application/port"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute は定義されていないパスへのリクエストに使用するルート名です。
+// 任意のパスをラベルにするとメトリクスの系列が際限なく増えるため、まとめて記録します。
+const unmatchedRoute = "unmatched"
+
+// HTTPMetrics はリクエストごとにルート・メソッド・ステータスコード別の件数と処理時間を記録するミドルウェアです。
+//
+// AccessLog と同様に、ハンドラから返されたエラーはここで HTTPErrorHandler に渡し、
+// エラーレスポンスのステータスコードで記録します。
+func HTTPMetrics(metrics port.Metrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			start := time.Now()
+
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			metrics.ObserveHTTPRequest(c.Request().Method, route, c.Response().Status, time.Since(start))
+
+			return nil
+		}
+	}
+}
+
+// ObserveUsecase はルートが呼び出すユースケースの実行結果を記録するミドルウェアです。
+// RequireRole と同様にルートごとに宣言し、認証・認可のミドルウェアより後に指定します。
+//
+//	e.POST("/users", userHandler.CreateUser, ObserveUsecase(m, "create_user"))
+//
+// 成功時は code="ok"、失敗時はエラーレスポンスと同じエラーコード（ドメインメッセージのコードなど）で記録します。
+func ObserveUsecase(metrics port.Metrics, usecase string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			err := next(c)
+			if err != nil {
+				metrics.ObserveUsecase(usecase, httperror.Code(err))
+				return err
+			}
+			metrics.ObserveUsecase(usecase, "ok")
+
+			return nil
+		}
+	}
+}
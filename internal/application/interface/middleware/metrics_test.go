@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"app/internal/application/interface/httperror"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// recordingMetrics は記録されたメトリクスを保持するテスト用の port.Metrics です。
+type recordingMetrics struct {
+	mu       sync.Mutex
+	requests []string
+	usecases []string
+}
+
+func (m *recordingMetrics) ObserveHTTPRequest(method string, route string, status int, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, method+" "+route+" "+http.StatusText(status))
+}
+
+func (m *recordingMetrics) ObserveUsecase(usecase string, code string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.usecases = append(m.usecases, usecase+" "+code)
+}
+
+// TestMetrics は HTTP リクエストとユースケースの実行結果の記録内容を検証します。
+//
+// - ルートはパラメーターを含まない定義で記録し、未定義のパスは unmatched にまとめる
+// - ユースケースの失敗はエラーレスポンスと同じエラーコードで記録する
+// - 認可で拒否されたリクエストはユースケースの結果として記録しない
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		method        string
+		path          string
+		authorization string
+		wantRequest   string
+		wantUsecase   string
+	}{
+		"success is recorded with ok": {
+			method:      http.MethodGet,
+			path:        "/users/1",
+			wantRequest: "GET /users/:id OK",
+			wantUsecase: "get_user ok",
+		},
+		"domain error is recorded with its code": {
+			method:      http.MethodGet,
+			path:        "/users/missing",
+			wantRequest: "GET /users/:id Not Found",
+			wantUsecase: "get_user " + value_obj.UserNotFoundError.Code(),
+		},
+		"forbidden request is not recorded as usecase result": {
+			method:        http.MethodDelete,
+			path:          "/users/1",
+			authorization: "Bearer valid-member",
+			wantRequest:   "DELETE /users/:id Forbidden",
+		},
+		"unknown path is recorded as unmatched": {
+			method:      http.MethodGet,
+			path:        "/no/such/path",
+			wantRequest: "GET unmatched Not Found",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &recordingMetrics{}
+			e := echo.New()
+			e.HTTPErrorHandler = httperror.Handler
+			e.Use(HTTPMetrics(m))
+			e.GET("/users/:id", func(c echo.Context) error {
+				if c.Param("id") == "missing" {
+					return value_obj.UserNotFoundError
+				}
+				return c.NoContent(http.StatusOK)
+			}, ObserveUsecase(m, "get_user"))
+			e.DELETE("/users/:id", func(c echo.Context) error {
+				return c.NoContent(http.StatusNoContent)
+			}, Authenticate(testTokenIssuer{}), RequireRole(value_obj.Root), ObserveUsecase(m, "delete_user"))
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			e.ServeHTTP(httptest.NewRecorder(), req)
+
+			if len(m.requests) != 1 || m.requests[0] != tt.wantRequest {
+				t.Errorf("expected request %q, got %v", tt.wantRequest, m.requests)
+			}
+			switch {
+			case tt.wantUsecase == "" && len(m.usecases) != 0:
+				t.Errorf("expected no usecase result, got %v", m.usecases)
+			case tt.wantUsecase != "" && (len(m.usecases) != 1 || m.usecases[0] != tt.wantUsecase):
+				t.Errorf("expected usecase %q, got %v", tt.wantUsecase, m.usecases)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"app/internal/application/port"
+	"app/internal/application/requestctx"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// requestIDPattern はクライアント・プロキシから受け取るリクエストIDとして許可する形式です。
+// ログへの不正な文字列の混入を防ぐため、英数字と一部の記号のみ・128 文字以内に限定します。
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// RequestID はリクエストごとにリクエストIDを決定し、context.Context とレスポンスヘッダーに設定するミドルウェアです。
+//
+// X-Request-ID ヘッダーに妥当な値があればそれを引き継ぎ、無ければ新しく生成します。
+// ユースケース・リポジトリのログには context.Context を通じて同じリクエストIDが付与されます。
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			id := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !requestIDPattern.MatchString(id) {
+				id = newRequestID()
+			}
+
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			c.SetRequest(c.Request().WithContext(requestctx.WithRequestID(c.Request().Context(), id)))
+
+			return next(c)
+		}
+	}
+}
+
+// AccessLog はリクエストごとにアクセスログを 1 行出力するミドルウェアです。
+//
+// RequestID の後段で使用します。ハンドラから返されたエラーはここで HTTPErrorHandler に渡し、
+// エラーレスポンスのステータスコードも含めて記録します。
+// 5xx は error、4xx は warn、それ以外は info で出力します。
+func AccessLog(logger port.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			start := time.Now()
+
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			req := c.Request()
+			res := c.Response()
+			args := []any{
+				"method", req.Method,
+				"path", req.URL.Path,
+				"route", c.Path(),
+				"status", res.Status,
+				"latency_ms", time.Since(start).Milliseconds(),
+				"bytes_out", res.Size,
+				"remote_ip", c.RealIP(),
+				"user_agent", req.UserAgent(),
+			}
+
+			// 認証ミドルウェアで操作者が設定された後の context.Context を使用する
+			ctx := req.Context()
+			switch {
+			case res.Status >= 500:
+				logger.Error(ctx, "request", args...)
+			case res.Status >= 400:
+				logger.Warn(ctx, "request", args...)
+			default:
+				logger.Info(ctx, "request", args...)
+			}
+
+			return nil
+		}
+	}
+}
+
+// newRequestID はランダムなリクエストIDを生成します。
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().UTC().Format("20060102T150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
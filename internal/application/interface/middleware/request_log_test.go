@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"app/internal/application/interface/httperror"
+	"app/internal/application/requestctx"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+
+	"github.com/labstack/echo/v4"
+)
+
+// recordedLog は recordingLogger が記録した 1 件のログです。
+type recordedLog struct {
+	level     string
+	requestID string
+	attrs     map[string]any
+}
+
+// recordingLogger は出力されたログを記録するテスト用の port.Logger です。
+type recordingLogger struct {
+	mu   sync.Mutex
+	logs []recordedLog
+}
+
+func (l *recordingLogger) Debug(ctx context.Context, msg string, args ...any) {
+	l.record(ctx, "debug", args)
+}
+func (l *recordingLogger) Info(ctx context.Context, msg string, args ...any) {
+	l.record(ctx, "info", args)
+}
+func (l *recordingLogger) Warn(ctx context.Context, msg string, args ...any) {
+	l.record(ctx, "warn", args)
+}
+func (l *recordingLogger) Error(ctx context.Context, msg string, args ...any) {
+	l.record(ctx, "error", args)
+}
+
+func (l *recordingLogger) record(ctx context.Context, level string, args []any) {
+	attrs := map[string]any{}
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[args[i].(string)] = args[i+1]
+	}
+	id, _ := requestctx.RequestIDFromContext(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logs = append(l.logs, recordedLog{level: level, requestID: id, attrs: attrs})
+}
+
+// TestRequestIDAndAccessLog はリクエストIDの引き継ぎ・生成と、
+// エラーレスポンスを含めたアクセスログのレベル・ステータスを検証します。
+func TestRequestIDAndAccessLog(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		requestID     string
+		handler       echo.HandlerFunc
+		wantStatus    int
+		wantLevel     string
+		wantRequestID string
+	}{
+		"valid request id is propagated": {
+			requestID: "abc-123",
+			handler: func(c echo.Context) error {
+				id, _ := requestctx.RequestIDFromContext(c.Request().Context())
+				return c.String(http.StatusOK, id)
+			},
+			wantStatus:    http.StatusOK,
+			wantLevel:     "info",
+			wantRequestID: "abc-123",
+		},
+		"invalid request id is replaced": {
+			requestID: "bad id\nwith newline",
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			},
+			wantStatus: http.StatusOK,
+			wantLevel:  "info",
+		},
+		"4xx error is logged as warn": {
+			handler: func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantLevel:  "warn",
+		},
+		"5xx error is logged as error": {
+			handler: func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusInternalServerError)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantLevel:  "error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logs := &recordingLogger{}
+			e := echo.New()
+			e.HTTPErrorHandler = httperror.Handler
+			e.Use(RequestID(), AccessLog(logs))
+			e.GET("/target", tt.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/target", nil)
+			if tt.requestID != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+
+			gotID := rec.Header().Get(echo.HeaderXRequestID)
+			if !requestIDPattern.MatchString(gotID) {
+				t.Fatalf("invalid response request id: %q", gotID)
+			}
+			if tt.wantRequestID != "" && gotID != tt.wantRequestID {
+				t.Fatalf("expected request id %q, got %q", tt.wantRequestID, gotID)
+			}
+
+			if len(logs.logs) != 1 {
+				t.Fatalf("expected 1 access log, got %d", len(logs.logs))
+			}
+			got := logs.logs[0]
+			if got.level != tt.wantLevel {
+				t.Errorf("expected level %s, got %s", tt.wantLevel, got.level)
+			}
+			if got.attrs["status"] != tt.wantStatus {
+				t.Errorf("expected logged status %d, got %v", tt.wantStatus, got.attrs["status"])
+			}
+			if got.requestID != gotID {
+				t.Errorf("expected logged request id %q, got %q", gotID, got.requestID)
+			}
+		})
+	}
+}
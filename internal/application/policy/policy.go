@@ -0,0 +1,48 @@
+package policy
+
+import (
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	"context"
+)
+
+// Authorize はユースケースの先頭で呼び出し、操作者が必要な権限を持っているかを確認します。
+//
+//   - context.Context に Principal が無い場合は UserUnauthenticatedError
+//   - 権限が不足している場合は UserForbiddenError
+//
+// HTTP ミドルウェアでのルート単位のチェックに加えてユースケース側でも確認することで、
+// ルーティングの設定漏れや HTTP 以外の呼び出し経路でも権限ルールが守られるようにしています。
+func Authorize(ctx context.Context, required value_obj.Role) (Principal, error) {
+
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return Principal{}, value_obj.UserUnauthenticatedError
+	}
+
+	if err := services.AuthorizeRole(p.Role, required); err != nil {
+		return Principal{}, err
+	}
+
+	return p, nil
+}
+
+// AuthorizeSelfOr は「本人であれば許可し、他人のリソースであれば required の権限を要求する」チェックです。
+// 自分のプロフィールの参照・更新のように、本人と管理者の双方に許可する操作で使用します。
+func AuthorizeSelfOr(ctx context.Context, ownerID string, required value_obj.Role) (Principal, error) {
+
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return Principal{}, value_obj.UserUnauthenticatedError
+	}
+
+	if p.UserID == ownerID {
+		return p, nil
+	}
+
+	if err := services.AuthorizeRole(p.Role, required); err != nil {
+		return Principal{}, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"app/internal/domain/user/value_obj"
+	"context"
+)
+
+// Principal は認証済みの操作者を表します。
+// HTTP ミドルウェアでアクセストークンから生成され、context.Context を通じてユースケースに渡されます。
+type Principal struct {
+	UserID string
+	Role   value_obj.Role
+}
+
+// principalKey は context.Context に Principal を格納する際のキーです。
+type principalKey struct{}
+
+// WithPrincipal は Principal を格納した context.Context を返します。
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext は context.Context から Principal を取り出します。
+// 認証されていないリクエストの場合は false を返します。
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
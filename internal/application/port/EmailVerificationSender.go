@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// メールアドレス確認用のメールを送信するインターフェース
+// 確認用トークンの発行・保存とメールの組み立ては、認証のユースケース側で実装する
+type EmailVerificationSender interface {
+
+	// 確認用トークンの発行と確認メールの送信
+	SendVerification(ctx context.Context, userID string, name string, email string) error
+}
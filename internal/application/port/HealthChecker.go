@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// 依存先（データベースなど）ごとの確認結果
+type HealthCheckResult struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// アプリケーションがリクエストを処理できる状態かを確認するインターフェース
+type HealthChecker interface {
+
+	// 登録済みのすべての依存先の確認(登録順に結果を返す)
+	Check(ctx context.Context) []HealthCheckResult
+}
@@ -0,0 +1,9 @@
+package port
+
+// エンティティの ID を採番するインターフェース
+// 採番した ID は文字列として昇順に並べると生成順になる
+type IDGenerator interface {
+
+	// 新しい ID の生成
+	NewID() (string, error)
+}
@@ -0,0 +1,21 @@
+package port
+
+import "context"
+
+// 構造化ログを出力するインターフェース
+// context.Context からリクエストIDや操作者のユーザーIDを取り出してログに付与するため、必ず ctx を渡します。
+// args は "key", value の組で指定します（例: "user_id", u.ID）。
+type Logger interface {
+
+	// デバッグ情報
+	Debug(ctx context.Context, msg string, args ...any)
+
+	// 通常の動作記録
+	Info(ctx context.Context, msg string, args ...any)
+
+	// 処理は継続できるが注意が必要な事象
+	Warn(ctx context.Context, msg string, args ...any)
+
+	// 処理を継続できなかった事象
+	Error(ctx context.Context, msg string, args ...any)
+}
@@ -0,0 +1,20 @@
+package port
+
+import "context"
+
+// 送信するメール
+type Mail struct {
+	To      string
+	Subject string
+
+	// 本文（プレーンテキスト）
+	Body string
+}
+
+// メールを送信するインターフェース
+// 送信方法（SMTP サーバー・ファイルへの書き出しなど）はインフラ層で実装する
+type MailSender interface {
+
+	// メールの送信
+	Send(ctx context.Context, mail Mail) error
+}
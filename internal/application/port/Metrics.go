@@ -0,0 +1,13 @@
+package port
+
+import "time"
+
+// HTTP リクエスト・ユースケースの実行結果を計測するインターフェース
+type Metrics interface {
+
+	// HTTP リクエストの記録(route はパラメーターを含まないルート定義。例: /users/:id)
+	ObserveHTTPRequest(method string, route string, status int, elapsed time.Duration)
+
+	// ユースケースの実行結果の記録(成功時の code は "ok"、失敗時はドメインメッセージなどのエラーコード)
+	ObserveUsecase(usecase string, code string)
+}
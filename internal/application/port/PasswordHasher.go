@@ -1,11 +1,16 @@
 package port
 
+import "context"
+
 // パスワードのハッシュ化・検証を行うインターフェース
+// 処理時間が長いため、トレースのスパンを記録できるよう context.Context を受け取る
 type PasswordHasher interface {
 
 	// パスワードのハッシュ化
-	Hash(password string) (string, error)
+	Hash(ctx context.Context, password string) (string, error)
 
 	// パスワードの検証
-	Compare(password, hash string) bool
+	// 一致した場合、ハッシュのアルゴリズム・パラメーターが現在の設定と異なれば needsRehash を true で返す
+	// （呼び出し側は Hash で作り直したハッシュを保存し直す）
+	Compare(ctx context.Context, password, hash string) (match bool, needsRehash bool)
 }
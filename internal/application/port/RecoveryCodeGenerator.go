@@ -0,0 +1,12 @@
+package port
+
+// 2 段階認証のリカバリーコードを生成するインターフェース
+// 保存にはハッシュ値を使用し、平文は発行時に一度だけ利用者に渡す
+type RecoveryCodeGenerator interface {
+
+	// コードの生成(平文を n 件返す)
+	Generate(n int) ([]string, error)
+
+	// 入力されたコードの正規化とハッシュ化(表記ゆれを吸収した上でハッシュ値を返す)
+	Hash(code string) string
+}
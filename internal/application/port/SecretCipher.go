@@ -0,0 +1,12 @@
+package port
+
+// TOTP シークレットなど、保存時に暗号化が必要な値を扱うインターフェース
+// associatedData には値の持ち主（ユーザーIDなど）を渡し、別のレコードへ暗号文を付け替えても復号できないようにする
+type SecretCipher interface {
+
+	// 暗号化
+	Encrypt(plaintext string, associatedData string) (string, error)
+
+	// 復号(改ざん・鍵の不一致の場合はエラー)
+	Decrypt(ciphertext string, associatedData string) (string, error)
+}
@@ -0,0 +1,12 @@
+package port
+
+// リフレッシュトークンなどの推測困難なランダムトークンを生成するインターフェース
+// 平文は利用者にのみ渡し、保存にはハッシュ値を使用する
+type SecureTokenGenerator interface {
+
+	// トークンの生成(平文とハッシュ値を返す)
+	Generate() (token string, hash string, err error)
+
+	// 平文トークンのハッシュ化
+	Hash(token string) string
+}
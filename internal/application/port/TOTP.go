@@ -0,0 +1,17 @@
+package port
+
+import "time"
+
+// 認証アプリのワンタイムコード（TOTP, RFC 6238）を扱うインターフェース
+type TOTP interface {
+
+	// シークレットの生成(認証アプリに登録する Base32 表記の値を返す)
+	GenerateSecret() (string, error)
+
+	// 認証アプリに登録するための otpauth:// 形式の URI
+	ProvisioningURI(secret string, accountName string) string
+
+	// コードの検証
+	// 一致した場合、そのコードの時間ステップを返す（呼び出し側は同じステップの再利用を拒否する）
+	Validate(secret string, code string, at time.Time) (step int64, ok bool)
+}
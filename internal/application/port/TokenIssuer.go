@@ -0,0 +1,20 @@
+package port
+
+import "time"
+
+// アクセストークンに含まれる認証情報
+type AccessTokenClaims struct {
+	UserID    string
+	Role      string
+	ExpiresAt time.Time
+}
+
+// アクセストークンの発行・検証を行うインターフェース
+type TokenIssuer interface {
+
+	// アクセストークンの発行
+	IssueAccessToken(userID string, role string) (token string, expiresAt time.Time, err error)
+
+	// アクセストークンの検証
+	ParseAccessToken(token string) (*AccessTokenClaims, error)
+}
@@ -0,0 +1,13 @@
+package port
+
+import "context"
+
+// 複数のリポジトリ操作を 1 つのトランザクションで実行するインターフェース
+type TransactionManager interface {
+
+	// fn を 1 つのトランザクションで実行する
+	// fn に渡されたコンテキストで呼び出したリポジトリの操作は、すべてこのトランザクション内で実行される
+	// fn がエラーを返した場合はロールバックし、そのエラーを返す（成功した場合はコミットする）
+	// すでにトランザクション内の場合は、新しく開始せずに外側のトランザクションに参加する
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,19 @@
+package requestctx
+
+import "context"
+
+// requestIDKey は context.Context にリクエストIDを格納する際のキーです。
+type requestIDKey struct{}
+
+// WithRequestID はリクエストIDを格納した context.Context を返します。
+// HTTP ミドルウェアで設定し、ユースケース・リポジトリのログに同じIDを付与するために使用します。
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext は context.Context からリクエストIDを取り出します。
+// 設定されていない場合は false を返します。
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
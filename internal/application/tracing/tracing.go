@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName はアプリケーションが作成するスパンの計装名です。
+const instrumentationName = "app"
+
+// Start はスパンを開始し、スパンを格納した context.Context を返します。
+// スパンは otel のグローバルな TracerProvider（infrastructure/tracing で設定）に送られ、
+// 未設定の場合は何も記録しません。
+//
+//	ctx, span := tracing.Start(ctx, "CreateUserUsecase.CreateUser")
+//	defer func() { tracing.End(span, err) }()
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End はスパンを終了します。エラーがある場合はスパンにエラーとして記録します。
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
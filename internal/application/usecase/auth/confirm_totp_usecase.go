@@ -0,0 +1,154 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ConfirmTOTPUsecase は「認証アプリに表示されたコードで登録を確認し、2 段階認証を有効にする」というユースケースを表します。
+//
+// 確認に成功すると、リカバリーコードを発行して平文を一度だけ返却します（保存するのはハッシュ値のみ）。
+// 2 段階認証を有効にする前に発行したリフレッシュトークンはすべて失効させ、以降のログインでは 2 段階目を必須にします。
+type ConfirmTOTPUsecase struct {
+	userRepository           repository.UserRepository
+	totpCredentialRepository repository.TOTPCredentialRepository
+	recoveryCodeRepository   repository.RecoveryCodeRepository
+	refreshTokenRepository   repository.RefreshTokenRepository
+	totp                     port.TOTP
+	cipher                   port.SecretCipher
+	recoveryCodes            port.RecoveryCodeGenerator
+	tx                       port.TransactionManager
+	logger                   port.Logger
+	options                  TwoFactorOptions
+	now                      func() time.Time
+}
+
+// NewConfirmTOTPUsecase は ConfirmTOTPUsecase のコンストラクタです。
+func NewConfirmTOTPUsecase(
+	userRepository repository.UserRepository,
+	totpCredentialRepository repository.TOTPCredentialRepository,
+	recoveryCodeRepository repository.RecoveryCodeRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	totp port.TOTP,
+	cipher port.SecretCipher,
+	recoveryCodes port.RecoveryCodeGenerator,
+	tx port.TransactionManager,
+	logger port.Logger,
+	options TwoFactorOptions,
+) *ConfirmTOTPUsecase {
+	return &ConfirmTOTPUsecase{
+		userRepository:           userRepository,
+		totpCredentialRepository: totpCredentialRepository,
+		recoveryCodeRepository:   recoveryCodeRepository,
+		refreshTokenRepository:   refreshTokenRepository,
+		totp:                     totp,
+		cipher:                   cipher,
+		recoveryCodes:            recoveryCodes,
+		tx:                       tx,
+		logger:                   logger,
+		options:                  options,
+		now:                      time.Now,
+	}
+}
+
+// ConfirmTOTP は 2 段階認証の登録確認ユースケースのエントリポイントです。
+//
+//  1. 必須入力チェック
+//  2. 操作者（ログイン中のユーザー）を取得し、2 段階認証を有効にしていれば拒否
+//  3. 未確認の登録を取得し、シークレットを復号してコードを検証
+//  4. リカバリーコードの生成
+//  5. 登録の確認・2 段階認証の有効化・リカバリーコードの保存・リフレッシュトークンの失効（トランザクション内）
+func (uc *ConfirmTOTPUsecase) ConfirmTOTP(ctx context.Context, cmd authdto.ConfirmTOTPCommand) (_ *authdto.ConfirmTOTPResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "ConfirmTOTPUsecase.ConfirmTOTP")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.Code == "" {
+		return nil, value_obj.UserRequiredError
+	}
+
+	// 操作者の取得
+	u, err := currentUser(ctx, uc.userRepository)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsTwoFactorEnabled() {
+		return nil, value_obj.UserTwoFactorAlreadyEnabledError
+	}
+
+	// 未確認の登録の取得とコードの検証
+	c, err := uc.totpCredentialRepository.FindByUserID(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if c.IsConfirmed() {
+		return nil, value_obj.UserTwoFactorAlreadyEnabledError
+	}
+	secret, err := uc.cipher.Decrypt(c.SecretCiphertext, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt totp secret: %w", err)
+	}
+	now := uc.now()
+	step, ok := uc.totp.Validate(secret, cmd.Code, now)
+	if !ok {
+		uc.logger.Warn(ctx, "two-factor confirmation failed", "reason", "code_mismatch", "target_user_id", u.ID)
+		return nil, value_obj.UserTwoFactorCodeInvalidError
+	}
+
+	// リカバリーコードの生成
+	plain, codes, err := newRecoveryCodes(uc.recoveryCodes, u.ID, uc.options.RecoveryCodes, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2 段階認証の有効化
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.totpCredentialRepository.ConfirmTOTPCredential(ctx, u.ID, step, now); err != nil {
+			return err
+		}
+		if err := uc.userRepository.EnableTwoFactor(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to enable two-factor: %w", err)
+		}
+		if err := uc.recoveryCodeRepository.ReplaceRecoveryCodes(ctx, u.ID, codes); err != nil {
+			return fmt.Errorf("failed to save recovery codes: %w", err)
+		}
+		if err := uc.refreshTokenRepository.RevokeByUserID(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.logger.Info(ctx, "two-factor enabled", "target_user_id", u.ID)
+
+	return &authdto.ConfirmTOTPResponse{RecoveryCodes: plain}, nil
+}
+
+// newRecoveryCodes はリカバリーコードを n 件生成し、平文と保存用のエンティティを返します。
+func newRecoveryCodes(generator port.RecoveryCodeGenerator, userID string, n int, now time.Time) ([]string, []*entity.RecoveryCode, error) {
+
+	plain, err := generator.Generate(n)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+	}
+
+	codes := make([]*entity.RecoveryCode, 0, len(plain))
+	for _, code := range plain {
+		c, err := entity.NewRecoveryCode(userID, generator.Hash(code), now)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create recovery code: %w", err)
+		}
+		codes = append(codes, c)
+	}
+
+	return plain, codes, nil
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestConfirmTOTPUsecase_ConfirmTOTP は 2 段階認証の登録確認ユースケースの振る舞いを検証します。
+// 正しいコードで 2 段階認証が有効になり、リカバリーコードの発行と発行済みのリフレッシュトークンの失効が行われること、
+// 誤ったコードや登録を開始していない場合は何も変更しないことを確認します。
+func TestConfirmTOTPUsecase_ConfirmTOTP(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-1", Role: value_obj.Member})
+
+	tests := map[string]struct {
+		cmd       authdto.ConfirmTOTPCommand
+		enrolled  bool
+		secret    string
+		wantErr   error
+		wantCodes []string
+	}{
+		"missing code": {
+			cmd:      authdto.ConfirmTOTPCommand{},
+			enrolled: true,
+			wantErr:  value_obj.UserRequiredError,
+		},
+		"not enrolled": {
+			cmd:     authdto.ConfirmTOTPCommand{Code: "000100"},
+			wantErr: value_obj.UserTwoFactorNotEnrolledError,
+		},
+		"wrong code": {
+			cmd:      authdto.ConfirmTOTPCommand{Code: "wrong!"},
+			enrolled: true,
+			wantErr:  value_obj.UserTwoFactorCodeInvalidError,
+		},
+		"success": {
+			cmd:       authdto.ConfirmTOTPCommand{Code: "000100"},
+			enrolled:  true,
+			wantCodes: []string{"code-1", "code-2"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			users := &testUserRepository{
+				findByIDFn: func(context.Context, string) (*entity.User, error) {
+					return &entity.User{ID: "user-1", Email: "alice@example.com", Role: "admin"}, nil
+				},
+			}
+			credentials := newTestTOTPCredentialRepository()
+			if tt.enrolled {
+				credentials.credentials["user-1"] = &entity.TOTPCredential{UserID: "user-1", SecretCiphertext: "enc:user-1:" + testTOTPSecret}
+			}
+			codes := newTestRecoveryCodeRepository()
+			refresh := newTestRefreshTokenRepository()
+			refresh.tokens["hash-old"] = &entity.RefreshToken{TokenHash: "hash-old", UserID: "user-1", ExpiresAt: time.Now().Add(time.Hour)}
+			uc := NewConfirmTOTPUsecase(users, credentials, codes, refresh, testTOTP{}, testSecretCipher{}, testRecoveryCodeGenerator{}, testtx.NewFake(), testlogger.NewPortLogger(t), testTwoFactorOptions)
+
+			res, err := uc.ConfirmTOTP(ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConfirmTOTP() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if _, ok := users.twoFactor["user-1"]; ok {
+					t.Error("two-factor must not be enabled on failure")
+				}
+				if refresh.tokens["hash-old"].RevokedAt != nil {
+					t.Error("refresh token must not be revoked on failure")
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(res.RecoveryCodes, tt.wantCodes) {
+				t.Errorf("RecoveryCodes = %v, want %v", res.RecoveryCodes, tt.wantCodes)
+			}
+			if _, ok := users.twoFactor["user-1"]; !ok {
+				t.Error("two-factor was not enabled")
+			}
+			if c := credentials.credentials["user-1"]; !c.IsConfirmed() || c.LastUsedStep != 100 {
+				t.Errorf("credential = %+v, want confirmed at step 100", c)
+			}
+			for _, code := range tt.wantCodes {
+				if c, ok := codes.codes["hash-"+code]; !ok || c.UserID != "user-1" {
+					t.Errorf("recovery code %q was not stored by hash", code)
+				}
+			}
+			if refresh.tokens["hash-old"].RevokedAt == nil {
+				t.Error("refresh tokens issued before enabling two-factor must be revoked")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EmailVerificationOptions はメールアドレスの確認の設定です。
+type EmailVerificationOptions struct {
+	// 確認用トークンの有効期間
+	TokenTTL time.Duration
+
+	// メールに記載する確認用の URL。トークンをクエリパラメーター token として付与する
+	URL string
+
+	// 確認メールを再送できる間隔
+	ResendInterval time.Duration
+
+	// 1 時間あたりに送信できる確認メールの上限（登録時の送信を含む）
+	ResendLimit int
+}
+
+// EmailVerificationIssuer は確認用トークンを発行し、確認メールを送信する port.EmailVerificationSender の実装です。
+//
+// ユーザー登録・メールアドレスの変更・確認メールの再送の各ユースケースから呼び出されます。
+// 確認用のトークンはハッシュ値と発行時のメールアドレスのみを保存し、平文はメールに記載するリンクにのみ含めます。
+// 新しいトークンを発行すると、同じユーザーの未使用のトークンは無効にします（最後に送ったリンクのみ有効）。
+type EmailVerificationIssuer struct {
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository
+	tokenGenerator                   port.SecureTokenGenerator
+	mailer                           port.MailSender
+	tx                               port.TransactionManager
+	logger                           port.Logger
+	options                          EmailVerificationOptions
+	now                              func() time.Time
+}
+
+// NewEmailVerificationIssuer は EmailVerificationIssuer のコンストラクタです。
+func NewEmailVerificationIssuer(
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository,
+	tokenGenerator port.SecureTokenGenerator,
+	mailer port.MailSender,
+	tx port.TransactionManager,
+	logger port.Logger,
+	options EmailVerificationOptions,
+) *EmailVerificationIssuer {
+	return &EmailVerificationIssuer{
+		emailVerificationTokenRepository: emailVerificationTokenRepository,
+		tokenGenerator:                   tokenGenerator,
+		mailer:                           mailer,
+		tx:                               tx,
+		logger:                           logger,
+		options:                          options,
+		now:                              time.Now,
+	}
+}
+
+// SendVerification は確認用トークンを発行し、確認メールを送信します。
+//
+//  1. 確認用トークンの生成
+//  2. 未使用のトークンの無効化と、新しいトークンの保存（トランザクション内）
+//  3. 確認用のリンクを記載したメールの送信
+func (i *EmailVerificationIssuer) SendVerification(ctx context.Context, userID string, name string, email string) (err error) {
+
+	ctx, span := tracing.Start(ctx, "EmailVerificationIssuer.SendVerification")
+	defer func() { tracing.End(span, err) }()
+
+	// トークン生成
+	token, hash, err := i.tokenGenerator.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate email verification token: %w", err)
+	}
+	link, err := i.verifyLink(token)
+	if err != nil {
+		return err
+	}
+
+	// Entity生成
+	now := i.now()
+	t, err := entity.NewEmailVerificationToken(userID, email, hash, now, now.Add(i.options.TokenTTL))
+	if err != nil {
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	// 未使用のトークンの無効化と保存
+	err = i.tx.Do(ctx, func(ctx context.Context) error {
+		if err := i.emailVerificationTokenRepository.InvalidateByUserID(ctx, userID, now); err != nil {
+			return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+		}
+		if err := i.emailVerificationTokenRepository.CreateEmailVerificationToken(ctx, t); err != nil {
+			return fmt.Errorf("failed to save email verification token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// メール送信
+	if err := i.mailer.Send(ctx, port.Mail{
+		To:      email,
+		Subject: "メールアドレス確認のお願い",
+		Body: fmt.Sprintf(
+			"%s さん\n\n以下のリンクから %s以内にメールアドレスを確認してください。\n確認が完了するまで、一部の機能はご利用いただけません。\n\n%s\n\nお心当たりがない場合は、このメールを破棄してください。\n",
+			name, ttlText(i.options.TokenTTL), link,
+		),
+	}); err != nil {
+		return fmt.Errorf("failed to send email verification mail: %w", err)
+	}
+	i.logger.Info(ctx, "email verification mail sent", "target_user_id", userID)
+
+	return nil
+}
+
+// verifyLink は確認用の URL に平文のトークンを付与したリンクを返します。
+func (i *EmailVerificationIssuer) verifyLink(token string) (string, error) {
+
+	u, err := url.Parse(i.options.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid email verification url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// ttlText はメールに記載する有効期間を返します（1 時間未満は分単位、それ以外は時間単位）。
+func ttlText(d time.Duration) string {
+	if d < time.Hour {
+		return fmt.Sprintf("%d 分", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%d 時間", int(d.Hours()))
+}
+
+var _ port.EmailVerificationSender = (*EmailVerificationIssuer)(nil)
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testmail "app/internal/test/mail"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testEmailVerificationTokenRepository はメモリ上でメールアドレス確認トークンを保持するテストリポジトリです。
+// 使用・無効化の結果を、保存済みトークンの状態から確認できるようにしています。
+type testEmailVerificationTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*entity.EmailVerificationToken
+}
+
+func newTestEmailVerificationTokenRepository() *testEmailVerificationTokenRepository {
+	return &testEmailVerificationTokenRepository{tokens: map[string]*entity.EmailVerificationToken{}}
+}
+
+func (m *testEmailVerificationTokenRepository) CreateEmailVerificationToken(_ context.Context, t *entity.EmailVerificationToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[t.TokenHash] = t
+	return nil
+}
+
+func (m *testEmailVerificationTokenRepository) FindByTokenHash(_ context.Context, hash string) (*entity.EmailVerificationToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok {
+		return nil, value_obj.UserEmailVerificationTokenInvalidError
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *testEmailVerificationTokenRepository) FindRecentByUserID(_ context.Context, userID string, limit int) ([]*entity.EmailVerificationToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var tokens []*entity.EmailVerificationToken
+	for _, t := range m.tokens {
+		if t.UserID == userID {
+			tokens = append(tokens, t)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].CreatedAt.After(tokens[j].CreatedAt) })
+	if len(tokens) > limit {
+		tokens = tokens[:limit]
+	}
+	return tokens, nil
+}
+
+func (m *testEmailVerificationTokenRepository) UseEmailVerificationToken(_ context.Context, hash string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok || t.UsedAt != nil {
+		return value_obj.UserEmailVerificationTokenInvalidError
+	}
+	t.UsedAt = &at
+	return nil
+}
+
+func (m *testEmailVerificationTokenRepository) InvalidateByUserID(_ context.Context, userID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tokens {
+		if t.UserID == userID && t.UsedAt == nil {
+			t.UsedAt = &at
+		}
+	}
+	return nil
+}
+
+var _ repo.EmailVerificationTokenRepository = (*testEmailVerificationTokenRepository)(nil)
+
+// testEmailVerificationOptions はテスト用のメールアドレス確認の設定です。
+var testEmailVerificationOptions = EmailVerificationOptions{
+	TokenTTL:       24 * time.Hour,
+	URL:            "https://api.example.com/auth/verify",
+	ResendInterval: time.Minute,
+	ResendLimit:    3,
+}
+
+// TestEmailVerificationIssuer_SendVerification は確認メール送信の振る舞いを検証します。
+// ハッシュ値と発行時のメールアドレスのみを保存し、平文のトークンを含むリンクをメールで送ること、
+// 新しいトークンの発行で以前のトークンが無効になることを確認します。
+func TestEmailVerificationIssuer_SendVerification(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := newTestEmailVerificationTokenRepository()
+		mailer := testmail.NewFake()
+		issuer := NewEmailVerificationIssuer(tokens, &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testEmailVerificationOptions)
+		now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+		issuer.now = func() time.Time { return now }
+
+		// 2 回送信すると、最後に送ったリンクのトークンのみ有効
+		for i := 0; i < 2; i++ {
+			if err := issuer.SendVerification(ctx, "user-1", "Alice", "alice@example.com"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		sent := mailer.Sent()
+		if len(sent) != 2 {
+			t.Fatalf("sent = %d, want 2", len(sent))
+		}
+		if sent[1].To != "alice@example.com" {
+			t.Errorf("To = %q, want %q", sent[1].To, "alice@example.com")
+		}
+		if want := "https://api.example.com/auth/verify?token=refresh-2"; !strings.Contains(sent[1].Body, want) {
+			t.Errorf("body does not contain link %q:\n%s", want, sent[1].Body)
+		}
+
+		latest, ok := tokens.tokens["hash-refresh-2"]
+		if !ok {
+			t.Fatalf("verification token was not stored by hash: %v", tokens.tokens)
+		}
+		if latest.UserID != "user-1" || latest.Email != "alice@example.com" || latest.IsUsed() || !latest.ExpiresAt.Equal(now.Add(24*time.Hour)) {
+			t.Errorf("latest = %+v, want unused token of user-1 for alice@example.com expiring at %v", latest, now.Add(24*time.Hour))
+		}
+		if !tokens.tokens["hash-refresh-1"].IsUsed() {
+			t.Error("previous token must be invalidated")
+		}
+	})
+
+	t.Run("mail error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("smtp error")
+		mailer := testmail.NewFake()
+		mailer.Err = expectedErr
+		issuer := NewEmailVerificationIssuer(newTestEmailVerificationTokenRepository(), &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testEmailVerificationOptions)
+
+		if err := issuer.SendVerification(ctx, "user-1", "Alice", "alice@example.com"); !errors.Is(err, expectedErr) {
+			t.Fatalf("expected wrapped error %v, got %v", expectedErr, err)
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"fmt"
+	"time"
+)
+
+// EnrollTOTPUsecase は「ログイン中のユーザーが認証アプリ（TOTP）の登録を開始する」というユースケースを表します。
+//
+// 生成したシークレットはユーザーIDを関連データとして暗号化し、未確認の登録として保存します。
+// 認証アプリに表示されたコードで登録を確認する（ConfirmTOTPUsecase）までは 2 段階認証は有効になりません。
+// 確認前にもう一度呼び出した場合は、新しいシークレットで未確認の登録を置き換えます。
+type EnrollTOTPUsecase struct {
+	userRepository           repository.UserRepository
+	totpCredentialRepository repository.TOTPCredentialRepository
+	totp                     port.TOTP
+	cipher                   port.SecretCipher
+	now                      func() time.Time
+}
+
+// NewEnrollTOTPUsecase は EnrollTOTPUsecase のコンストラクタです。
+func NewEnrollTOTPUsecase(
+	userRepository repository.UserRepository,
+	totpCredentialRepository repository.TOTPCredentialRepository,
+	totp port.TOTP,
+	cipher port.SecretCipher,
+) *EnrollTOTPUsecase {
+	return &EnrollTOTPUsecase{
+		userRepository:           userRepository,
+		totpCredentialRepository: totpCredentialRepository,
+		totp:                     totp,
+		cipher:                   cipher,
+		now:                      time.Now,
+	}
+}
+
+// EnrollTOTP は 2 段階認証の登録開始ユースケースのエントリポイントです。
+//
+//  1. 操作者（ログイン中のユーザー）を取得し、2 段階認証を有効にしていれば拒否
+//  2. シークレットの生成と暗号化
+//  3. 未確認の登録として保存
+//  4. 認証アプリに登録するためのシークレットと URI を返却
+func (uc *EnrollTOTPUsecase) EnrollTOTP(ctx context.Context) (_ *authdto.EnrollTOTPResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "EnrollTOTPUsecase.EnrollTOTP")
+	defer func() { tracing.End(span, err) }()
+
+	// 操作者の取得
+	u, err := currentUser(ctx, uc.userRepository)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsTwoFactorEnabled() {
+		return nil, value_obj.UserTwoFactorAlreadyEnabledError
+	}
+
+	// シークレットの生成と暗号化
+	secret, err := uc.totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	ciphertext, err := uc.cipher.Encrypt(secret, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+
+	// 未確認の登録として保存
+	c, err := entity.NewTOTPCredential(u.ID, ciphertext, uc.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create totp credential: %w", err)
+	}
+	if err := uc.totpCredentialRepository.SaveTOTPCredential(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to save totp credential: %w", err)
+	}
+
+	return &authdto.EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningURI: uc.totp.ProvisioningURI(secret, u.Email),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestEnrollTOTPUsecase_EnrollTOTP は 2 段階認証の登録開始ユースケースの振る舞いを検証します。
+// シークレットがユーザーIDに結び付けて暗号化された未確認の登録として保存され、
+// 2 段階認証を有効にしているユーザーは拒否されることを確認します。
+func TestEnrollTOTPUsecase_EnrollTOTP(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	enabledAt := time.Now()
+	users := &testUserRepository{
+		findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+			switch id {
+			case "user-1":
+				return &entity.User{ID: "user-1", Email: "alice@example.com", Role: "admin"}, nil
+			case "user-2":
+				return &entity.User{ID: "user-2", Email: "bob@example.com", Role: "admin", TwoFactorEnabledAt: &enabledAt}, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+	as := func(id string) context.Context {
+		return policy.WithPrincipal(context.Background(), policy.Principal{UserID: id, Role: value_obj.Member})
+	}
+
+	tests := map[string]struct {
+		ctx     context.Context
+		wantErr error
+	}{
+		"unauthenticated": {
+			ctx:     context.Background(),
+			wantErr: value_obj.UserUnauthenticatedError,
+		},
+		"deleted user": {
+			ctx:     as("user-9"),
+			wantErr: value_obj.UserUnauthenticatedError,
+		},
+		"already enabled": {
+			ctx:     as("user-2"),
+			wantErr: value_obj.UserTwoFactorAlreadyEnabledError,
+		},
+		"success": {
+			ctx: as("user-1"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			credentials := newTestTOTPCredentialRepository()
+			uc := NewEnrollTOTPUsecase(users, credentials, testTOTP{}, testSecretCipher{})
+
+			res, err := uc.EnrollTOTP(tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("EnrollTOTP() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(credentials.credentials) != 0 {
+					t.Errorf("credentials = %v, want none", credentials.credentials)
+				}
+				return
+			}
+
+			if res.Secret != testTOTPSecret || res.ProvisioningURI != "otpauth://totp/test:alice@example.com?secret="+testTOTPSecret {
+				t.Errorf("EnrollTOTP() = %+v", res)
+			}
+			c, ok := credentials.credentials["user-1"]
+			if !ok {
+				t.Fatal("credential was not saved")
+			}
+			if c.SecretCiphertext != "enc:user-1:"+testTOTPSecret {
+				t.Errorf("SecretCiphertext = %q, want encrypted with user id", c.SecretCiphertext)
+			}
+			if c.IsConfirmed() {
+				t.Error("credential must not be confirmed before ConfirmTOTP")
+			}
+		})
+	}
+}
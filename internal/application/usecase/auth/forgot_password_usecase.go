@@ -0,0 +1,224 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// PasswordResetOptions はパスワード再設定の設定です。
+type PasswordResetOptions struct {
+	// 再設定用トークンの有効期間
+	TokenTTL time.Duration
+
+	// メールに記載する再設定画面の URL。トークンをクエリパラメーター token として付与する
+	URL string
+
+	// 再設定メールを再送できる間隔
+	ResendInterval time.Duration
+
+	// 1 時間あたりに送信できる再設定メールの上限
+	ResendLimit int
+}
+
+// ForgotPasswordUsecase は「パスワードを忘れたユーザーに再設定用のリンクをメールで送る」というユースケースを表します。
+//
+// 再設定用のトークンはハッシュ値のみを保存し、平文はメールに記載するリンクにのみ含めます。
+// 新しいトークンを発行すると、同じユーザーの未使用のトークンは無効にします（最後に送ったリンクのみ有効）。
+//
+// ログインと同様に、登録済みのメールアドレスかどうかを外部から推測できないよう、
+// 未登録のメールアドレスでもエラーにはせず、メールを送らずに成功として扱います。
+// 確認メールの再送と同じく、直前の送信から ResendInterval が経過していない場合と、
+// 直近 1 時間の送信回数が ResendLimit に達している場合もメールを送らずに成功として扱います。
+//
+// 応答時間の差からも推測できないよう、ユーザーの取得以降（トークンの発行・メールの送信）はリクエストとは別の
+// ゴルーチンで処理し、入力チェックのみで応答します。そのため、処理中のエラーやメールの送信の失敗はログに記録するのみです。
+// 停止時は Wait で処理中の申請の完了を待ちます。
+type ForgotPasswordUsecase struct {
+	userRepository               repository.UserRepository
+	passwordResetTokenRepository repository.PasswordResetTokenRepository
+	tokenGenerator               port.SecureTokenGenerator
+	mailer                       port.MailSender
+	tx                           port.TransactionManager
+	logger                       port.Logger
+	options                      PasswordResetOptions
+	now                          func() time.Time
+
+	// 処理中の申請（停止時に完了を待つために使用）
+	wg sync.WaitGroup
+}
+
+// NewForgotPasswordUsecase は ForgotPasswordUsecase のコンストラクタです。
+func NewForgotPasswordUsecase(
+	userRepository repository.UserRepository,
+	passwordResetTokenRepository repository.PasswordResetTokenRepository,
+	tokenGenerator port.SecureTokenGenerator,
+	mailer port.MailSender,
+	tx port.TransactionManager,
+	logger port.Logger,
+	options PasswordResetOptions,
+) *ForgotPasswordUsecase {
+	return &ForgotPasswordUsecase{
+		userRepository:               userRepository,
+		passwordResetTokenRepository: passwordResetTokenRepository,
+		tokenGenerator:               tokenGenerator,
+		mailer:                       mailer,
+		tx:                           tx,
+		logger:                       logger,
+		options:                      options,
+		now:                          time.Now,
+	}
+}
+
+// ForgotPassword はパスワード再設定申請ユースケースのエントリポイントです。
+//
+//  1. 必須入力チェック
+//  2. 以降の処理（process）をリクエストとは別のゴルーチンで開始して終了
+func (uc *ForgotPasswordUsecase) ForgotPassword(ctx context.Context, cmd authdto.ForgotPasswordCommand) (err error) {
+
+	ctx, span := tracing.Start(ctx, "ForgotPasswordUsecase.ForgotPassword")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.Email == "" {
+		return value_obj.UserRequiredError
+	}
+
+	// 応答の後も処理を続けるため、リクエストの終了によるキャンセルを引き継がないコンテキストを使用する
+	bg := context.WithoutCancel(ctx)
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.process(bg, cmd.Email); err != nil {
+			uc.logger.Error(bg, "failed to process password reset request", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Wait は処理中の申請がすべて完了するか、ctx の期限を過ぎるまで待ちます。
+// サーバーの停止時に、データベースの接続を閉じる前に呼び出します。
+func (uc *ForgotPasswordUsecase) Wait(ctx context.Context) error {
+
+	done := make(chan struct{})
+	go func() {
+		uc.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("password reset requests are still in progress: %w", ctx.Err())
+	}
+}
+
+// process はパスワード再設定の申請を処理します。
+//
+//  1. メールアドレスによるユーザー取得（未登録の場合はメールを送らずに終了）
+//  2. 直近に発行したトークンから申請の間隔・回数を確認（制限中の場合はメールを送らずに終了）
+//  3. 再設定用トークンの生成
+//  4. 未使用のトークンの無効化と、新しいトークンの保存（トランザクション内）
+//  5. 再設定用のリンクを記載したメールの送信（失敗した場合はログに記録）
+func (uc *ForgotPasswordUsecase) process(ctx context.Context, email string) (err error) {
+
+	ctx, span := tracing.Start(ctx, "ForgotPasswordUsecase.process")
+	defer func() { tracing.End(span, err) }()
+
+	// ユーザー取得
+	u, err := uc.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			uc.logger.Info(ctx, "password reset requested", "reason", "user_not_found")
+			return nil
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// 申請の間隔・回数チェック
+	recent, err := uc.passwordResetTokenRepository.FindRecentByUserID(ctx, u.ID, uc.options.ResendLimit)
+	if err != nil {
+		return fmt.Errorf("failed to find password reset tokens: %w", err)
+	}
+	sentAt := make([]time.Time, 0, len(recent))
+	for _, t := range recent {
+		sentAt = append(sentAt, t.CreatedAt)
+	}
+	now := uc.now()
+	if isSendThrottled(now, sentAt, uc.options.ResendInterval, uc.options.ResendLimit) {
+		uc.logger.Info(ctx, "password reset requested", "reason", "throttled", "target_user_id", u.ID)
+		return nil
+	}
+
+	// トークン生成
+	token, hash, err := uc.tokenGenerator.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate password reset token: %w", err)
+	}
+	link, err := uc.resetLink(token)
+	if err != nil {
+		return err
+	}
+
+	// Entity生成
+	t, err := entity.NewPasswordResetToken(u.ID, hash, now, now.Add(uc.options.TokenTTL))
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	// 未使用のトークンの無効化と保存
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.passwordResetTokenRepository.InvalidateByUserID(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+		}
+		if err := uc.passwordResetTokenRepository.CreatePasswordResetToken(ctx, t); err != nil {
+			return fmt.Errorf("failed to save password reset token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// メール送信
+	if err := uc.mailer.Send(ctx, port.Mail{
+		To:      u.Email,
+		Subject: "パスワード再設定のご案内",
+		Body: fmt.Sprintf(
+			"%s さん\n\nパスワードの再設定が申請されました。\n以下のリンクから %d 分以内に新しいパスワードを設定してください。\n\n%s\n\nお心当たりがない場合は、このメールを破棄してください。パスワードは変更されません。\n",
+			u.Name, int(uc.options.TokenTTL.Minutes()), link,
+		),
+	}); err != nil {
+		// 応答は返却済みのため、送信の失敗は対象のユーザーとともにログに記録する
+		uc.logger.Error(ctx, "failed to send password reset mail", "target_user_id", u.ID, "error", err)
+		return nil
+	}
+	uc.logger.Info(ctx, "password reset mail sent", "target_user_id", u.ID)
+
+	return nil
+}
+
+// resetLink は再設定画面の URL に平文のトークンを付与したリンクを返します。
+func (uc *ForgotPasswordUsecase) resetLink(token string) (string, error) {
+
+	u, err := url.Parse(uc.options.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid password reset url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,318 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testmail "app/internal/test/mail"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testPasswordResetTokenRepository はメモリ上でパスワード再設定トークンを保持するテストリポジトリです。
+// 使用・無効化の結果を、保存済みトークンの状態から確認できるようにしています。
+type testPasswordResetTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*entity.PasswordResetToken
+}
+
+func newTestPasswordResetTokenRepository() *testPasswordResetTokenRepository {
+	return &testPasswordResetTokenRepository{tokens: map[string]*entity.PasswordResetToken{}}
+}
+
+func (m *testPasswordResetTokenRepository) CreatePasswordResetToken(_ context.Context, t *entity.PasswordResetToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[t.TokenHash] = t
+	return nil
+}
+
+func (m *testPasswordResetTokenRepository) FindByTokenHash(_ context.Context, hash string) (*entity.PasswordResetToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok {
+		return nil, value_obj.UserPasswordResetTokenInvalidError
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *testPasswordResetTokenRepository) FindRecentByUserID(_ context.Context, userID string, limit int) ([]*entity.PasswordResetToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var tokens []*entity.PasswordResetToken
+	for _, t := range m.tokens {
+		if t.UserID == userID {
+			tokens = append(tokens, t)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].CreatedAt.After(tokens[j].CreatedAt) })
+	if len(tokens) > limit {
+		tokens = tokens[:limit]
+	}
+	return tokens, nil
+}
+
+func (m *testPasswordResetTokenRepository) UsePasswordResetToken(_ context.Context, hash string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok || t.UsedAt != nil {
+		return value_obj.UserPasswordResetTokenInvalidError
+	}
+	t.UsedAt = &at
+	return nil
+}
+
+func (m *testPasswordResetTokenRepository) InvalidateByUserID(_ context.Context, userID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tokens {
+		if t.UserID == userID && t.UsedAt == nil {
+			t.UsedAt = &at
+		}
+	}
+	return nil
+}
+
+var _ repo.PasswordResetTokenRepository = (*testPasswordResetTokenRepository)(nil)
+
+// testPasswordResetOptions はテスト用のパスワード再設定の設定です。
+var testPasswordResetOptions = PasswordResetOptions{
+	TokenTTL:       30 * time.Minute,
+	URL:            "https://app.example.com/password/reset",
+	ResendInterval: time.Minute,
+	ResendLimit:    3,
+}
+
+// waitForgotPassword は処理中のパスワード再設定の申請が完了するまで待ちます。
+func waitForgotPassword(t *testing.T, uc *ForgotPasswordUsecase) {
+	t.Helper()
+
+	if err := uc.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+}
+
+// TestForgotPasswordUsecase_ForgotPassword はパスワード再設定申請ユースケースの振る舞いを検証します。
+// 未登録のメールアドレスではメールを送らずに成功し、登録済みの場合はハッシュ値のみを保存して
+// 平文のトークンを含むリンクをメールで送ることを確認します。
+// 送信の制限中・メールの送信に失敗した場合も、登録済みかを推測できないよう成功として扱うことを確認します。
+// メールの送信などは応答の後に別のゴルーチンで行うため、結果は処理の完了を待ってから確認します。
+func TestForgotPasswordUsecase_ForgotPassword(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	alice := &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed-Password1", Role: "member"}
+	users := &testUserRepository{
+		findByEmailFn: func(_ context.Context, email string) (*entity.User, error) {
+			if email == alice.Email {
+				return alice, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+
+	t.Run("required empty", func(t *testing.T) {
+		t.Parallel()
+
+		uc := NewForgotPasswordUsecase(users, newTestPasswordResetTokenRepository(), &testTokenGenerator{}, testmail.NewFake(), testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+
+		if err := uc.ForgotPassword(ctx, authdto.ForgotPasswordCommand{}); !errors.Is(err, value_obj.UserRequiredError) {
+			t.Fatalf("expected error %v, got %v", value_obj.UserRequiredError, err)
+		}
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := newTestPasswordResetTokenRepository()
+		mailer := testmail.NewFake()
+		uc := NewForgotPasswordUsecase(users, tokens, &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+
+		if err := uc.ForgotPassword(ctx, authdto.ForgotPasswordCommand{Email: "bob@example.com"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		waitForgotPassword(t, uc)
+		if len(mailer.Sent()) != 0 || len(tokens.tokens) != 0 {
+			t.Errorf("sent = %d, tokens = %d, want no mail and no token", len(mailer.Sent()), len(tokens.tokens))
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := newTestPasswordResetTokenRepository()
+		mailer := testmail.NewFake()
+		uc := NewForgotPasswordUsecase(users, tokens, &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+		now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+		uc.now = func() time.Time { return now }
+
+		// 間隔をあけて 2 回申請すると、最後に送ったリンクのトークンのみ有効
+		for i := 0; i < 2; i++ {
+			if err := uc.ForgotPassword(ctx, authdto.ForgotPasswordCommand{Email: alice.Email}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			waitForgotPassword(t, uc)
+			now = now.Add(2 * time.Minute)
+		}
+
+		sent := mailer.Sent()
+		if len(sent) != 2 {
+			t.Fatalf("sent = %d, want 2", len(sent))
+		}
+		if sent[1].To != alice.Email {
+			t.Errorf("To = %q, want %q", sent[1].To, alice.Email)
+		}
+		if want := "https://app.example.com/password/reset?token=refresh-2"; !strings.Contains(sent[1].Body, want) {
+			t.Errorf("body does not contain link %q:\n%s", want, sent[1].Body)
+		}
+
+		latest, ok := tokens.tokens["hash-refresh-2"]
+		if !ok {
+			t.Fatalf("reset token was not stored by hash: %v", tokens.tokens)
+		}
+		issuedAt := now.Add(-2 * time.Minute)
+		if latest.UserID != alice.ID || latest.IsUsed() || !latest.ExpiresAt.Equal(issuedAt.Add(30*time.Minute)) {
+			t.Errorf("latest = %+v, want unused token of %s expiring at %v", latest, alice.ID, issuedAt.Add(30*time.Minute))
+		}
+		if !tokens.tokens["hash-refresh-1"].IsUsed() {
+			t.Error("previous token must be invalidated")
+		}
+	})
+
+	t.Run("canceled request", func(t *testing.T) {
+		t.Parallel()
+
+		// リクエストがキャンセルされても（応答の後を含む）、ユーザーの取得以降の処理は中断しない
+		canceled := &testUserRepository{
+			findByEmailFn: func(ctx context.Context, email string) (*entity.User, error) {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				return users.FindByEmail(ctx, email)
+			},
+		}
+		mailer := testmail.NewFake()
+		uc := NewForgotPasswordUsecase(canceled, newTestPasswordResetTokenRepository(), &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+
+		reqCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		if err := uc.ForgotPassword(reqCtx, authdto.ForgotPasswordCommand{Email: alice.Email}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		waitForgotPassword(t, uc)
+		if len(mailer.Sent()) != 1 {
+			t.Errorf("sent = %d, want 1", len(mailer.Sent()))
+		}
+	})
+
+	t.Run("mail error", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := newTestPasswordResetTokenRepository()
+		mailer := testmail.NewFake()
+		mailer.Err = errors.New("smtp error")
+		uc := NewForgotPasswordUsecase(users, tokens, &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+
+		// 送信の失敗は未登録のメールアドレスと同じく成功として扱う
+		if err := uc.ForgotPassword(ctx, authdto.ForgotPasswordCommand{Email: alice.Email}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		waitForgotPassword(t, uc)
+		if len(tokens.tokens) != 1 {
+			t.Errorf("tokens = %d, want 1", len(tokens.tokens))
+		}
+	})
+}
+
+// TestForgotPasswordUsecase_ForgotPassword_Throttle はパスワード再設定メールの送信の間隔・回数の制限を検証します。
+// 制限中の申請はメールを送らずに成功として扱うことを確認します。
+func TestForgotPasswordUsecase_ForgotPassword_Throttle(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	alice := &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed-Password1", Role: "member"}
+	users := &testUserRepository{
+		findByEmailFn: func(_ context.Context, email string) (*entity.User, error) {
+			if email == alice.Email {
+				return alice, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+
+	// sentAt は alice に再設定メールを送った日時（現在からの経過時間）の一覧からトークンを作成します。
+	sentAt := func(ago ...time.Duration) *testPasswordResetTokenRepository {
+		tokens := newTestPasswordResetTokenRepository()
+		for i, d := range ago {
+			hash := fmt.Sprintf("hash-%d", i)
+			tokens.tokens[hash] = &entity.PasswordResetToken{TokenHash: hash, UserID: alice.ID, CreatedAt: now.Add(-d), ExpiresAt: now.Add(30*time.Minute - d)}
+		}
+		return tokens
+	}
+
+	tests := map[string]struct {
+		tokens   *testPasswordResetTokenRepository
+		wantSent bool
+	}{
+		"within resend interval": {
+			tokens: sentAt(30 * time.Second),
+		},
+		"hourly limit reached": {
+			tokens: sentAt(2*time.Minute, 20*time.Minute, 59*time.Minute),
+		},
+		"limit window passed": {
+			tokens:   sentAt(2*time.Minute, 20*time.Minute, 61*time.Minute),
+			wantSent: true,
+		},
+		"first request": {
+			tokens:   sentAt(),
+			wantSent: true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ForgotPasswordUsecase ケース開始: %s", name)
+
+			before := len(tt.tokens.tokens)
+			mailer := testmail.NewFake()
+			uc := NewForgotPasswordUsecase(users, tt.tokens, &testTokenGenerator{}, mailer, testtx.NewFake(), testlogger.NewPortLogger(t), testPasswordResetOptions)
+			uc.now = func() time.Time { return now }
+
+			if err := uc.ForgotPassword(context.Background(), authdto.ForgotPasswordCommand{Email: alice.Email}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			waitForgotPassword(t, uc)
+			if tt.wantSent != (len(mailer.Sent()) == 1) {
+				t.Fatalf("sent = %d, want sent = %v", len(mailer.Sent()), tt.wantSent)
+			}
+			if !tt.wantSent && len(tt.tokens.tokens) != before {
+				t.Errorf("tokens = %d, want no new token while throttled", len(tt.tokens.tokens))
+			}
+		})
+	}
+}
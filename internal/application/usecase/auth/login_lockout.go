@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"app/internal/application/port"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"context"
+	"time"
+)
+
+// LoginLockoutOptions はログインの失敗によるロックアウトの設定です。
+type LoginLockoutOptions struct {
+	// ロックアウトするまでに連続して失敗できる回数
+	MaxFailures int
+
+	// 最後の失敗からログインを拒否する期間（この期間より前の失敗は数え直す）
+	Duration time.Duration
+}
+
+// loginLockout はログインとその 2 段階目で共通の、ユーザーごとの失敗回数の記録とロックアウトの判定を行います。
+//
+// パスワードと 2 段階目のコードの誤りを同じ回数として数えるため、
+// チャレンジを発行し直しながらコードを総当たりすることもできません。
+// 失敗回数はトークンの組を発行した時点（ログインの完了時）にのみリセットし、
+// 2 段階認証を有効にしているユーザーのパスワードの一致ではリセットしません。
+type loginLockout struct {
+	userRepository repository.UserRepository
+	logger         port.Logger
+	options        LoginLockoutOptions
+}
+
+// isLocked は指定時刻時点でユーザーのログインを拒否するかを返します。
+func (l *loginLockout) isLocked(u *entity.User, now time.Time) bool {
+	return u.IsLoginLocked(now, l.options.MaxFailures, l.options.Duration)
+}
+
+// recordFailure はログインの失敗を記録します。
+// 記録に失敗した場合は警告ログのみ出力します。
+func (l *loginLockout) recordFailure(ctx context.Context, userID string, now time.Time) {
+
+	if err := l.userRepository.RecordLoginFailure(ctx, userID, now, now.Add(-l.options.Duration)); err != nil {
+		l.logger.Warn(ctx, "failed to record login failure", "target_user_id", userID, "error", err)
+	}
+}
+
+// reset はログインの完了時に失敗回数を 0 に戻します（失敗していない場合は何もしない）。
+// リセットに失敗した場合は警告ログのみ出力します。
+func (l *loginLockout) reset(ctx context.Context, u *entity.User) {
+
+	if u.FailedLoginCount == 0 {
+		return
+	}
+	if err := l.userRepository.ResetLoginFailures(ctx, u.ID); err != nil {
+		l.logger.Warn(ctx, "failed to reset login failures", "target_user_id", u.ID, "error", err)
+	}
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestLoginLockout はパスワードと 2 段階目のコードの誤りがユーザーごとに数えられ、
+// 上限に達したユーザーは正しいパスワード・コードでもロックアウトの期間が過ぎるまでログインできないことを検証します。
+func TestLoginLockout(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	now := time.Now()
+
+	// setup はログインとその 2 段階目のユースケースを、同じユーザーリポジトリ・チャレンジ・時刻で組み立てます。
+	type fixture struct {
+		users  *testUserRepository
+		login  *LoginUsecase
+		verify *VerifyTwoFactorUsecase
+		clock  time.Time
+	}
+	setup := func(t *testing.T, u *entity.User) *fixture {
+		f := &fixture{clock: now}
+		f.users = &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) { return u, nil },
+			findByIDFn:    func(context.Context, string) (*entity.User, error) { return u, nil },
+		}
+		challenges := newTestTwoFactorChallengeRepository()
+		credentials := newTestTOTPCredentialRepository()
+		credentials.credentials[u.ID] = &entity.TOTPCredential{UserID: u.ID, SecretCiphertext: "enc:" + u.ID + ":" + testTOTPSecret, ConfirmedAt: &now}
+		gen := &testTokenGenerator{}
+		f.login = NewLoginUsecase(f.users, newTestRefreshTokenRepository(), challenges, testPasswordHasher{}, testTokenIssuer{}, gen, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+		f.verify = NewVerifyTwoFactorUsecase(f.users, newTestRefreshTokenRepository(), challenges, credentials, newTestRecoveryCodeRepository(), testTOTP{}, testSecretCipher{}, testRecoveryCodeGenerator{}, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+		f.login.tokens.now = func() time.Time { return f.clock }
+		f.verify.tokens.now = func() time.Time { return f.clock }
+		return f
+	}
+
+	t.Run("wrong passwords lock the account", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("ログインのロックアウト（パスワード）ケース開始")
+
+		f := setup(t, &entity.User{ID: "user-1", Email: "alice@example.com", Password: "hashed-Password1", Role: "member", EmailVerifiedAt: &now})
+		wrong := authdto.LoginCommand{Email: "alice@example.com", Password: "Wrong1234"}
+		right := authdto.LoginCommand{Email: "alice@example.com", Password: "Password1"}
+
+		for i := 0; i < testLoginLockoutOptions.MaxFailures; i++ {
+			if _, err := f.login.Login(ctx, wrong); !errors.Is(err, value_obj.UserLoginFailedError) {
+				t.Fatalf("Login(wrong) #%d error = %v, want %v", i+1, err, value_obj.UserLoginFailedError)
+			}
+		}
+		if _, err := f.login.Login(ctx, right); !errors.Is(err, value_obj.UserLoginFailedError) {
+			t.Fatalf("Login(right) while locked error = %v, want %v", err, value_obj.UserLoginFailedError)
+		}
+
+		// ロックアウトの期間が過ぎればログインでき、失敗回数はリセットされる
+		f.clock = now.Add(testLoginLockoutOptions.Duration)
+		if _, err := f.login.Login(ctx, right); err != nil {
+			t.Fatalf("Login(right) after lockout error = %v", err)
+		}
+		if _, ok := f.users.failures["user-1"]; ok {
+			t.Errorf("failures = %+v, want reset after login", f.users.failures["user-1"])
+		}
+	})
+
+	t.Run("wrong codes across challenges lock the account", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("ログインのロックアウト（2 段階目のコード）ケース開始")
+
+		f := setup(t, &entity.User{ID: "user-2", Email: "bob@example.com", Password: "hashed-Password1", Role: "admin", EmailVerifiedAt: &now, TwoFactorEnabledAt: &now})
+		password := authdto.LoginCommand{Email: "bob@example.com", Password: "Password1"}
+
+		// チャレンジごとの上限まで誤ったコードを入力し、チャレンジを発行し直して続ける
+		var token string
+		for failures := 0; failures < testLoginLockoutOptions.MaxFailures; failures++ {
+			if failures%testTwoFactorOptions.MaxAttempts == 0 {
+				res, err := f.login.Login(ctx, password)
+				if err != nil {
+					t.Fatalf("Login() before failure #%d error = %v", failures+1, err)
+				}
+				token = res.TwoFactorToken
+			}
+			_, err := f.verify.VerifyTwoFactor(ctx, authdto.VerifyTwoFactorCommand{TwoFactorToken: token, Code: "wrong!"})
+			if !errors.Is(err, value_obj.UserTwoFactorCodeInvalidError) {
+				t.Fatalf("VerifyTwoFactor(wrong) #%d error = %v, want %v", failures+1, err, value_obj.UserTwoFactorCodeInvalidError)
+			}
+		}
+
+		// 上限に達した後は、残りの入力回数があるチャレンジでも正しいコードを拒否し、新しいチャレンジも発行しない
+		code := "000001"
+		if _, err := f.verify.VerifyTwoFactor(ctx, authdto.VerifyTwoFactorCommand{TwoFactorToken: token, Code: code}); !errors.Is(err, value_obj.UserTwoFactorChallengeInvalidError) {
+			t.Fatalf("VerifyTwoFactor(right) while locked error = %v, want %v", err, value_obj.UserTwoFactorChallengeInvalidError)
+		}
+		if _, err := f.login.Login(ctx, password); !errors.Is(err, value_obj.UserLoginFailedError) {
+			t.Fatalf("Login() while locked error = %v, want %v", err, value_obj.UserLoginFailedError)
+		}
+
+		// ロックアウトの期間が過ぎれば 2 段階目まで完了でき、失敗回数はリセットされる
+		f.clock = now.Add(testLoginLockoutOptions.Duration)
+		res, err := f.login.Login(ctx, password)
+		if err != nil {
+			t.Fatalf("Login() after lockout error = %v", err)
+		}
+		if _, err := f.verify.VerifyTwoFactor(ctx, authdto.VerifyTwoFactorCommand{TwoFactorToken: res.TwoFactorToken, Code: code}); err != nil {
+			t.Fatalf("VerifyTwoFactor(right) after lockout error = %v", err)
+		}
+		if _, ok := f.users.failures["user-2"]; ok {
+			t.Errorf("failures = %+v, want reset after login", f.users.failures["user-2"])
+		}
+	})
+}
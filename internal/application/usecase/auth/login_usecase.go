@@ -0,0 +1,181 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// LoginUsecase は「メールアドレスとパスワードでログインする」というアプリケーションユースケースを表します。
+//
+// このユースケースの責務は次の通りです。
+//   - メールアドレスでユーザーを取得し、PasswordHasher でパスワードを検証する
+//   - 認証に成功した場合はアクセストークンとリフレッシュトークンを発行する
+//   - 2 段階認証を有効にしているユーザーの場合はトークンの組の代わりに 2 段階目のチャレンジを発行する
+//
+// ユーザーが存在しない場合とパスワードが一致しない場合は、どちらも同じ UserLoginFailedError を返し、
+// 登録済みのメールアドレスかどうかを外部から推測できないようにしています。
+// 失敗の理由はログにのみ出力します。
+//
+// パスワードの誤りはユーザーごとに数え、連続した失敗が LoginLockoutOptions.MaxFailures 回に達したユーザーは、
+// 最後の失敗から LoginLockoutOptions.Duration の間、正しいパスワードでもログインを拒否します。
+// ロックアウト中も同じ UserLoginFailedError を返します。
+type LoginUsecase struct {
+	userRepository               repository.UserRepository
+	twoFactorChallengeRepository repository.TwoFactorChallengeRepository
+	hasher                       port.PasswordHasher
+	tokens                       *tokenPairIssuer
+	lockout                      *loginLockout
+	logger                       port.Logger
+	options                      TwoFactorOptions
+}
+
+// NewLoginUsecase は LoginUsecase のコンストラクタです。
+func NewLoginUsecase(
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	twoFactorChallengeRepository repository.TwoFactorChallengeRepository,
+	hasher port.PasswordHasher,
+	issuer port.TokenIssuer,
+	tokenGenerator port.SecureTokenGenerator,
+	logger port.Logger,
+	options TwoFactorOptions,
+	lockoutOptions LoginLockoutOptions,
+) *LoginUsecase {
+	return &LoginUsecase{
+		userRepository:               userRepository,
+		twoFactorChallengeRepository: twoFactorChallengeRepository,
+		hasher:                       hasher,
+		logger:                       logger,
+		options:                      options,
+		tokens: &tokenPairIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			issuer:                 issuer,
+			tokenGenerator:         tokenGenerator,
+			now:                    time.Now,
+		},
+		lockout: &loginLockout{
+			userRepository: userRepository,
+			logger:         logger,
+			options:        lockoutOptions,
+		},
+	}
+}
+
+// Login はログインユースケースのエントリポイントです。
+//
+//  1. 必須入力チェック
+//  2. メールアドレスによるユーザー取得（UserRepository.FindByEmail）
+//  3. ロックアウト中かの確認
+//  4. パスワードの検証（PasswordHasher.Compare。誤っていれば失敗を記録）
+//  5. 保存済みのハッシュが古い設定で作成されていれば、現在の設定で作り直して保存
+//  6. 2 段階認証を有効にしていれば、2 段階目のチャレンジを発行して終了
+//  7. トークンの組の発行と、リフレッシュトークンの保存、失敗回数のリセット
+func (uc *LoginUsecase) Login(ctx context.Context, cmd authdto.LoginCommand) (_ *authdto.LoginResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "LoginUsecase.Login")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.Email == "" || cmd.Password == "" {
+		return nil, value_obj.UserRequiredError
+	}
+
+	// ユーザー取得
+	u, err := uc.userRepository.FindByEmail(ctx, cmd.Email)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			uc.logger.Warn(ctx, "login failed", "reason", "user_not_found")
+			return nil, value_obj.UserLoginFailedError
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// ロックアウトの確認
+	now := uc.tokens.now()
+	if uc.lockout.isLocked(u, now) {
+		uc.logger.Warn(ctx, "login failed", "reason", "locked", "target_user_id", u.ID)
+		return nil, value_obj.UserLoginFailedError
+	}
+
+	// パスワード検証
+	match, needsRehash := uc.hasher.Compare(ctx, cmd.Password, u.Password)
+	if !match {
+		uc.logger.Warn(ctx, "login failed", "reason", "password_mismatch", "target_user_id", u.ID)
+		uc.lockout.recordFailure(ctx, u.ID, now)
+		return nil, value_obj.UserLoginFailedError
+	}
+
+	// ハッシュの作り直し
+	if needsRehash {
+		uc.rehash(ctx, u.ID, cmd.Password)
+	}
+
+	// 2 段階目のチャレンジ発行
+	if u.IsTwoFactorEnabled() {
+		return uc.challenge(ctx, u)
+	}
+
+	// トークン発行
+	// 2 段階認証が必須の権限で未設定の場合は、メンバーの権限で発行し設定を促す
+	res, _, err := uc.tokens.issue(ctx, u, "")
+	if err != nil {
+		return nil, err
+	}
+	uc.lockout.reset(ctx, u)
+	uc.logger.Info(ctx, "login succeeded", "target_user_id", u.ID)
+
+	return &authdto.LoginResponse{
+		TokenResponse:          res,
+		TwoFactorSetupRequired: value_obj.ParseRole(u.Role).RequiresTwoFactor(),
+	}, nil
+}
+
+// challenge はログインの 2 段階目で使用するトークンを発行し、ハッシュ値のみを保存します。
+func (uc *LoginUsecase) challenge(ctx context.Context, u *entity.User) (*authdto.LoginResponse, error) {
+
+	token, hash, err := uc.tokens.tokenGenerator.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate two-factor token: %w", err)
+	}
+
+	now := uc.tokens.now()
+	c, err := entity.NewTwoFactorChallenge(u.ID, hash, now, now.Add(uc.options.ChallengeTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create two-factor challenge: %w", err)
+	}
+	if err := uc.twoFactorChallengeRepository.CreateTwoFactorChallenge(ctx, c); err != nil {
+		return nil, fmt.Errorf("failed to save two-factor challenge: %w", err)
+	}
+	uc.logger.Info(ctx, "login requires two-factor", "target_user_id", u.ID)
+
+	return &authdto.LoginResponse{
+		TwoFactorRequired:  true,
+		TwoFactorToken:     token,
+		TwoFactorExpiresIn: int64(uc.options.ChallengeTTL.Seconds()),
+	}, nil
+}
+
+// rehash は検証に成功したパスワードを現在の設定でハッシュ化し直して保存します。
+// 失敗してもログインには影響させず、警告ログのみ出力します（次回のログインで再度作り直す）。
+func (uc *LoginUsecase) rehash(ctx context.Context, userID, password string) {
+
+	hashed, err := uc.hasher.Hash(ctx, password)
+	if err != nil {
+		uc.logger.Warn(ctx, "failed to rehash password", "target_user_id", userID, "error", err)
+		return
+	}
+	if err := uc.userRepository.UpdatePassword(ctx, userID, hashed); err != nil {
+		uc.logger.Warn(ctx, "failed to rehash password", "target_user_id", userID, "error", err)
+		return
+	}
+
+	uc.logger.Info(ctx, "password rehashed", "target_user_id", userID)
+}
@@ -0,0 +1,470 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testUserRepository は認証ユースケース用のテストリポジトリです。
+// FindByEmail / FindByID の戻り値を差し替えて、ユーザーの有無による分岐を検証します。
+// UpdatePassword で保存されたハッシュは passwords に、MarkEmailVerified で確認したメールアドレスは verified に、
+// EnableTwoFactor で 2 段階認証を有効にしたユーザーは twoFactor に記録します。
+// RecordLoginFailure で記録したログインの失敗は failures に保持し、FindByEmail / FindByID の結果に反映します。
+type testUserRepository struct {
+	findByEmailFn func(ctx context.Context, email string) (*entity.User, error)
+	findByIDFn    func(ctx context.Context, id string) (*entity.User, error)
+
+	mu        sync.Mutex
+	passwords map[string]string
+	verified  map[string]string
+	twoFactor map[string]time.Time
+	failures  map[string]entity.User
+}
+
+func (m *testUserRepository) CreateUser(context.Context, *entity.User) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) ExistsByEmail(context.Context, string) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+func (m *testUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	if m.findByIDFn != nil {
+		return m.withFailures(m.findByIDFn(ctx, id))
+	}
+	return nil, value_obj.UserNotFoundError
+}
+
+func (m *testUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	if m.findByEmailFn != nil {
+		return m.withFailures(m.findByEmailFn(ctx, email))
+	}
+	return nil, value_obj.UserNotFoundError
+}
+
+// withFailures は記録済みのログインの失敗を反映したユーザーのコピーを返します。
+func (m *testUserRepository) withFailures(u *entity.User, err error) (*entity.User, error) {
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.failures[u.ID]
+	if !ok {
+		return u, nil
+	}
+	copied := *u
+	copied.FailedLoginCount = f.FailedLoginCount
+	copied.LastFailedLoginAt = f.LastFailedLoginAt
+	return &copied, nil
+}
+
+func (m *testUserRepository) FindByUser(context.Context, string, string, string) (*entity.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) ListUsers(context.Context, repo.UserListCriteria) (*repo.UserPage, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) UpdateUser(context.Context, *entity.User) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) UpdatePassword(_ context.Context, id string, hashedPassword string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.passwords == nil {
+		m.passwords = map[string]string{}
+	}
+	m.passwords[id] = hashedPassword
+	return nil
+}
+
+func (m *testUserRepository) MarkEmailVerified(_ context.Context, id string, email string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.verified == nil {
+		m.verified = map[string]string{}
+	}
+	m.verified[id] = email
+	return nil
+}
+
+func (m *testUserRepository) EnableTwoFactor(_ context.Context, id string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.twoFactor == nil {
+		m.twoFactor = map[string]time.Time{}
+	}
+	m.twoFactor[id] = at
+	return nil
+}
+
+func (m *testUserRepository) RecordLoginFailure(_ context.Context, id string, at time.Time, resetBefore time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failures == nil {
+		m.failures = map[string]entity.User{}
+	}
+	f := m.failures[id]
+	if f.LastFailedLoginAt == nil || f.LastFailedLoginAt.Before(resetBefore) {
+		f.FailedLoginCount = 0
+	}
+	f.FailedLoginCount++
+	f.LastFailedLoginAt = &at
+	m.failures[id] = f
+	return nil
+}
+
+func (m *testUserRepository) ResetLoginFailures(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.failures, id)
+	return nil
+}
+
+func (m *testUserRepository) DeleteUser(context.Context, string) error {
+	return errors.New("not implemented")
+}
+
+// testRefreshTokenRepository はメモリ上でリフレッシュトークンを保持するテストリポジトリです。
+// ローテーションや失効の結果を、保存済みトークンの状態から確認できるようにしています。
+type testRefreshTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]*entity.RefreshToken
+}
+
+func newTestRefreshTokenRepository() *testRefreshTokenRepository {
+	return &testRefreshTokenRepository{tokens: map[string]*entity.RefreshToken{}}
+}
+
+func (m *testRefreshTokenRepository) CreateRefreshToken(_ context.Context, t *entity.RefreshToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[t.TokenHash] = t
+	return nil
+}
+
+func (m *testRefreshTokenRepository) FindByTokenHash(_ context.Context, hash string) (*entity.RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok {
+		return nil, value_obj.UserRefreshTokenInvalidError
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *testRefreshTokenRepository) RevokeRefreshToken(_ context.Context, hash string, replacedBy string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tokens[hash]
+	if !ok || t.RevokedAt != nil {
+		return value_obj.UserRefreshTokenInvalidError
+	}
+	t.RevokedAt = &at
+	t.ReplacedBy = replacedBy
+	return nil
+}
+
+func (m *testRefreshTokenRepository) RevokeFamily(_ context.Context, familyID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+func (m *testRefreshTokenRepository) RevokeByUserID(_ context.Context, userID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tokens {
+		if t.UserID == userID && t.RevokedAt == nil {
+			t.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+// testTokenIssuer は署名を行わず、ユーザーIDと権限をそのまま埋め込むテスト用の発行者です。
+type testTokenIssuer struct{}
+
+func (testTokenIssuer) IssueAccessToken(userID string, role string) (string, time.Time, error) {
+	return "access-" + userID + "-" + role, time.Now().Add(15 * time.Minute), nil
+}
+
+func (testTokenIssuer) ParseAccessToken(string) (*port.AccessTokenClaims, error) {
+	return nil, errors.New("not implemented")
+}
+
+// testTokenGenerator は連番のトークンを生成するテスト用の実装です。
+type testTokenGenerator struct {
+	mu  sync.Mutex
+	seq int
+}
+
+func (g *testTokenGenerator) Generate() (string, string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.seq++
+	token := fmt.Sprintf("refresh-%d", g.seq)
+	return token, g.Hash(token), nil
+}
+
+func (g *testTokenGenerator) Hash(token string) string {
+	return "hash-" + token
+}
+
+var _ repo.UserRepository = (*testUserRepository)(nil)
+var _ repo.RefreshTokenRepository = (*testRefreshTokenRepository)(nil)
+var _ port.TokenIssuer = testTokenIssuer{}
+var _ port.SecureTokenGenerator = (*testTokenGenerator)(nil)
+
+// testPasswordHasher は平文と "hashed-" 接頭辞付きの値を比較するテストハッシャーです。
+// "legacy-" 接頭辞付きの値は、一致するが作り直しが必要な古い形式のハッシュとして扱います。
+type testPasswordHasher struct{}
+
+func (testPasswordHasher) Hash(_ context.Context, password string) (string, error) {
+	return "hashed-" + password, nil
+}
+
+func (testPasswordHasher) Compare(_ context.Context, password, hash string) (bool, bool) {
+	if hash == "legacy-"+password {
+		return true, true
+	}
+	return hash == "hashed-"+password, false
+}
+
+// TestLoginUsecase_Login はログインユースケースの振る舞いを検証します。
+// 必須入力・未登録ユーザー・パスワード不一致・正常系の各シナリオで、
+// 返却されるエラーと保存されるリフレッシュトークンを確認します。
+func TestLoginUsecase_Login(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	verifiedAt := time.Now()
+	alice := &entity.User{ID: "user-1", Email: "alice@example.com", Password: "hashed-Password1", Role: "member", EmailVerifiedAt: &verifiedAt}
+	users := &testUserRepository{
+		findByEmailFn: func(_ context.Context, email string) (*entity.User, error) {
+			if email == alice.Email {
+				return alice, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+
+	tests := map[string]struct {
+		cmd     authdto.LoginCommand
+		wantErr error
+	}{
+		"required empty": {
+			cmd:     authdto.LoginCommand{},
+			wantErr: value_obj.UserRequiredError,
+		},
+		"unknown email": {
+			cmd:     authdto.LoginCommand{Email: "bob@example.com", Password: "Password1"},
+			wantErr: value_obj.UserLoginFailedError,
+		},
+		"wrong password": {
+			cmd:     authdto.LoginCommand{Email: "alice@example.com", Password: "Wrong1234"},
+			wantErr: value_obj.UserLoginFailedError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("LoginUsecase エラーケース開始: %s", name)
+
+			uc := NewLoginUsecase(users, newTestRefreshTokenRepository(), newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+			_, err := uc.Login(ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase リポジトリエラーケース開始")
+
+		expectedErr := errors.New("db error")
+		failing := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return nil, expectedErr
+			},
+		}
+		uc := NewLoginUsecase(failing, newTestRefreshTokenRepository(), newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		_, err := uc.Login(ctx, authdto.LoginCommand{Email: "alice@example.com", Password: "Password1"})
+		if !errors.Is(err, expectedErr) {
+			t.Fatalf("expected wrapped error %v, got %v", expectedErr, err)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase 正常系ケース開始")
+
+		tokens := newTestRefreshTokenRepository()
+		uc := NewLoginUsecase(users, tokens, newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		res, err := uc.Login(ctx, authdto.LoginCommand{Email: "alice@example.com", Password: "Password1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.AccessToken != "access-user-1-member" {
+			t.Errorf("AccessToken = %q, want %q", res.AccessToken, "access-user-1-member")
+		}
+		if res.TokenType != "Bearer" {
+			t.Errorf("TokenType = %q, want %q", res.TokenType, "Bearer")
+		}
+		if res.ExpiresIn <= 0 {
+			t.Errorf("ExpiresIn = %d, want positive", res.ExpiresIn)
+		}
+
+		stored, ok := tokens.tokens["hash-"+res.RefreshToken]
+		if !ok {
+			t.Fatalf("refresh token %q was not stored by hash", res.RefreshToken)
+		}
+		if stored.UserID != "user-1" {
+			t.Errorf("stored.UserID = %q, want %q", stored.UserID, "user-1")
+		}
+		if stored.FamilyID != stored.TokenHash {
+			t.Errorf("stored.FamilyID = %q, want own hash %q", stored.FamilyID, stored.TokenHash)
+		}
+		if len(users.passwords) != 0 {
+			t.Errorf("password must not be rehashed, got %v", users.passwords)
+		}
+	})
+
+	t.Run("unverified email is issued as guest", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase メールアドレス未確認ケース開始")
+
+		carol := &entity.User{ID: "user-3", Email: "carol@example.com", Password: "hashed-Password1", Role: "admin"}
+		unverifiedUsers := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return carol, nil
+			},
+		}
+		uc := NewLoginUsecase(unverifiedUsers, newTestRefreshTokenRepository(), newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		res, err := uc.Login(ctx, authdto.LoginCommand{Email: "carol@example.com", Password: "Password1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.AccessToken != "access-user-3-guest" {
+			t.Errorf("AccessToken = %q, want %q", res.AccessToken, "access-user-3-guest")
+		}
+	})
+
+	t.Run("legacy hash is rehashed", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase ハッシュ作り直しケース開始")
+
+		bob := &entity.User{ID: "user-2", Email: "bob@example.com", Password: "legacy-Password1", Role: "member"}
+		legacyUsers := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return bob, nil
+			},
+		}
+		uc := NewLoginUsecase(legacyUsers, newTestRefreshTokenRepository(), newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		if _, err := uc.Login(ctx, authdto.LoginCommand{Email: "bob@example.com", Password: "Password1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := legacyUsers.passwords["user-2"]; got != "hashed-Password1" {
+			t.Errorf("rehashed password = %q, want %q", got, "hashed-Password1")
+		}
+	})
+
+	t.Run("admin without two-factor is issued as member", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase 2 段階認証未設定ケース開始")
+
+		verifiedAt := time.Now()
+		dave := &entity.User{ID: "user-4", Email: "dave@example.com", Password: "hashed-Password1", Role: "admin", EmailVerifiedAt: &verifiedAt}
+		admins := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return dave, nil
+			},
+		}
+		uc := NewLoginUsecase(admins, newTestRefreshTokenRepository(), newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		res, err := uc.Login(ctx, authdto.LoginCommand{Email: "dave@example.com", Password: "Password1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.AccessToken != "access-user-4-member" || !res.TwoFactorSetupRequired {
+			t.Errorf("Login() = %+v, want member token with two-factor setup required", res)
+		}
+	})
+
+	t.Run("two-factor enabled user receives a challenge", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("LoginUsecase 2 段階認証ケース開始")
+
+		now := time.Now()
+		erin := &entity.User{ID: "user-5", Email: "erin@example.com", Password: "hashed-Password1", Role: "admin", EmailVerifiedAt: &now, TwoFactorEnabledAt: &now}
+		twoFactorUsers := &testUserRepository{
+			findByEmailFn: func(context.Context, string) (*entity.User, error) {
+				return erin, nil
+			},
+		}
+		tokens := newTestRefreshTokenRepository()
+		challenges := newTestTwoFactorChallengeRepository()
+		uc := NewLoginUsecase(twoFactorUsers, tokens, challenges, testPasswordHasher{}, testTokenIssuer{}, &testTokenGenerator{}, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+
+		res, err := uc.Login(ctx, authdto.LoginCommand{Email: "erin@example.com", Password: "Password1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.TokenResponse != nil || !res.TwoFactorRequired || res.TwoFactorExpiresIn != int64(testTwoFactorOptions.ChallengeTTL.Seconds()) {
+			t.Errorf("Login() = %+v, want two-factor challenge without tokens", res)
+		}
+		if len(tokens.tokens) != 0 {
+			t.Errorf("refresh tokens = %v, want none before the second step", tokens.tokens)
+		}
+		c, ok := challenges.challenges["hash-"+res.TwoFactorToken]
+		if !ok || c.UserID != "user-5" {
+			t.Fatalf("challenge %q was not stored by hash", res.TwoFactorToken)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// LogoutUsecase は「ログアウトしてセッションを終了する」というユースケースを表します。
+//
+// 提示されたリフレッシュトークンが属するファミリーをまとめて失効させ、
+// ローテーション済みの後継トークンも含めて以後の再発行をできなくします。
+// 未知のトークンや失効済みのトークンでもエラーにはせず、何度呼び出しても同じ結果になるようにしています。
+type LogoutUsecase struct {
+	refreshTokenRepository repository.RefreshTokenRepository
+	tokenGenerator         port.SecureTokenGenerator
+	now                    func() time.Time
+}
+
+// NewLogoutUsecase は LogoutUsecase のコンストラクタです。
+func NewLogoutUsecase(refreshTokenRepository repository.RefreshTokenRepository, tokenGenerator port.SecureTokenGenerator) *LogoutUsecase {
+	return &LogoutUsecase{
+		refreshTokenRepository: refreshTokenRepository,
+		tokenGenerator:         tokenGenerator,
+		now:                    time.Now,
+	}
+}
+
+// Logout はログアウトユースケースのエントリポイントです。
+func (uc *LogoutUsecase) Logout(ctx context.Context, cmd authdto.LogoutCommand) (err error) {
+
+	ctx, span := tracing.Start(ctx, "LogoutUsecase.Logout")
+	defer func() { tracing.End(span, err) }()
+
+	if cmd.RefreshToken == "" {
+		return nil
+	}
+
+	// 保存済みトークンの取得
+	current, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenGenerator.Hash(cmd.RefreshToken))
+	if err != nil {
+		if errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			return nil
+		}
+		return fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	// ファミリー単位で失効
+	if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID, uc.now()); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+
+	return nil
+}
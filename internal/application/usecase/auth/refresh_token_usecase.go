@@ -0,0 +1,139 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RefreshTokenUsecase は「リフレッシュトークンを使ってトークンを再発行する」というユースケースを表します。
+//
+// リフレッシュトークンは一度使うと失効させ、同じファミリーの新しいトークンに置き換えます（ローテーション）。
+// すでに失効したトークンが再度提示された場合は漏えいとみなし、ファミリー全体を失効させます。
+//
+// 旧トークンの失効は「未失効であること」を条件にした更新で行い、新しいトークンの組の発行と同じトランザクションで実行します。
+// 同じトークンで同時に再発行された場合は一方の失効のみが成功し、もう一方は再利用として扱われます。
+type RefreshTokenUsecase struct {
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	tokenGenerator         port.SecureTokenGenerator
+	tokens                 *tokenPairIssuer
+	tx                     port.TransactionManager
+	logger                 port.Logger
+}
+
+// NewRefreshTokenUsecase は RefreshTokenUsecase のコンストラクタです。
+func NewRefreshTokenUsecase(
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	issuer port.TokenIssuer,
+	tokenGenerator port.SecureTokenGenerator,
+	tx port.TransactionManager,
+	logger port.Logger,
+) *RefreshTokenUsecase {
+	return &RefreshTokenUsecase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenGenerator:         tokenGenerator,
+		tx:                     tx,
+		logger:                 logger,
+		tokens: &tokenPairIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			issuer:                 issuer,
+			tokenGenerator:         tokenGenerator,
+			now:                    time.Now,
+		},
+	}
+}
+
+// Refresh はトークン再発行ユースケースのエントリポイントです。
+//
+//  1. 提示されたトークンのハッシュ値で保存済みトークンを取得
+//  2. 失効済みなら再利用とみなしてファミリーを失効、期限切れなら拒否
+//  3. ユーザーがまだ有効であることを確認
+//  4. 旧トークンを後継付きで失効し、同じファミリーで新しいトークンの組を発行（トランザクション内）
+//     失効済みで更新できなかった場合は、同時に再発行されたとみなしてファミリーを失効
+func (uc *RefreshTokenUsecase) Refresh(ctx context.Context, cmd authdto.RefreshTokenCommand) (_ *authdto.TokenResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "RefreshTokenUsecase.Refresh")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.RefreshToken == "" {
+		return nil, value_obj.UserRefreshTokenInvalidError
+	}
+
+	// 保存済みトークンの取得
+	current, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenGenerator.Hash(cmd.RefreshToken))
+	if err != nil {
+		if errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	now := uc.tokens.now()
+
+	// 再利用検知
+	if current.IsRevoked() {
+		return nil, uc.revokeFamily(ctx, current.UserID, current.FamilyID, now)
+	}
+
+	// 有効期限チェック
+	if current.IsExpired(now) {
+		return nil, value_obj.UserRefreshTokenInvalidError
+	}
+
+	// ユーザーの有効性チェック（削除済みユーザーには再発行しない）
+	u, err := uc.userRepository.FindByID(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, value_obj.UserRefreshTokenInvalidError
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// 後継トークンの生成（旧トークンの失効時に後継として記録するため、先に生成する）
+	next, nextHash, err := uc.tokenGenerator.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	// 旧トークンの失効と新しいトークンの組の発行
+	var res *authdto.TokenResponse
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.refreshTokenRepository.RevokeRefreshToken(ctx, current.TokenHash, nextHash, now); err != nil {
+			return err
+		}
+
+		var err error
+		res, err = uc.tokens.issueWith(ctx, u, current.FamilyID, next, nextHash)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			return nil, uc.revokeFamily(ctx, current.UserID, current.FamilyID, now)
+		}
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return res, nil
+}
+
+// revokeFamily は失効済みのトークンが再利用されたとみなし、ファミリー全体を失効させます。
+// 失効に成功した場合は UserRefreshTokenInvalidError を返します。
+func (uc *RefreshTokenUsecase) revokeFamily(ctx context.Context, userID, familyID string, now time.Time) error {
+
+	uc.logger.Warn(ctx, "refresh token reuse detected; revoking token family", "target_user_id", userID)
+	if err := uc.refreshTokenRepository.RevokeFamily(ctx, familyID, now); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+
+	return value_obj.UserRefreshTokenInvalidError
+}
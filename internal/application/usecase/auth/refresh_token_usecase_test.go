@@ -0,0 +1,227 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestRefreshTokenUsecase_Refresh はトークン再発行のローテーションと再利用検知を検証します。
+//
+// ログインで得たリフレッシュトークンを使って再発行し、
+//   - 旧トークンが後継付きで失効すること
+//   - 失効済みトークンを再提示するとファミリー全体が失効すること
+//   - 同時に再発行され、取得後に失効済みになったトークンも再利用として扱われること
+//   - 期限切れ・未知のトークンが拒否されること
+//
+// を確認します。
+func TestRefreshTokenUsecase_Refresh(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	alice := &entity.User{ID: "user-1", Email: "alice@example.com", Password: "hashed-Password1", Role: "member"}
+	users := &testUserRepository{
+		findByEmailFn: func(context.Context, string) (*entity.User, error) { return alice, nil },
+		findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+			if id == alice.ID {
+				return alice, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+
+	// login はテスト用にログインを行い、発行されたトークンの組を返します。
+	login := func(t *testing.T, tokens *testRefreshTokenRepository, gen *testTokenGenerator) *authdto.TokenResponse {
+		t.Helper()
+		res, err := NewLoginUsecase(users, tokens, newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, gen, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions).
+			Login(ctx, authdto.LoginCommand{Email: alice.Email, Password: "Password1"})
+		if err != nil {
+			t.Fatalf("login failed: %v", err)
+		}
+		return res.TokenResponse
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("RefreshTokenUsecase ローテーションケース開始")
+
+		tokens := newTestRefreshTokenRepository()
+		gen := &testTokenGenerator{}
+		first := login(t, tokens, gen)
+
+		uc := NewRefreshTokenUsecase(users, tokens, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+		second, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if second.RefreshToken == first.RefreshToken {
+			t.Fatal("expected a new refresh token")
+		}
+
+		old := tokens.tokens[gen.Hash(first.RefreshToken)]
+		if !old.IsRevoked() {
+			t.Error("expected old token to be revoked")
+		}
+		if old.ReplacedBy != gen.Hash(second.RefreshToken) {
+			t.Errorf("ReplacedBy = %q, want %q", old.ReplacedBy, gen.Hash(second.RefreshToken))
+		}
+		next := tokens.tokens[gen.Hash(second.RefreshToken)]
+		if next.FamilyID != old.FamilyID {
+			t.Errorf("FamilyID = %q, want %q", next.FamilyID, old.FamilyID)
+		}
+	})
+
+	t.Run("reuse revokes family", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("RefreshTokenUsecase 再利用検知ケース開始")
+
+		tokens := newTestRefreshTokenRepository()
+		gen := &testTokenGenerator{}
+		first := login(t, tokens, gen)
+
+		uc := NewRefreshTokenUsecase(users, tokens, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+		second, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// 失効済みトークンの再提示
+		if _, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+		}
+
+		// 正規の後継トークンも使えなくなっている
+		if _, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: second.RefreshToken}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+		}
+	})
+
+	t.Run("concurrent rotation revokes family", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("RefreshTokenUsecase 同時再発行ケース開始")
+
+		tokens := newTestRefreshTokenRepository()
+		gen := &testTokenGenerator{}
+		first := login(t, tokens, gen)
+
+		// 取得時点では未失効だったトークンを、もう一方の再発行が先に失効させた状態を再現する
+		stale := &staleRefreshTokenRepository{testRefreshTokenRepository: tokens}
+		uc := NewRefreshTokenUsecase(users, stale, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+		winner := NewRefreshTokenUsecase(users, tokens, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+		second, err := winner.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+		}
+		if len(tokens.tokens) != 2 {
+			t.Errorf("stored tokens = %d, want 2 (no token issued for the losing refresh)", len(tokens.tokens))
+		}
+		if !tokens.tokens[gen.Hash(second.RefreshToken)].IsRevoked() {
+			t.Error("expected the family to be revoked")
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("RefreshTokenUsecase 期限切れケース開始")
+
+		tokens := newTestRefreshTokenRepository()
+		gen := &testTokenGenerator{}
+		first := login(t, tokens, gen)
+
+		uc := NewRefreshTokenUsecase(users, tokens, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+		uc.tokens.now = func() time.Time { return time.Now().Add(RefreshTokenTTL + time.Minute) }
+
+		if _, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: first.RefreshToken}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("RefreshTokenUsecase 未知トークンケース開始")
+
+		uc := NewRefreshTokenUsecase(users, newTestRefreshTokenRepository(), testTokenIssuer{}, &testTokenGenerator{}, testtx.NewFake(), testlogger.NewPortLogger(t))
+
+		if _, err := uc.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: "unknown"}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+			t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+		}
+	})
+}
+
+// TestLogoutUsecase_Logout はログアウト後に同じセッションのトークンで再発行できないことを検証します。
+func TestLogoutUsecase_Logout(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	alice := &entity.User{ID: "user-1", Email: "alice@example.com", Password: "hashed-Password1"}
+	users := &testUserRepository{
+		findByEmailFn: func(context.Context, string) (*entity.User, error) { return alice, nil },
+		findByIDFn:    func(context.Context, string) (*entity.User, error) { return alice, nil },
+	}
+	tokens := newTestRefreshTokenRepository()
+	gen := &testTokenGenerator{}
+
+	res, err := NewLoginUsecase(users, tokens, newTestTwoFactorChallengeRepository(), testPasswordHasher{}, testTokenIssuer{}, gen, testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions).
+		Login(ctx, authdto.LoginCommand{Email: alice.Email, Password: "Password1"})
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	uc := NewLogoutUsecase(tokens, gen)
+	if err := uc.Logout(ctx, authdto.LogoutCommand{RefreshToken: res.RefreshToken}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 2 回目のログアウトもエラーにならない
+	if err := uc.Logout(ctx, authdto.LogoutCommand{RefreshToken: res.RefreshToken}); err != nil {
+		t.Fatalf("unexpected error on second logout: %v", err)
+	}
+
+	refresh := NewRefreshTokenUsecase(users, tokens, testTokenIssuer{}, gen, testtx.NewFake(), testlogger.NewPortLogger(t))
+	if _, err := refresh.Refresh(ctx, authdto.RefreshTokenCommand{RefreshToken: res.RefreshToken}); !errors.Is(err, value_obj.UserRefreshTokenInvalidError) {
+		t.Fatalf("expected %v, got %v", value_obj.UserRefreshTokenInvalidError, err)
+	}
+}
+
+// staleRefreshTokenRepository は、失効済みのトークンも未失効として返すテストリポジトリです。
+// 同じトークンで同時に再発行され、取得後に別のリクエストが失効させた状態を再現します。
+type staleRefreshTokenRepository struct {
+	*testRefreshTokenRepository
+}
+
+func (m *staleRefreshTokenRepository) FindByTokenHash(ctx context.Context, hash string) (*entity.RefreshToken, error) {
+	t, err := m.testRefreshTokenRepository.FindByTokenHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	t.RevokedAt = nil
+	return t, nil
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ResendVerificationUsecase は「ログイン中のユーザーに確認メールを再送する」というユースケースを表します。
+//
+// 確認メールの大量送信を防ぐため、直前の送信から ResendInterval が経過していない場合と、
+// 直近 1 時間の送信回数が ResendLimit に達している場合は UserEmailVerificationThrottledError で拒否します。
+// 送信回数には登録時・メールアドレス変更時の送信も含めます。
+type ResendVerificationUsecase struct {
+	userRepository                   repository.UserRepository
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository
+	verification                     port.EmailVerificationSender
+	options                          EmailVerificationOptions
+	now                              func() time.Time
+}
+
+// NewResendVerificationUsecase は ResendVerificationUsecase のコンストラクタです。
+func NewResendVerificationUsecase(
+	userRepository repository.UserRepository,
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository,
+	verification port.EmailVerificationSender,
+	options EmailVerificationOptions,
+) *ResendVerificationUsecase {
+	return &ResendVerificationUsecase{
+		userRepository:                   userRepository,
+		emailVerificationTokenRepository: emailVerificationTokenRepository,
+		verification:                     verification,
+		options:                          options,
+		now:                              time.Now,
+	}
+}
+
+// ResendVerification は確認メール再送ユースケースのエントリポイントです。
+//
+//  1. 操作者（ログイン中のユーザー）を取得し、確認済みなら拒否
+//  2. 直近に発行したトークンから再送の間隔・回数を確認
+//  3. 確認用トークンの発行と確認メールの送信（EmailVerificationSender）
+func (uc *ResendVerificationUsecase) ResendVerification(ctx context.Context) (err error) {
+
+	ctx, span := tracing.Start(ctx, "ResendVerificationUsecase.ResendVerification")
+	defer func() { tracing.End(span, err) }()
+
+	// 操作者の取得
+	u, err := currentUser(ctx, uc.userRepository)
+	if err != nil {
+		return err
+	}
+	if u.IsEmailVerified() {
+		return value_obj.UserEmailAlreadyVerifiedError
+	}
+
+	// 再送の間隔・回数チェック
+	recent, err := uc.emailVerificationTokenRepository.FindRecentByUserID(ctx, u.ID, uc.options.ResendLimit)
+	if err != nil {
+		return fmt.Errorf("failed to find email verification tokens: %w", err)
+	}
+	sentAt := make([]time.Time, 0, len(recent))
+	for _, t := range recent {
+		sentAt = append(sentAt, t.CreatedAt)
+	}
+	if isSendThrottled(uc.now(), sentAt, uc.options.ResendInterval, uc.options.ResendLimit) {
+		return value_obj.UserEmailVerificationThrottledError
+	}
+
+	// 確認メールの送信
+	if err := uc.verification.SendVerification(ctx, u.ID, u.Name, u.Email); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testverification "app/internal/test/verification"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestResendVerificationUsecase_ResendVerification は確認メール再送ユースケースの振る舞いを検証します。
+// 確認済みのユーザーと、再送の間隔・直近 1 時間の回数の上限に達したユーザーへの再送を拒否することを確認します。
+func TestResendVerificationUsecase_ResendVerification(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	verifiedAt := now.Add(-time.Hour)
+	users := &testUserRepository{
+		findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+			switch id {
+			case "user-1":
+				return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Role: "member"}, nil
+			case "user-2":
+				return &entity.User{ID: "user-2", Name: "Bob", Email: "bob@example.com", Role: "member", EmailVerifiedAt: &verifiedAt}, nil
+			}
+			return nil, value_obj.UserNotFoundError
+		},
+	}
+	asAlice := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-1", Role: value_obj.Guest})
+	asBob := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-2", Role: value_obj.Member})
+
+	// sentAt は alice に確認メールを送った日時（現在からの経過時間）の一覧からトークンを作成します。
+	sentAt := func(ago ...time.Duration) *testEmailVerificationTokenRepository {
+		tokens := newTestEmailVerificationTokenRepository()
+		for i, d := range ago {
+			hash := fmt.Sprintf("hash-%d", i)
+			tokens.tokens[hash] = &entity.EmailVerificationToken{TokenHash: hash, UserID: "user-1", Email: "alice@example.com", CreatedAt: now.Add(-d), ExpiresAt: now.Add(24*time.Hour - d)}
+		}
+		return tokens
+	}
+
+	tests := map[string]struct {
+		ctx      context.Context
+		tokens   *testEmailVerificationTokenRepository
+		wantErr  error
+		wantSent bool
+	}{
+		"unauthenticated": {
+			ctx:     context.Background(),
+			tokens:  sentAt(),
+			wantErr: value_obj.UserUnauthenticatedError,
+		},
+		"already verified": {
+			ctx:     asBob,
+			tokens:  sentAt(),
+			wantErr: value_obj.UserEmailAlreadyVerifiedError,
+		},
+		"within resend interval": {
+			ctx:     asAlice,
+			tokens:  sentAt(30 * time.Second),
+			wantErr: value_obj.UserEmailVerificationThrottledError,
+		},
+		"hourly limit reached": {
+			ctx:     asAlice,
+			tokens:  sentAt(2*time.Minute, 20*time.Minute, 59*time.Minute),
+			wantErr: value_obj.UserEmailVerificationThrottledError,
+		},
+		"limit window passed": {
+			ctx:      asAlice,
+			tokens:   sentAt(2*time.Minute, 20*time.Minute, 61*time.Minute),
+			wantSent: true,
+		},
+		"first resend": {
+			ctx:      asAlice,
+			tokens:   sentAt(5 * time.Minute),
+			wantSent: true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ResendVerificationUsecase ケース開始: %s", name)
+
+			verification := testverification.NewFake()
+			uc := NewResendVerificationUsecase(users, tt.tokens, verification, testEmailVerificationOptions)
+			uc.now = func() time.Time { return now }
+
+			err := uc.ResendVerification(tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			requests := verification.Requests()
+			if tt.wantSent != (len(requests) == 1) {
+				t.Fatalf("requests = %v, want sent = %v", requests, tt.wantSent)
+			}
+			if tt.wantSent && requests[0].Email != "alice@example.com" {
+				t.Errorf("Email = %q, want %q", requests[0].Email, "alice@example.com")
+			}
+		})
+	}
+}
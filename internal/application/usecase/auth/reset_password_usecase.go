@@ -0,0 +1,138 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ResetPasswordUsecase は「再設定用のトークンを使って新しいパスワードを設定する」というユースケースを表します。
+//
+// トークンは一度だけ使用でき、有効期限を過ぎたもの・使用済みのものは UserPasswordResetTokenInvalidError で拒否します。
+// 新しいパスワードはユーザー作成時と同じパスワードポリシーで検証し、PasswordHasher でハッシュ化して保存します。
+// 再設定後は発行済みのリフレッシュトークンをすべて失効させ、ほかの端末のログイン状態を解除します。
+type ResetPasswordUsecase struct {
+	userRepository               repository.UserRepository
+	passwordResetTokenRepository repository.PasswordResetTokenRepository
+	refreshTokenRepository       repository.RefreshTokenRepository
+	hasher                       port.PasswordHasher
+	tokenGenerator               port.SecureTokenGenerator
+	tx                           port.TransactionManager
+	policy                       *services.PasswordPolicy
+	logger                       port.Logger
+	now                          func() time.Time
+}
+
+// NewResetPasswordUsecase は ResetPasswordUsecase のコンストラクタです。
+func NewResetPasswordUsecase(
+	userRepository repository.UserRepository,
+	passwordResetTokenRepository repository.PasswordResetTokenRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	hasher port.PasswordHasher,
+	tokenGenerator port.SecureTokenGenerator,
+	tx port.TransactionManager,
+	policy *services.PasswordPolicy,
+	logger port.Logger,
+) *ResetPasswordUsecase {
+	return &ResetPasswordUsecase{
+		userRepository:               userRepository,
+		passwordResetTokenRepository: passwordResetTokenRepository,
+		refreshTokenRepository:       refreshTokenRepository,
+		hasher:                       hasher,
+		tokenGenerator:               tokenGenerator,
+		tx:                           tx,
+		policy:                       policy,
+		logger:                       logger,
+		now:                          time.Now,
+	}
+}
+
+// ResetPassword はパスワード再設定ユースケースのエントリポイントです。
+//
+//  1. 提示されたトークンのハッシュ値で保存済みトークンを取得し、使用済み・期限切れなら拒否
+//  2. ユーザーがまだ有効であることを確認
+//  3. 新しいパスワードのドメインバリデーション（services.ResetPasswordValidation）
+//  4. パスワードのハッシュ化（PasswordHasher.Hash）
+//  5. トークンの使用・パスワードの更新・未使用のトークンの無効化・リフレッシュトークンの失効（トランザクション内）
+//
+// パスワードがポリシーに違反している場合はトークンを使用済みにしないため、同じリンクから入力し直せます。
+func (uc *ResetPasswordUsecase) ResetPassword(ctx context.Context, cmd authdto.ResetPasswordCommand) (err error) {
+
+	ctx, span := tracing.Start(ctx, "ResetPasswordUsecase.ResetPassword")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.Token == "" {
+		return value_obj.UserPasswordResetTokenInvalidError
+	}
+
+	// 保存済みトークンの取得
+	hash := uc.tokenGenerator.Hash(cmd.Token)
+	t, err := uc.passwordResetTokenRepository.FindByTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+			return err
+		}
+		return fmt.Errorf("failed to find password reset token: %w", err)
+	}
+
+	// 使用済み・有効期限チェック
+	now := uc.now()
+	if t.IsUsed() || t.IsExpired(now) {
+		return value_obj.UserPasswordResetTokenInvalidError
+	}
+
+	// ユーザーの有効性チェック（削除済みユーザーのパスワードは再設定しない）
+	u, err := uc.userRepository.FindByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return value_obj.UserPasswordResetTokenInvalidError
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// ドメインバリデーション
+	if err := services.ResetPasswordValidation(ctx, uc.policy, u.Name, u.Email, cmd.Password); err != nil {
+		return err
+	}
+
+	// パスワードのハッシュ化
+	hashed, err := uc.hasher.Hash(ctx, cmd.Password)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// トークンの使用とパスワードの更新
+	// 同じトークンで同時に再設定された場合は、トークンを先に使用した一方のみ成功する
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.passwordResetTokenRepository.UsePasswordResetToken(ctx, hash, now); err != nil {
+			if errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+				return err
+			}
+			return fmt.Errorf("failed to use password reset token: %w", err)
+		}
+		if err := uc.userRepository.UpdatePassword(ctx, u.ID, hashed); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		if err := uc.passwordResetTokenRepository.InvalidateByUserID(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+		}
+		if err := uc.refreshTokenRepository.RevokeByUserID(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	uc.logger.Info(ctx, "password reset", "target_user_id", u.ID)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestResetPasswordUsecase_ResetPassword はパスワード再設定ユースケースの振る舞いを検証します。
+// 無効・使用済み・期限切れのトークンを拒否し、ポリシー違反ではトークンを使用済みにしないこと、
+// 成功時はパスワードを更新してトークンを使用済みにし、リフレッシュトークンを失効させることを確認します。
+func TestResetPasswordUsecase_ResetPassword(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	alice := &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed-Password1", Role: "member"}
+
+	// newUsecase はトークンを保存済みのユースケースを生成します。
+	newUsecase := func(t *testing.T) (*ResetPasswordUsecase, *testUserRepository, *testPasswordResetTokenRepository, *testRefreshTokenRepository) {
+		users := &testUserRepository{
+			findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+				if id == alice.ID {
+					return alice, nil
+				}
+				return nil, value_obj.UserNotFoundError
+			},
+		}
+		resetTokens := newTestPasswordResetTokenRepository()
+		used := now.Add(-time.Minute)
+		resetTokens.tokens["hash-valid"] = &entity.PasswordResetToken{TokenHash: "hash-valid", UserID: alice.ID, ExpiresAt: now.Add(time.Hour)}
+		resetTokens.tokens["hash-other"] = &entity.PasswordResetToken{TokenHash: "hash-other", UserID: alice.ID, ExpiresAt: now.Add(time.Hour)}
+		resetTokens.tokens["hash-used"] = &entity.PasswordResetToken{TokenHash: "hash-used", UserID: alice.ID, ExpiresAt: now.Add(time.Hour), UsedAt: &used}
+		resetTokens.tokens["hash-expired"] = &entity.PasswordResetToken{TokenHash: "hash-expired", UserID: alice.ID, ExpiresAt: now}
+		resetTokens.tokens["hash-deleted"] = &entity.PasswordResetToken{TokenHash: "hash-deleted", UserID: "user-deleted", ExpiresAt: now.Add(time.Hour)}
+		refreshTokens := newTestRefreshTokenRepository()
+		refreshTokens.tokens["hash-refresh"] = &entity.RefreshToken{TokenHash: "hash-refresh", UserID: alice.ID, FamilyID: "hash-refresh", ExpiresAt: now.Add(time.Hour)}
+
+		uc := NewResetPasswordUsecase(users, resetTokens, refreshTokens, testPasswordHasher{}, &testTokenGenerator{}, testtx.NewFake(), services.DefaultPasswordPolicy(), testlogger.NewPortLogger(t))
+		uc.now = func() time.Time { return now }
+		return uc, users, resetTokens, refreshTokens
+	}
+
+	tests := map[string]struct {
+		cmd     authdto.ResetPasswordCommand
+		wantErr error
+	}{
+		"token empty": {
+			cmd:     authdto.ResetPasswordCommand{Password: "NewPassw0rd"},
+			wantErr: value_obj.UserPasswordResetTokenInvalidError,
+		},
+		"unknown token": {
+			cmd:     authdto.ResetPasswordCommand{Token: "unknown", Password: "NewPassw0rd"},
+			wantErr: value_obj.UserPasswordResetTokenInvalidError,
+		},
+		"used token": {
+			cmd:     authdto.ResetPasswordCommand{Token: "used", Password: "NewPassw0rd"},
+			wantErr: value_obj.UserPasswordResetTokenInvalidError,
+		},
+		"expired token": {
+			cmd:     authdto.ResetPasswordCommand{Token: "expired", Password: "NewPassw0rd"},
+			wantErr: value_obj.UserPasswordResetTokenInvalidError,
+		},
+		"deleted user": {
+			cmd:     authdto.ResetPasswordCommand{Token: "deleted", Password: "NewPassw0rd"},
+			wantErr: value_obj.UserPasswordResetTokenInvalidError,
+		},
+		"password required": {
+			cmd:     authdto.ResetPasswordCommand{Token: "valid"},
+			wantErr: value_obj.UserRequiredError,
+		},
+		"password too short": {
+			cmd:     authdto.ResetPasswordCommand{Token: "valid", Password: "Pass1"},
+			wantErr: value_obj.UserPasswordTooShortError,
+		},
+		"password similar to profile": {
+			cmd:     authdto.ResetPasswordCommand{Token: "valid", Password: "Alice2024x"},
+			wantErr: value_obj.UserPasswordSimilarToProfileError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ResetPasswordUsecase エラーケース開始: %s", name)
+
+			uc, users, resetTokens, _ := newUsecase(t)
+
+			err := uc.ResetPassword(ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if len(users.passwords) != 0 {
+				t.Errorf("password must not be updated, got %v", users.passwords)
+			}
+			if resetTokens.tokens["hash-valid"].IsUsed() {
+				t.Error("valid token must not be used on failure")
+			}
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("ResetPasswordUsecase 正常系ケース開始")
+
+		uc, users, resetTokens, refreshTokens := newUsecase(t)
+
+		if err := uc.ResetPassword(ctx, authdto.ResetPasswordCommand{Token: "valid", Password: "NewPassw0rd"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := users.passwords[alice.ID]; got != "hashed-NewPassw0rd" {
+			t.Errorf("stored password = %q, want %q", got, "hashed-NewPassw0rd")
+		}
+		if !resetTokens.tokens["hash-valid"].IsUsed() || !resetTokens.tokens["hash-other"].IsUsed() {
+			t.Error("all reset tokens of the user must be used or invalidated")
+		}
+		if !refreshTokens.tokens["hash-refresh"].IsRevoked() {
+			t.Error("refresh tokens of the user must be revoked")
+		}
+
+		// 同じトークンは二度と使えない
+		err := uc.ResetPassword(ctx, authdto.ResetPasswordCommand{Token: "valid", Password: "An0therPass"})
+		if !errors.Is(err, value_obj.UserPasswordResetTokenInvalidError) {
+			t.Fatalf("expected error %v on reuse, got %v", value_obj.UserPasswordResetTokenInvalidError, err)
+		}
+	})
+}
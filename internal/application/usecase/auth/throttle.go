@@ -0,0 +1,15 @@
+package auth
+
+import "time"
+
+// sendWindow はメールの送信回数を数える期間です。
+const sendWindow = time.Hour
+
+// isSendThrottled は直近の送信日時（新しい順に最大 limit 件）から、メールの送信を制限するかを返します。
+// 直前の送信から interval が経過していない場合と、直近 1 時間の送信回数が limit に達している場合に制限します。
+func isSendThrottled(now time.Time, sentAt []time.Time, interval time.Duration, limit int) bool {
+	if len(sentAt) > 0 && now.Sub(sentAt[0]) < interval {
+		return true
+	}
+	return len(sentAt) >= limit && now.Sub(sentAt[len(sentAt)-1]) < sendWindow
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RefreshTokenTTL はリフレッシュトークンの有効期間です。
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+// tokenPairIssuer はアクセストークンとリフレッシュトークンの組を発行する共通処理です。
+//
+// ログインとトークン再発行の双方で「アクセストークンを署名し、リフレッシュトークンを生成して
+// ハッシュ値のみを保存する」という同じ手順が必要になるため、ここに集約しています。
+type tokenPairIssuer struct {
+	refreshTokenRepository repository.RefreshTokenRepository
+	issuer                 port.TokenIssuer
+	tokenGenerator         port.SecureTokenGenerator
+	now                    func() time.Time
+}
+
+// issue はユーザーに対してトークンの組を発行します。
+// familyID が空の場合は新しいセッション（ファミリー）として扱います。
+// 返り値として、保存したリフレッシュトークンのハッシュ値も返却し、ローテーション時の後継記録に利用します。
+func (i *tokenPairIssuer) issue(ctx context.Context, u *entity.User, familyID string) (*authdto.TokenResponse, string, error) {
+
+	// リフレッシュトークンの生成
+	refreshToken, refreshHash, err := i.tokenGenerator.Generate()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	res, err := i.issueWith(ctx, u, familyID, refreshToken, refreshHash)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res, refreshHash, nil
+}
+
+// issueWith は生成済みのリフレッシュトークンを使ってトークンの組を発行します。
+// ローテーションでは旧トークンの失効時に後継のハッシュ値を記録するため、先に生成したトークンを渡します。
+func (i *tokenPairIssuer) issueWith(ctx context.Context, u *entity.User, familyID string, refreshToken string, refreshHash string) (*authdto.TokenResponse, error) {
+
+	// アクセストークンの発行
+	// メールアドレスを確認していないユーザーはゲストの権限で発行する
+	accessToken, expiresAt, err := i.issuer.IssueAccessToken(u.ID, string(u.EffectiveRole()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token: %w", err)
+	}
+
+	// Entity生成
+	now := i.now()
+	t, err := entity.NewRefreshToken(u.ID, refreshHash, familyID, now.Add(RefreshTokenTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	// リフレッシュトークンの保存
+	if err := i.refreshTokenRepository.CreateRefreshToken(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return &authdto.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(expiresAt.Sub(now).Seconds()),
+	}, nil
+}
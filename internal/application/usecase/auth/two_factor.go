@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TwoFactorOptions は 2 段階認証の設定です。
+type TwoFactorOptions struct {
+	// ログインの 2 段階目（コードの入力）の有効期間
+	ChallengeTTL time.Duration
+
+	// 1 回のログインで誤ったコードを入力できる回数
+	MaxAttempts int
+
+	// 発行するリカバリーコードの数
+	RecoveryCodes int
+}
+
+// currentUser は操作者（ログイン中のユーザー）を取得します。
+// 削除済みなどで見つからない場合は UserUnauthenticatedError を返します。
+func currentUser(ctx context.Context, userRepository repository.UserRepository) (*entity.User, error) {
+
+	p, ok := policy.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, value_obj.UserUnauthenticatedError
+	}
+	u, err := userRepository.FindByID(ctx, p.UserID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, value_obj.UserUnauthenticatedError
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return u, nil
+}
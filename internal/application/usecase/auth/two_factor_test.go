@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"app/internal/application/port"
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// testTwoFactorOptions は 2 段階認証のユースケースのテストで使用する設定です。
+var testTwoFactorOptions = TwoFactorOptions{ChallengeTTL: 5 * time.Minute, MaxAttempts: 3, RecoveryCodes: 2}
+
+// testLoginLockoutOptions はログインのロックアウトを含むユースケースのテストで使用する設定です。
+var testLoginLockoutOptions = LoginLockoutOptions{MaxFailures: 5, Duration: 15 * time.Minute}
+
+// testTOTPSecret は testTOTP が生成するシークレットです。
+const testTOTPSecret = "TESTSECRET"
+
+// testTOTP は 6 桁の数値をそのまま時間ステップとして受け付けるテスト用の実装です。
+// 例えば "000100" は時間ステップ 100 のコードとして扱い、シークレットが testTOTPSecret 以外の場合はすべて拒否します。
+type testTOTP struct{}
+
+func (testTOTP) GenerateSecret() (string, error) {
+	return testTOTPSecret, nil
+}
+
+func (testTOTP) ProvisioningURI(secret string, accountName string) string {
+	return "otpauth://totp/test:" + accountName + "?secret=" + secret
+}
+
+func (testTOTP) Validate(secret string, code string, _ time.Time) (int64, bool) {
+	step, err := strconv.ParseInt(code, 10, 64)
+	if secret != testTOTPSecret || len(code) != 6 || err != nil {
+		return 0, false
+	}
+	return step, true
+}
+
+// testSecretCipher は関連データと平文を連結するだけのテスト用の暗号化です。
+// 関連データが暗号化時と異なる場合は復号に失敗します。
+type testSecretCipher struct{}
+
+func (testSecretCipher) Encrypt(plaintext string, associatedData string) (string, error) {
+	return "enc:" + associatedData + ":" + plaintext, nil
+}
+
+func (testSecretCipher) Decrypt(ciphertext string, associatedData string) (string, error) {
+	plaintext, ok := strings.CutPrefix(ciphertext, "enc:"+associatedData+":")
+	if !ok {
+		return "", errors.New("associated data mismatch")
+	}
+	return plaintext, nil
+}
+
+// testRecoveryCodeGenerator は "code-1", "code-2", ... を生成するテスト用の実装です。
+// ハッシュ値は小文字にした値に "hash-" を付けたものです。
+type testRecoveryCodeGenerator struct{}
+
+func (testRecoveryCodeGenerator) Generate(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("code-%d", i+1)
+	}
+	return codes, nil
+}
+
+func (testRecoveryCodeGenerator) Hash(code string) string {
+	return "hash-" + strings.ToLower(code)
+}
+
+// testTOTPCredentialRepository はメモリ上で TOTP シークレットを保持するテストリポジトリです。
+// 確認・時間ステップの使用の結果を、保存済みの登録の状態から確認できるようにしています。
+type testTOTPCredentialRepository struct {
+	mu          sync.Mutex
+	credentials map[string]*entity.TOTPCredential
+}
+
+func newTestTOTPCredentialRepository() *testTOTPCredentialRepository {
+	return &testTOTPCredentialRepository{credentials: map[string]*entity.TOTPCredential{}}
+}
+
+func (m *testTOTPCredentialRepository) SaveTOTPCredential(_ context.Context, c *entity.TOTPCredential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.credentials[c.UserID] = c
+	return nil
+}
+
+func (m *testTOTPCredentialRepository) FindByUserID(_ context.Context, userID string) (*entity.TOTPCredential, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.credentials[userID]
+	if !ok {
+		return nil, value_obj.UserTwoFactorNotEnrolledError
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (m *testTOTPCredentialRepository) ConfirmTOTPCredential(_ context.Context, userID string, step int64, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.credentials[userID]
+	if !ok || c.ConfirmedAt != nil {
+		return value_obj.UserTwoFactorNotEnrolledError
+	}
+	c.ConfirmedAt = &at
+	c.LastUsedStep = step
+	return nil
+}
+
+func (m *testTOTPCredentialRepository) UseTOTPStep(_ context.Context, userID string, step int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.credentials[userID]
+	if !ok || c.ConfirmedAt == nil || step <= c.LastUsedStep {
+		return value_obj.UserTwoFactorCodeInvalidError
+	}
+	c.LastUsedStep = step
+	return nil
+}
+
+// testRecoveryCodeRepository はメモリ上でリカバリーコードを保持するテストリポジトリです。
+type testRecoveryCodeRepository struct {
+	mu    sync.Mutex
+	codes map[string]*entity.RecoveryCode
+}
+
+func newTestRecoveryCodeRepository() *testRecoveryCodeRepository {
+	return &testRecoveryCodeRepository{codes: map[string]*entity.RecoveryCode{}}
+}
+
+func (m *testRecoveryCodeRepository) ReplaceRecoveryCodes(_ context.Context, userID string, codes []*entity.RecoveryCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for hash, c := range m.codes {
+		if c.UserID == userID {
+			delete(m.codes, hash)
+		}
+	}
+	for _, c := range codes {
+		m.codes[c.CodeHash] = c
+	}
+	return nil
+}
+
+func (m *testRecoveryCodeRepository) UseRecoveryCode(_ context.Context, userID string, codeHash string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.codes[codeHash]
+	if !ok || c.UserID != userID || c.UsedAt != nil {
+		return value_obj.UserTwoFactorCodeInvalidError
+	}
+	c.UsedAt = &at
+	return nil
+}
+
+// testTwoFactorChallengeRepository はメモリ上でログインの 2 段階目のチャレンジを保持するテストリポジトリです。
+type testTwoFactorChallengeRepository struct {
+	mu         sync.Mutex
+	challenges map[string]*entity.TwoFactorChallenge
+}
+
+func newTestTwoFactorChallengeRepository() *testTwoFactorChallengeRepository {
+	return &testTwoFactorChallengeRepository{challenges: map[string]*entity.TwoFactorChallenge{}}
+}
+
+func (m *testTwoFactorChallengeRepository) CreateTwoFactorChallenge(_ context.Context, c *entity.TwoFactorChallenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.challenges[c.TokenHash] = c
+	return nil
+}
+
+func (m *testTwoFactorChallengeRepository) FindByTokenHash(_ context.Context, hash string) (*entity.TwoFactorChallenge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.challenges[hash]
+	if !ok {
+		return nil, value_obj.UserTwoFactorChallengeInvalidError
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (m *testTwoFactorChallengeRepository) IncrementAttempts(_ context.Context, hash string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.challenges[hash]; ok {
+		c.Attempts++
+	}
+	return nil
+}
+
+func (m *testTwoFactorChallengeRepository) UseTwoFactorChallenge(_ context.Context, hash string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.challenges[hash]
+	if !ok || c.UsedAt != nil {
+		return value_obj.UserTwoFactorChallengeInvalidError
+	}
+	c.UsedAt = &at
+	return nil
+}
+
+var _ port.TOTP = testTOTP{}
+var _ port.SecretCipher = testSecretCipher{}
+var _ port.RecoveryCodeGenerator = testRecoveryCodeGenerator{}
+var _ repo.TOTPCredentialRepository = (*testTOTPCredentialRepository)(nil)
+var _ repo.RecoveryCodeRepository = (*testRecoveryCodeRepository)(nil)
+var _ repo.TwoFactorChallengeRepository = (*testTwoFactorChallengeRepository)(nil)
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VerifyEmailUsecase は「確認メールのリンクからメールアドレスを確認済みにする」というユースケースを表します。
+//
+// トークンは一度だけ使用でき、有効期限を過ぎたもの・使用済みのもの・発行後にメールアドレスが変更されたものは
+// UserEmailVerificationTokenInvalidError で拒否します。
+// 確認が完了するまでユーザーはゲストと同じ権限として扱われ、確認後に発行したアクセストークンから本来の権限になります。
+type VerifyEmailUsecase struct {
+	userRepository                   repository.UserRepository
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository
+	tokenGenerator                   port.SecureTokenGenerator
+	tx                               port.TransactionManager
+	logger                           port.Logger
+	now                              func() time.Time
+}
+
+// NewVerifyEmailUsecase は VerifyEmailUsecase のコンストラクタです。
+func NewVerifyEmailUsecase(
+	userRepository repository.UserRepository,
+	emailVerificationTokenRepository repository.EmailVerificationTokenRepository,
+	tokenGenerator port.SecureTokenGenerator,
+	tx port.TransactionManager,
+	logger port.Logger,
+) *VerifyEmailUsecase {
+	return &VerifyEmailUsecase{
+		userRepository:                   userRepository,
+		emailVerificationTokenRepository: emailVerificationTokenRepository,
+		tokenGenerator:                   tokenGenerator,
+		tx:                               tx,
+		logger:                           logger,
+		now:                              time.Now,
+	}
+}
+
+// VerifyEmail はメールアドレス確認ユースケースのエントリポイントです。
+//
+//  1. 提示されたトークンのハッシュ値で保存済みトークンを取得し、使用済み・期限切れなら拒否
+//  2. ユーザーがまだ有効で、メールアドレスがトークンの発行時から変わっていないことを確認
+//  3. トークンの使用・メールアドレスの確認済みへの更新・未使用のトークンの無効化（トランザクション内）
+func (uc *VerifyEmailUsecase) VerifyEmail(ctx context.Context, query authdto.VerifyEmailQuery) (_ *authdto.VerifyEmailResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "VerifyEmailUsecase.VerifyEmail")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if query.Token == "" {
+		return nil, value_obj.UserEmailVerificationTokenInvalidError
+	}
+
+	// 保存済みトークンの取得
+	hash := uc.tokenGenerator.Hash(query.Token)
+	t, err := uc.emailVerificationTokenRepository.FindByTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find email verification token: %w", err)
+	}
+
+	// 使用済み・有効期限チェック
+	now := uc.now()
+	if t.IsUsed() || t.IsExpired(now) {
+		return nil, value_obj.UserEmailVerificationTokenInvalidError
+	}
+
+	// ユーザーの有効性チェック（削除済みユーザー・メールアドレス変更前のトークンは使用できない）
+	u, err := uc.userRepository.FindByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, value_obj.UserEmailVerificationTokenInvalidError
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if u.Email != t.Email {
+		return nil, value_obj.UserEmailVerificationTokenInvalidError
+	}
+
+	// トークンの使用と確認済みへの更新
+	// 同じトークンで同時に確認された場合は、トークンを先に使用した一方のみ成功する
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.emailVerificationTokenRepository.UseEmailVerificationToken(ctx, hash, now); err != nil {
+			if errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+				return err
+			}
+			return fmt.Errorf("failed to use email verification token: %w", err)
+		}
+		if err := uc.userRepository.MarkEmailVerified(ctx, u.ID, t.Email, now); err != nil {
+			// 取得後にメールアドレスが変更された・削除された場合
+			if errors.Is(err, value_obj.UserNotFoundError) {
+				return value_obj.UserEmailVerificationTokenInvalidError
+			}
+			return fmt.Errorf("failed to mark email verified: %w", err)
+		}
+		if err := uc.emailVerificationTokenRepository.InvalidateByUserID(ctx, u.ID, now); err != nil {
+			return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.logger.Info(ctx, "email verified", "target_user_id", u.ID)
+
+	return &authdto.VerifyEmailResponse{UserID: u.ID, Email: t.Email, EmailVerifiedAt: now}, nil
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestVerifyEmailUsecase_VerifyEmail はメールアドレス確認ユースケースの振る舞いを検証します。
+// 無効・使用済み・期限切れ・メールアドレス変更前のトークンを拒否し、
+// 成功時はメールアドレスを確認済みにしてトークンを使用済みにすることを確認します。
+func TestVerifyEmailUsecase_VerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	alice := &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed-Password1", Role: "member"}
+
+	// newUsecase はトークンを保存済みのユースケースを生成します。
+	newUsecase := func(t *testing.T) (*VerifyEmailUsecase, *testUserRepository, *testEmailVerificationTokenRepository) {
+		users := &testUserRepository{
+			findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+				if id == alice.ID {
+					return alice, nil
+				}
+				return nil, value_obj.UserNotFoundError
+			},
+		}
+		tokens := newTestEmailVerificationTokenRepository()
+		used := now.Add(-time.Minute)
+		tokens.tokens["hash-valid"] = &entity.EmailVerificationToken{TokenHash: "hash-valid", UserID: alice.ID, Email: alice.Email, ExpiresAt: now.Add(time.Hour)}
+		tokens.tokens["hash-used"] = &entity.EmailVerificationToken{TokenHash: "hash-used", UserID: alice.ID, Email: alice.Email, ExpiresAt: now.Add(time.Hour), UsedAt: &used}
+		tokens.tokens["hash-expired"] = &entity.EmailVerificationToken{TokenHash: "hash-expired", UserID: alice.ID, Email: alice.Email, ExpiresAt: now}
+		tokens.tokens["hash-old-email"] = &entity.EmailVerificationToken{TokenHash: "hash-old-email", UserID: alice.ID, Email: "old@example.com", ExpiresAt: now.Add(time.Hour)}
+		tokens.tokens["hash-deleted"] = &entity.EmailVerificationToken{TokenHash: "hash-deleted", UserID: "user-deleted", Email: "deleted@example.com", ExpiresAt: now.Add(time.Hour)}
+
+		uc := NewVerifyEmailUsecase(users, tokens, &testTokenGenerator{}, testtx.NewFake(), testlogger.NewPortLogger(t))
+		uc.now = func() time.Time { return now }
+		return uc, users, tokens
+	}
+
+	tests := map[string]struct {
+		query   authdto.VerifyEmailQuery
+		wantErr error
+	}{
+		"token empty": {
+			query:   authdto.VerifyEmailQuery{},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+		"unknown token": {
+			query:   authdto.VerifyEmailQuery{Token: "unknown"},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+		"used token": {
+			query:   authdto.VerifyEmailQuery{Token: "used"},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+		"expired token": {
+			query:   authdto.VerifyEmailQuery{Token: "expired"},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+		"email changed after issue": {
+			query:   authdto.VerifyEmailQuery{Token: "old-email"},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+		"deleted user": {
+			query:   authdto.VerifyEmailQuery{Token: "deleted"},
+			wantErr: value_obj.UserEmailVerificationTokenInvalidError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("VerifyEmailUsecase エラーケース開始: %s", name)
+
+			uc, users, tokens := newUsecase(t)
+
+			_, err := uc.VerifyEmail(ctx, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if len(users.verified) != 0 {
+				t.Errorf("email must not be verified, got %v", users.verified)
+			}
+			if tokens.tokens["hash-valid"].IsUsed() {
+				t.Error("valid token must not be used on failure")
+			}
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("VerifyEmailUsecase 正常系ケース開始")
+
+		uc, users, tokens := newUsecase(t)
+
+		res, err := uc.VerifyEmail(ctx, authdto.VerifyEmailQuery{Token: "valid"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.UserID != alice.ID || res.Email != alice.Email || !res.EmailVerifiedAt.Equal(now) {
+			t.Errorf("response = %+v, want %s / %s verified at %v", res, alice.ID, alice.Email, now)
+		}
+		if got := users.verified[alice.ID]; got != alice.Email {
+			t.Errorf("verified email = %q, want %q", got, alice.Email)
+		}
+		if !tokens.tokens["hash-valid"].IsUsed() || !tokens.tokens["hash-old-email"].IsUsed() {
+			t.Error("all verification tokens of the user must be used or invalidated")
+		}
+
+		// 同じトークンは二度と使えない
+		_, err = uc.VerifyEmail(ctx, authdto.VerifyEmailQuery{Token: "valid"})
+		if !errors.Is(err, value_obj.UserEmailVerificationTokenInvalidError) {
+			t.Fatalf("expected error %v on reuse, got %v", value_obj.UserEmailVerificationTokenInvalidError, err)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VerifyTwoFactorUsecase は「ログインの 2 段階目として認証アプリのコードまたはリカバリーコードを検証する」というユースケースを表します。
+//
+// ログイン時に発行したチャレンジが未使用・有効期間内で、誤ったコードの入力が MaxAttempts 回に達していない場合のみ受け付けます。
+// 同じコードの再利用を防ぐため、認証アプリのコードは使用済みの時間ステップ以前のものを拒否し、
+// リカバリーコードは使用済みにします。いずれもチャレンジの使用と同じトランザクションで記録します。
+//
+// 誤ったコードはチャレンジごとの入力回数に加えて、ログインのパスワードの誤りと同じユーザーごとの失敗回数として数えます。
+// ロックアウト中のユーザーは、正しいコードでも UserTwoFactorChallengeInvalidError で拒否します。
+type VerifyTwoFactorUsecase struct {
+	userRepository               repository.UserRepository
+	twoFactorChallengeRepository repository.TwoFactorChallengeRepository
+	totpCredentialRepository     repository.TOTPCredentialRepository
+	recoveryCodeRepository       repository.RecoveryCodeRepository
+	totp                         port.TOTP
+	cipher                       port.SecretCipher
+	recoveryCodes                port.RecoveryCodeGenerator
+	tokens                       *tokenPairIssuer
+	lockout                      *loginLockout
+	tx                           port.TransactionManager
+	logger                       port.Logger
+	options                      TwoFactorOptions
+}
+
+// NewVerifyTwoFactorUsecase は VerifyTwoFactorUsecase のコンストラクタです。
+func NewVerifyTwoFactorUsecase(
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	twoFactorChallengeRepository repository.TwoFactorChallengeRepository,
+	totpCredentialRepository repository.TOTPCredentialRepository,
+	recoveryCodeRepository repository.RecoveryCodeRepository,
+	totp port.TOTP,
+	cipher port.SecretCipher,
+	recoveryCodes port.RecoveryCodeGenerator,
+	issuer port.TokenIssuer,
+	tokenGenerator port.SecureTokenGenerator,
+	tx port.TransactionManager,
+	logger port.Logger,
+	options TwoFactorOptions,
+	lockoutOptions LoginLockoutOptions,
+) *VerifyTwoFactorUsecase {
+	return &VerifyTwoFactorUsecase{
+		userRepository:               userRepository,
+		twoFactorChallengeRepository: twoFactorChallengeRepository,
+		totpCredentialRepository:     totpCredentialRepository,
+		recoveryCodeRepository:       recoveryCodeRepository,
+		totp:                         totp,
+		cipher:                       cipher,
+		recoveryCodes:                recoveryCodes,
+		tx:                           tx,
+		logger:                       logger,
+		options:                      options,
+		tokens: &tokenPairIssuer{
+			refreshTokenRepository: refreshTokenRepository,
+			issuer:                 issuer,
+			tokenGenerator:         tokenGenerator,
+			now:                    time.Now,
+		},
+		lockout: &loginLockout{
+			userRepository: userRepository,
+			logger:         logger,
+			options:        lockoutOptions,
+		},
+	}
+}
+
+// VerifyTwoFactor はログインの 2 段階目のユースケースのエントリポイントです。
+//
+//  1. 必須入力チェック（コードとリカバリーコードのいずれか一方）
+//  2. チャレンジの取得と、使用済み・期限切れ・入力回数の確認
+//  3. ユーザーの取得と、ロックアウト中かの確認
+//  4. 認証アプリのコードの場合はシークレットを復号して検証
+//  5. コード（またはリカバリーコード）とチャレンジの使用、トークンの組の発行（トランザクション内）
+//  6. コードが誤っていた場合は入力回数を加算し、ユーザーの失敗を記録
+//  7. 成功した場合はユーザーの失敗回数をリセット
+func (uc *VerifyTwoFactorUsecase) VerifyTwoFactor(ctx context.Context, cmd authdto.VerifyTwoFactorCommand) (_ *authdto.TokenResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "VerifyTwoFactorUsecase.VerifyTwoFactor")
+	defer func() { tracing.End(span, err) }()
+
+	// 必須入力項目のチェック
+	if cmd.TwoFactorToken == "" || (cmd.Code == "") == (cmd.RecoveryCode == "") {
+		return nil, value_obj.UserRequiredError
+	}
+
+	// チャレンジの取得
+	hash := uc.tokens.tokenGenerator.Hash(cmd.TwoFactorToken)
+	c, err := uc.twoFactorChallengeRepository.FindByTokenHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, value_obj.UserTwoFactorChallengeInvalidError) {
+			uc.logger.Warn(ctx, "two-factor verification failed", "reason", "challenge_not_found")
+		}
+		return nil, err
+	}
+	now := uc.tokens.now()
+	switch {
+	case c.IsUsed():
+		uc.logger.Warn(ctx, "two-factor verification failed", "reason", "challenge_used", "target_user_id", c.UserID)
+		return nil, value_obj.UserTwoFactorChallengeInvalidError
+	case c.IsExpired(now):
+		uc.logger.Warn(ctx, "two-factor verification failed", "reason", "challenge_expired", "target_user_id", c.UserID)
+		return nil, value_obj.UserTwoFactorChallengeInvalidError
+	case c.Attempts >= uc.options.MaxAttempts:
+		uc.logger.Warn(ctx, "two-factor verification failed", "reason", "too_many_attempts", "target_user_id", c.UserID)
+		return nil, value_obj.UserTwoFactorChallengeInvalidError
+	}
+
+	// ユーザー取得
+	u, err := uc.userRepository.FindByID(ctx, c.UserID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, value_obj.UserTwoFactorChallengeInvalidError
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if uc.lockout.isLocked(u, now) {
+		uc.logger.Warn(ctx, "two-factor verification failed", "reason", "locked", "target_user_id", u.ID)
+		return nil, value_obj.UserTwoFactorChallengeInvalidError
+	}
+
+	// 認証アプリのコードの検証
+	var step int64
+	if cmd.Code != "" {
+		cred, err := uc.totpCredentialRepository.FindByUserID(ctx, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find totp credential: %w", err)
+		}
+		secret, err := uc.cipher.Decrypt(cred.SecretCiphertext, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt totp secret: %w", err)
+		}
+		var ok bool
+		if step, ok = uc.totp.Validate(secret, cmd.Code, now); !ok {
+			return nil, uc.fail(ctx, hash, u.ID, now, "code_mismatch")
+		}
+	}
+
+	// コードとチャレンジの使用、トークン発行
+	var res *authdto.TokenResponse
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if cmd.Code != "" {
+			if err := uc.totpCredentialRepository.UseTOTPStep(ctx, u.ID, step); err != nil {
+				return err
+			}
+		} else {
+			if err := uc.recoveryCodeRepository.UseRecoveryCode(ctx, u.ID, uc.recoveryCodes.Hash(cmd.RecoveryCode), now); err != nil {
+				return err
+			}
+		}
+		if err := uc.twoFactorChallengeRepository.UseTwoFactorChallenge(ctx, hash, now); err != nil {
+			return err
+		}
+
+		var err error
+		res, _, err = uc.tokens.issue(ctx, u, "")
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, value_obj.UserTwoFactorCodeInvalidError) {
+			return nil, uc.fail(ctx, hash, u.ID, now, "code_reused_or_unknown")
+		}
+		return nil, err
+	}
+	uc.lockout.reset(ctx, u)
+	uc.logger.Info(ctx, "login succeeded", "target_user_id", u.ID, "recovery_code", cmd.RecoveryCode != "")
+
+	return res, nil
+}
+
+// fail は誤ったコードの入力回数の加算とユーザーの失敗の記録を行い、UserTwoFactorCodeInvalidError を返します。
+// 記録に失敗した場合は警告ログのみ出力します。
+func (uc *VerifyTwoFactorUsecase) fail(ctx context.Context, tokenHash, userID string, now time.Time, reason string) error {
+
+	uc.logger.Warn(ctx, "two-factor verification failed", "reason", reason, "target_user_id", userID)
+	if err := uc.twoFactorChallengeRepository.IncrementAttempts(ctx, tokenHash); err != nil {
+		uc.logger.Warn(ctx, "failed to record two-factor attempt", "target_user_id", userID, "error", err)
+	}
+	uc.lockout.recordFailure(ctx, userID, now)
+
+	return value_obj.UserTwoFactorCodeInvalidError
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	authdto "app/internal/application/dto/auth"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestVerifyTwoFactorUsecase_VerifyTwoFactor はログインの 2 段階目のユースケースの振る舞いを検証します。
+// 認証アプリのコード・リカバリーコードのいずれでもトークンの組が発行され、チャレンジとコードは再利用できないこと、
+// 誤ったコードは入力回数に数えられ、上限に達したチャレンジは正しいコードでも拒否されることを確認します。
+func TestVerifyTwoFactorUsecase_VerifyTwoFactor(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	now := time.Now()
+	findUser := func(_ context.Context, id string) (*entity.User, error) {
+		if id == "user-1" {
+			return &entity.User{ID: "user-1", Email: "alice@example.com", Role: "admin", EmailVerifiedAt: &now, TwoFactorEnabledAt: &now}, nil
+		}
+		return nil, value_obj.UserNotFoundError
+	}
+
+	// setup はチャレンジ "token"（ハッシュ値 hash-token）と、時間ステップ 100 まで使用済みの TOTP、
+	// 未使用のリカバリーコード code-1 を用意します。ログインの失敗回数はケースごとに数えます。
+	type fixture struct {
+		challenges  *testTwoFactorChallengeRepository
+		credentials *testTOTPCredentialRepository
+		codes       *testRecoveryCodeRepository
+		refresh     *testRefreshTokenRepository
+		uc          *VerifyTwoFactorUsecase
+	}
+	setup := func(t *testing.T, challenge entity.TwoFactorChallenge) *fixture {
+		f := &fixture{
+			challenges:  newTestTwoFactorChallengeRepository(),
+			credentials: newTestTOTPCredentialRepository(),
+			codes:       newTestRecoveryCodeRepository(),
+			refresh:     newTestRefreshTokenRepository(),
+		}
+		f.challenges.challenges["hash-token"] = &challenge
+		f.credentials.credentials["user-1"] = &entity.TOTPCredential{UserID: "user-1", SecretCiphertext: "enc:user-1:" + testTOTPSecret, ConfirmedAt: &now, LastUsedStep: 100}
+		f.codes.codes["hash-code-1"] = &entity.RecoveryCode{CodeHash: "hash-code-1", UserID: "user-1"}
+		users := &testUserRepository{findByIDFn: findUser}
+		f.uc = NewVerifyTwoFactorUsecase(users, f.refresh, f.challenges, f.credentials, f.codes, testTOTP{}, testSecretCipher{}, testRecoveryCodeGenerator{}, testTokenIssuer{}, &testTokenGenerator{}, testtx.NewFake(), testlogger.NewPortLogger(t), testTwoFactorOptions, testLoginLockoutOptions)
+		return f
+	}
+	active := entity.TwoFactorChallenge{TokenHash: "hash-token", UserID: "user-1", CreatedAt: now, ExpiresAt: now.Add(5 * time.Minute)}
+	used := active
+	used.UsedAt = &now
+	expired := active
+	expired.ExpiresAt = now.Add(-time.Second)
+	exhausted := active
+	exhausted.Attempts = testTwoFactorOptions.MaxAttempts
+
+	tests := map[string]struct {
+		challenge    entity.TwoFactorChallenge
+		cmd          authdto.VerifyTwoFactorCommand
+		wantErr      error
+		wantAttempts int
+	}{
+		"missing token": {
+			challenge: active,
+			cmd:       authdto.VerifyTwoFactorCommand{Code: "000101"},
+			wantErr:   value_obj.UserRequiredError,
+		},
+		"both code and recovery code": {
+			challenge: active,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000101", RecoveryCode: "code-1"},
+			wantErr:   value_obj.UserRequiredError,
+		},
+		"unknown token": {
+			challenge: active,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "other", Code: "000101"},
+			wantErr:   value_obj.UserTwoFactorChallengeInvalidError,
+		},
+		"used challenge": {
+			challenge: used,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000101"},
+			wantErr:   value_obj.UserTwoFactorChallengeInvalidError,
+		},
+		"expired challenge": {
+			challenge: expired,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000101"},
+			wantErr:   value_obj.UserTwoFactorChallengeInvalidError,
+		},
+		"too many attempts": {
+			challenge:    exhausted,
+			cmd:          authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000101"},
+			wantErr:      value_obj.UserTwoFactorChallengeInvalidError,
+			wantAttempts: testTwoFactorOptions.MaxAttempts,
+		},
+		"wrong code": {
+			challenge:    active,
+			cmd:          authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "wrong!"},
+			wantErr:      value_obj.UserTwoFactorCodeInvalidError,
+			wantAttempts: 1,
+		},
+		"reused code": {
+			challenge:    active,
+			cmd:          authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000100"},
+			wantErr:      value_obj.UserTwoFactorCodeInvalidError,
+			wantAttempts: 1,
+		},
+		"unknown recovery code": {
+			challenge:    active,
+			cmd:          authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", RecoveryCode: "code-9"},
+			wantErr:      value_obj.UserTwoFactorCodeInvalidError,
+			wantAttempts: 1,
+		},
+		"totp code": {
+			challenge: active,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", Code: "000101"},
+		},
+		"recovery code": {
+			challenge: active,
+			cmd:       authdto.VerifyTwoFactorCommand{TwoFactorToken: "token", RecoveryCode: "CODE-1"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := setup(t, tt.challenge)
+
+			res, err := f.uc.VerifyTwoFactor(ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyTwoFactor() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := f.challenges.challenges["hash-token"].Attempts; got != tt.wantAttempts {
+				t.Errorf("Attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantErr != nil {
+				if len(f.refresh.tokens) != 0 {
+					t.Errorf("refresh tokens = %v, want none", f.refresh.tokens)
+				}
+				return
+			}
+
+			// 2 段階認証を有効にした管理者は管理者の権限で発行される
+			if res.AccessToken != "access-user-1-admin" {
+				t.Errorf("AccessToken = %q, want %q", res.AccessToken, "access-user-1-admin")
+			}
+			if _, ok := f.refresh.tokens["hash-"+res.RefreshToken]; !ok {
+				t.Errorf("refresh token %q was not stored by hash", res.RefreshToken)
+			}
+
+			// チャレンジは再利用できない
+			if _, err := f.uc.VerifyTwoFactor(ctx, tt.cmd); !errors.Is(err, value_obj.UserTwoFactorChallengeInvalidError) {
+				t.Errorf("second VerifyTwoFactor() error = %v, want %v", err, value_obj.UserTwoFactorChallengeInvalidError)
+			}
+		})
+	}
+}
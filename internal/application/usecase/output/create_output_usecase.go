@@ -0,0 +1,110 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/services"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"fmt"
+	"time"
+)
+
+// CreateOutputUsecase は「認証済みユーザーが自分のアウトプットを登録する」というアプリケーションユースケースです。
+//
+// アウトプットの所有者はリクエストの入力ではなく、コンテキストの操作者（Principal）から決定します。
+// これにより、他人名義のアウトプットを作成することはできません。
+type CreateOutputUsecase struct {
+	outputRepository repository.OutputRepository
+	ids              port.IDGenerator
+}
+
+// NewCreateOutputUsecase は CreateOutputUsecase のコンストラクタです。
+func NewCreateOutputUsecase(outputRepository repository.OutputRepository, ids port.IDGenerator) *CreateOutputUsecase {
+	return &CreateOutputUsecase{outputRepository: outputRepository, ids: ids}
+}
+
+// CreateOutput はアウトプット作成ユースケースのエントリポイントです。
+//
+//  1. 操作者が member 権限以上か確認
+//  2. 種別の決定（省略時は other）と開催日・ISBN の正規化
+//  3. ドメインサービス CreateOutputValidation / OutputTypeValidation で入力値を検証（違反は項目ごとにまとめて返す）
+//  4. ID を採番してエンティティを生成（ステータスは下書き）し、リポジトリで保存
+//  5. 作成したアウトプットを OutputResponse として返却
+func (uc *CreateOutputUsecase) CreateOutput(ctx context.Context, cmd outputdto.CreateOutputCommand) (_ *outputdto.OutputResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "CreateOutputUsecase.CreateOutput")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック(member権限以上で使用可能)
+	actor, err := policy.Authorize(ctx, userValueObj.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	// 入力値の違反は項目ごとにまとめて返す
+	v := &value_obj.ValidationErrors{}
+
+	// 種別の決定
+	outputType, ok := value_obj.ParseOutputType(cmd.Type)
+	if !ok {
+		v.Add("type", value_obj.OutputTypeInvalidError)
+	}
+
+	// 種別ごとの付加情報の変換
+	eventDate, err := parseEventDate(cmd.EventDate)
+	if err != nil {
+		v.Add("event_date", value_obj.OutputEventDateFormatError)
+	}
+	isbn := services.NormalizeISBN(cmd.ISBN)
+
+	// バリデーションチェック
+	v.Merge(services.CreateOutputValidation(ctx, cmd.Title, cmd.Description, cmd.URL))
+	if ok {
+		v.Merge(services.OutputTypeValidation(ctx, string(outputType), cmd.URL, eventDate, isbn))
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// ID の採番
+	id, err := uc.ids.NewID()
+	if err != nil {
+		return nil, err
+	}
+
+	// Entity生成
+	o, err := entity.NewOutput(id, actor.UserID, cmd.Title, cmd.Description, cmd.URL, string(outputType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build output: %w", err)
+	}
+	o.EventDate = eventDate
+	o.ISBN = isbn
+
+	// アウトプット作成
+	if err := uc.outputRepository.CreateOutput(ctx, o); err != nil {
+		return nil, fmt.Errorf("failed to create output: %w", err)
+	}
+
+	return outputdto.NewOutputResponse(o), nil
+}
+
+// parseEventDate は YYYY-MM-DD 形式の開催日をパースします。
+// 空文字は「開催日なし」として nil を返します。
+func parseEventDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, value_obj.OutputEventDateFormatError
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,214 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testidgen "app/internal/test/idgen"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// testOutputRepository はメモリ上でアウトプットを保持するテストリポジトリです。
+// 論理削除済みのアウトプットは FindByID の対象外とし、実装と同じ振る舞いに揃えています。
+type testOutputRepository struct {
+	mu       sync.Mutex
+	outputs  map[string]*entity.Output
+	criteria repository.OutputListCriteria
+	err      error
+}
+
+func newTestOutputRepository(outputs ...*entity.Output) *testOutputRepository {
+	m := &testOutputRepository{outputs: map[string]*entity.Output{}}
+	for _, o := range outputs {
+		m.outputs[o.ID] = o
+	}
+	return m
+}
+
+func (m *testOutputRepository) CreateOutput(_ context.Context, o *entity.Output) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.outputs[o.ID] = o
+	return nil
+}
+
+func (m *testOutputRepository) FindByID(_ context.Context, id string) (*entity.Output, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	o, ok := m.outputs[id]
+	if !ok || o.DeleteFlag {
+		return nil, value_obj.OutputNotFoundError
+	}
+	copied := *o
+	return &copied, nil
+}
+
+func (m *testOutputRepository) ListOutputs(_ context.Context, criteria repository.OutputListCriteria) (*repository.OutputPage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.criteria = criteria
+	page := &repository.OutputPage{}
+	for _, o := range m.outputs {
+		if o.DeleteFlag || (criteria.UserID != "" && o.UserID != criteria.UserID) {
+			continue
+		}
+		page.Outputs = append(page.Outputs, o)
+	}
+	page.Total = int64(len(page.Outputs))
+	return page, nil
+}
+
+func (m *testOutputRepository) UpdateOutput(_ context.Context, o *entity.Output) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored, ok := m.outputs[o.ID]; !ok || stored.Status != string(value_obj.Draft) {
+		return value_obj.OutputNotEditableError
+	}
+	copied := *o
+	m.outputs[o.ID] = &copied
+	return nil
+}
+
+func (m *testOutputRepository) UpdateOutputStatus(_ context.Context, o *entity.Output, from string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stored, ok := m.outputs[o.ID]; !ok || stored.Status != from {
+		return value_obj.OutputStatusTransitionError
+	}
+	copied := *o
+	m.outputs[o.ID] = &copied
+	return nil
+}
+
+func (m *testOutputRepository) DeleteOutput(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if o, ok := m.outputs[id]; ok {
+		o.DeleteFlag = true
+	}
+	return nil
+}
+
+var _ repository.OutputRepository = (*testOutputRepository)(nil)
+
+// withPrincipal は指定した操作者を持つコンテキストを返します。
+func withPrincipal(userID string, role userValueObj.Role) context.Context {
+	return policy.WithPrincipal(context.Background(), policy.Principal{UserID: userID, Role: role})
+}
+
+// TestCreateOutputUsecase_CreateOutput はアウトプット作成ユースケースを検証します。
+// 所有者が操作者から決定されること、ステータスが下書きで作成されることを確認します。
+func TestCreateOutputUsecase_CreateOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	valid := outputdto.CreateOutputCommand{Title: "Go入門", Description: "学習記録", URL: "https://example.com/post", Type: "blog"}
+
+	tests := map[string]struct {
+		ctx     context.Context
+		cmd     outputdto.CreateOutputCommand
+		wantErr error
+	}{
+		"unauthenticated":    {ctx: context.Background(), cmd: valid, wantErr: userValueObj.UserUnauthenticatedError},
+		"guest is forbidden": {ctx: withPrincipal("guest-1", userValueObj.Guest), cmd: valid, wantErr: userValueObj.UserForbiddenError},
+		"title required":     {ctx: withPrincipal("user-1", userValueObj.Member), cmd: outputdto.CreateOutputCommand{}, wantErr: value_obj.OutputRequiredError},
+		"invalid url":        {ctx: withPrincipal("user-1", userValueObj.Member), cmd: outputdto.CreateOutputCommand{Title: "Go入門", URL: "example"}, wantErr: value_obj.OutputURLFormatError},
+		"unknown type": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.CreateOutputCommand{Title: "Go入門", Type: "podcast"},
+			wantErr: value_obj.OutputTypeInvalidError,
+		},
+		"repository outside code host": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.CreateOutputCommand{Title: "ツール", URL: "https://example.com/tool", Type: "repository"},
+			wantErr: value_obj.OutputRepositoryURLHostError,
+		},
+		"talk with malformed date": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.CreateOutputCommand{Title: "登壇", Type: "talk", EventDate: "2025/06/01"},
+			wantErr: value_obj.OutputEventDateFormatError,
+		},
+		"talk with event date": {
+			ctx: withPrincipal("user-1", userValueObj.Member),
+			cmd: outputdto.CreateOutputCommand{Title: "登壇", Type: "talk", EventDate: "2025-06-01"},
+		},
+		"book note with hyphenated isbn": {
+			ctx: withPrincipal("user-1", userValueObj.Member),
+			cmd: outputdto.CreateOutputCommand{Title: "読書メモ", Type: "book_note", ISBN: "978-4-87311-969-4"},
+		},
+		"type defaults to other": {ctx: withPrincipal("user-1", userValueObj.Member), cmd: outputdto.CreateOutputCommand{Title: "メモ"}},
+		"member creates own":     {ctx: withPrincipal("user-1", userValueObj.Member), cmd: valid},
+		"admin creates own":      {ctx: withPrincipal("admin-1", userValueObj.Admin), cmd: valid},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("CreateOutputUsecase テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository()
+			res, err := NewCreateOutputUsecase(repoMock, testidgen.NewFake("output")).CreateOutput(tt.ctx, tt.cmd)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if len(repoMock.outputs) != 0 {
+					t.Fatalf("output should not be stored on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actor, _ := policy.PrincipalFromContext(tt.ctx)
+			if res.ID != "output-0001" {
+				t.Errorf("ID = %q, want %q", res.ID, "output-0001")
+			}
+			if res.UserID != actor.UserID {
+				t.Errorf("UserID = %q, want %q", res.UserID, actor.UserID)
+			}
+			if res.Status != "draft" {
+				t.Errorf("Status = %q, want %q", res.Status, "draft")
+			}
+			if res.Title != tt.cmd.Title || res.URL != tt.cmd.URL || res.EventDate != tt.cmd.EventDate {
+				t.Errorf("unexpected response: %+v", res)
+			}
+			if tt.cmd.Type == "" && res.Type != "other" {
+				t.Errorf("Type = %q, want %q", res.Type, "other")
+			}
+			if tt.cmd.ISBN != "" && res.ISBN != "9784873119694" {
+				t.Errorf("ISBN = %q, want normalized %q", res.ISBN, "9784873119694")
+			}
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("db error")
+		repoMock := newTestOutputRepository()
+		repoMock.err = expectedErr
+
+		_, err := NewCreateOutputUsecase(repoMock, testidgen.NewFake("output")).CreateOutput(withPrincipal("user-1", userValueObj.Member), valid)
+		if !errors.Is(err, expectedErr) {
+			t.Fatalf("expected wrapped error %v, got %v", expectedErr, err)
+		}
+	})
+}
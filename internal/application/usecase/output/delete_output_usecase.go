@@ -0,0 +1,41 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/repository"
+	"context"
+	"fmt"
+)
+
+// DeleteOutputUsecase は「アウトプットを論理削除する」というアプリケーションユースケースです。
+//
+// 所有者本人または admin 権限以上の操作者のみ削除できます。
+// 存在しない・削除済みのアウトプットを指定した場合は OutputNotFoundError を返します。
+type DeleteOutputUsecase struct {
+	outputRepository repository.OutputRepository
+}
+
+// NewDeleteOutputUsecase は DeleteOutputUsecase のコンストラクタです。
+func NewDeleteOutputUsecase(outputRepository repository.OutputRepository) *DeleteOutputUsecase {
+	return &DeleteOutputUsecase{outputRepository: outputRepository}
+}
+
+// DeleteOutput はアウトプット削除ユースケースのエントリポイントです。
+func (uc *DeleteOutputUsecase) DeleteOutput(ctx context.Context, cmd outputdto.DeleteOutputCommand) (err error) {
+
+	ctx, span := tracing.Start(ctx, "DeleteOutputUsecase.DeleteOutput")
+	defer func() { tracing.End(span, err) }()
+
+	// 存在チェックと権限チェック
+	if _, err := findOwnedOutput(ctx, uc.outputRepository, cmd.ID); err != nil {
+		return err
+	}
+
+	// アウトプット削除
+	if err := uc.outputRepository.DeleteOutput(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("failed to delete output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestDeleteOutputUsecase_DeleteOutput はアウトプット削除が所有者本人と admin 権限以上に限定され、
+// 削除済みのアウトプットには OutputNotFoundError を返すことを検証します。
+func TestDeleteOutputUsecase_DeleteOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		ctx         context.Context
+		id          string
+		wantErr     error
+		wantDeleted bool
+	}{
+		"other member is forbidden": {ctx: withPrincipal("user-2", userValueObj.Member), id: "output-1", wantErr: userValueObj.UserForbiddenError},
+		"already deleted":           {ctx: withPrincipal("user-1", userValueObj.Member), id: "deleted-1", wantErr: value_obj.OutputNotFoundError},
+		"owner deletes":             {ctx: withPrincipal("user-1", userValueObj.Member), id: "output-1", wantDeleted: true},
+		"admin deletes":             {ctx: withPrincipal("admin-1", userValueObj.Admin), id: "output-1", wantDeleted: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("DeleteOutputUsecase テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository(
+				&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門"},
+				&entity.Output{ID: "deleted-1", UserID: "user-1", Title: "削除済み", DeleteFlag: true},
+			)
+
+			err := NewDeleteOutputUsecase(repoMock).DeleteOutput(tt.ctx, outputdto.DeleteOutputCommand{ID: tt.id})
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := repoMock.outputs["output-1"].DeleteFlag; got != tt.wantDeleted {
+				t.Fatalf("DeleteFlag = %v, want %v", got, tt.wantDeleted)
+			}
+		})
+	}
+}
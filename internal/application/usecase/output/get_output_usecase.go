@@ -0,0 +1,66 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GetOutputUsecase は「ID を指定してアウトプットを 1 件取得する」というアプリケーションユースケースです。
+//
+// 所有者本人または admin 権限以上の操作者のみ取得できます。
+type GetOutputUsecase struct {
+	outputRepository repository.OutputRepository
+}
+
+// NewGetOutputUsecase は GetOutputUsecase のコンストラクタです。
+func NewGetOutputUsecase(outputRepository repository.OutputRepository) *GetOutputUsecase {
+	return &GetOutputUsecase{outputRepository: outputRepository}
+}
+
+// GetOutput はアウトプット取得ユースケースのエントリポイントです。
+func (uc *GetOutputUsecase) GetOutput(ctx context.Context, query outputdto.GetOutputQuery) (_ *outputdto.OutputResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "GetOutputUsecase.GetOutput")
+	defer func() { tracing.End(span, err) }()
+
+	o, err := findOwnedOutput(ctx, uc.outputRepository, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputdto.NewOutputResponse(o), nil
+}
+
+// findOwnedOutput はアウトプットを取得し、操作者が所有者本人または admin 権限以上であるかを確認します。
+// 認証されていない場合は所有者を確認する前に UserUnauthenticatedError を返します。
+func findOwnedOutput(ctx context.Context, outputRepository repository.OutputRepository, id string) (*entity.Output, error) {
+
+	// 認証チェック
+	if _, err := policy.Authorize(ctx, userValueObj.Guest); err != nil {
+		return nil, err
+	}
+
+	// アウトプットの取得
+	o, err := outputRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, value_obj.OutputNotFoundError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find output: %w", err)
+	}
+
+	// 権限チェック(所有者本人またはadmin権限以上)
+	if _, err := policy.AuthorizeSelfOr(ctx, o.UserID, userValueObj.Admin); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
@@ -0,0 +1,63 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestGetOutputUsecase_GetOutput はアウトプット取得が所有者本人と admin 権限以上に限定されることを検証します。
+func TestGetOutputUsecase_GetOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	repoMock := newTestOutputRepository(
+		&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門", Status: "draft"},
+		&entity.Output{ID: "deleted-1", UserID: "user-1", Title: "削除済み", DeleteFlag: true},
+	)
+
+	tests := map[string]struct {
+		ctx     context.Context
+		id      string
+		wantErr error
+	}{
+		"unauthenticated":           {ctx: context.Background(), id: "output-1", wantErr: userValueObj.UserUnauthenticatedError},
+		"other member is forbidden": {ctx: withPrincipal("user-2", userValueObj.Member), id: "output-1", wantErr: userValueObj.UserForbiddenError},
+		"owner":                     {ctx: withPrincipal("user-1", userValueObj.Member), id: "output-1"},
+		"admin":                     {ctx: withPrincipal("admin-1", userValueObj.Admin), id: "output-1"},
+		"not found":                 {ctx: withPrincipal("admin-1", userValueObj.Admin), id: "missing", wantErr: value_obj.OutputNotFoundError},
+		"deleted":                   {ctx: withPrincipal("user-1", userValueObj.Member), id: "deleted-1", wantErr: value_obj.OutputNotFoundError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("GetOutputUsecase テストケース開始: %s", name)
+
+			res, err := NewGetOutputUsecase(repoMock).GetOutput(tt.ctx, outputdto.GetOutputQuery{ID: tt.id})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.ID != "output-1" || res.Title != "Go入門" {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+		})
+	}
+}
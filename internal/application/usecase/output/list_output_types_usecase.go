@@ -0,0 +1,48 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/services"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+)
+
+// ListOutputTypesUsecase は「アウトプット種別と種別ごとの入力ルールを一覧取得する」というアプリケーションユースケースです。
+//
+// 種別と入力ルールはドメインサービスに定義されたものをそのまま返すため、リポジトリには依存しません。
+// 管理画面から利用されることを想定し、admin 権限以上の操作者のみ使用できます。
+type ListOutputTypesUsecase struct{}
+
+// NewListOutputTypesUsecase は ListOutputTypesUsecase のコンストラクタです。
+func NewListOutputTypesUsecase() *ListOutputTypesUsecase {
+	return &ListOutputTypesUsecase{}
+}
+
+// ListOutputTypes はアウトプット種別一覧取得ユースケースのエントリポイントです。
+func (uc *ListOutputTypesUsecase) ListOutputTypes(ctx context.Context) (_ []*outputdto.OutputTypeResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "ListOutputTypesUsecase.ListOutputTypes")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック(admin権限以上で使用可能)
+	if _, err := policy.Authorize(ctx, userValueObj.Admin); err != nil {
+		return nil, err
+	}
+
+	rules := services.OutputTypeRules()
+	items := make([]*outputdto.OutputTypeResponse, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, &outputdto.OutputTypeResponse{
+			Type:              string(rule.Type),
+			Label:             rule.Type.Label(),
+			RequiresURL:       rule.RequiresURL,
+			RequiresCodeHost:  rule.RequiresCodeHost,
+			RequiresEventDate: rule.RequiresEventDate,
+			RequiresISBN:      rule.RequiresISBN,
+		})
+	}
+
+	return items, nil
+}
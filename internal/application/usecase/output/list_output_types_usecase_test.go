@@ -0,0 +1,67 @@
+package output
+
+import (
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestListOutputTypesUsecase_ListOutputTypes は種別一覧が admin 権限以上に限定され、
+// 定義済みの全種別が入力ルール付きで返ることを検証します。
+func TestListOutputTypesUsecase_ListOutputTypes(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	uc := NewListOutputTypesUsecase()
+
+	t.Run("member is forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := uc.ListOutputTypes(withPrincipal("user-1", userValueObj.Member)); !errors.Is(err, userValueObj.UserForbiddenError) {
+			t.Fatalf("expected error %v, got %v", userValueObj.UserForbiddenError, err)
+		}
+		if _, err := uc.ListOutputTypes(context.Background()); !errors.Is(err, userValueObj.UserUnauthenticatedError) {
+			t.Fatalf("expected error %v, got %v", userValueObj.UserUnauthenticatedError, err)
+		}
+	})
+
+	t.Run("admin lists all types", func(t *testing.T) {
+		t.Parallel()
+
+		items, err := uc.ListOutputTypes(withPrincipal("admin-1", userValueObj.Admin))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(items) != len(value_obj.OutputTypes()) {
+			t.Fatalf("got %d types, want %d", len(items), len(value_obj.OutputTypes()))
+		}
+		for i, want := range value_obj.OutputTypes() {
+			if items[i].Type != string(want) || items[i].Label == "" {
+				t.Errorf("items[%d] = %+v, want type %q with label", i, items[i], want)
+			}
+		}
+
+		for _, item := range items {
+			switch item.Type {
+			case "repository":
+				if !item.RequiresURL || !item.RequiresCodeHost {
+					t.Errorf("repository rule = %+v, want url on code host", item)
+				}
+			case "talk":
+				if !item.RequiresEventDate {
+					t.Errorf("talk rule = %+v, want event date", item)
+				}
+			case "book_note":
+				if !item.RequiresISBN {
+					t.Errorf("book_note rule = %+v, want isbn", item)
+				}
+			}
+		}
+	})
+}
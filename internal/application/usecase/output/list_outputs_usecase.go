@@ -0,0 +1,90 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/services"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"fmt"
+)
+
+// ListOutputsUsecase は「アウトプットをページ単位で一覧取得する」というアプリケーションユースケースです。
+//
+// user_id を省略した場合は操作者自身のアウトプットを返します。
+// 他のユーザーのアウトプット一覧の取得は admin 権限以上の操作者のみ許可します。
+type ListOutputsUsecase struct {
+	outputRepository repository.OutputRepository
+}
+
+// NewListOutputsUsecase は ListOutputsUsecase のコンストラクタです。
+func NewListOutputsUsecase(outputRepository repository.OutputRepository) *ListOutputsUsecase {
+	return &ListOutputsUsecase{outputRepository: outputRepository}
+}
+
+// ListOutputs はアウトプット一覧取得ユースケースのエントリポイントです。
+//
+//  1. 対象ユーザーを決定し、本人または admin 権限以上か確認
+//  2. 取得件数の既定値を補完し、ドメインサービス ListOutputsValidation で検索条件を検証
+//  3. リポジトリで一覧と総件数を取得し、レスポンスエンベロープに詰め替えて返却
+func (uc *ListOutputsUsecase) ListOutputs(ctx context.Context, query outputdto.ListOutputsQuery) (_ *outputdto.OutputListResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "ListOutputsUsecase.ListOutputs")
+	defer func() { tracing.End(span, err) }()
+
+	// 認証チェック
+	actor, err := policy.Authorize(ctx, userValueObj.Guest)
+	if err != nil {
+		return nil, err
+	}
+
+	// 対象ユーザーの決定と権限チェック
+	userID := query.UserID
+	if userID == "" {
+		userID = actor.UserID
+	}
+	if _, err := policy.AuthorizeSelfOr(ctx, userID, userValueObj.Admin); err != nil {
+		return nil, err
+	}
+
+	criteria := repository.OutputListCriteria{
+		UserID: userID,
+		Type:   query.Type,
+		Status: query.Status,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+
+	// 取得件数の既定値補完
+	if criteria.Limit == 0 {
+		criteria.Limit = services.DefaultOutputListLimit
+	}
+
+	// バリデーションチェック
+	if err := services.ListOutputsValidation(ctx, criteria); err != nil {
+		return nil, err
+	}
+
+	// 一覧取得
+	page, err := uc.outputRepository.ListOutputs(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outputs: %w", err)
+	}
+
+	items := make([]*outputdto.OutputResponse, 0, len(page.Outputs))
+	for _, o := range page.Outputs {
+		items = append(items, outputdto.NewOutputResponse(o))
+	}
+
+	return &outputdto.OutputListResponse{
+		Items: items,
+		Pagination: outputdto.Pagination{
+			Total:   page.Total,
+			Limit:   criteria.Limit,
+			Offset:  query.Offset,
+			HasNext: int64(query.Offset+len(items)) < page.Total,
+		},
+	}, nil
+}
@@ -0,0 +1,99 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/services"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestListOutputsUsecase_ListOutputs はアウトプット一覧の対象ユーザーの決定と権限チェック、
+// 取得件数の既定値補完を検証します。
+func TestListOutputsUsecase_ListOutputs(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		ctx        context.Context
+		query      outputdto.ListOutputsQuery
+		wantErr    error
+		wantUserID string
+		wantLimit  int
+		wantTotal  int64
+	}{
+		"unauthenticated": {
+			ctx:     context.Background(),
+			wantErr: userValueObj.UserUnauthenticatedError,
+		},
+		"defaults to own outputs": {
+			ctx:        withPrincipal("user-1", userValueObj.Member),
+			wantUserID: "user-1",
+			wantLimit:  services.DefaultOutputListLimit,
+			wantTotal:  2,
+		},
+		"member cannot list others": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			query:   outputdto.ListOutputsQuery{UserID: "user-2"},
+			wantErr: userValueObj.UserForbiddenError,
+		},
+		"admin lists others": {
+			ctx:        withPrincipal("admin-1", userValueObj.Admin),
+			query:      outputdto.ListOutputsQuery{UserID: "user-2", Limit: 5},
+			wantUserID: "user-2",
+			wantLimit:  5,
+			wantTotal:  1,
+		},
+		"limit over max": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			query:   outputdto.ListOutputsQuery{Limit: services.MaxOutputListLimit + 1},
+			wantErr: value_obj.OutputListLimitRangeError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ListOutputsUsecase テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository(
+				&entity.Output{ID: "output-1", UserID: "user-1"},
+				&entity.Output{ID: "output-2", UserID: "user-1"},
+				&entity.Output{ID: "output-3", UserID: "user-2"},
+			)
+
+			res, err := NewListOutputsUsecase(repoMock).ListOutputs(tt.ctx, tt.query)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if repoMock.criteria.UserID != tt.wantUserID {
+				t.Errorf("criteria.UserID = %q, want %q", repoMock.criteria.UserID, tt.wantUserID)
+			}
+			if res.Pagination.Limit != tt.wantLimit {
+				t.Errorf("Pagination.Limit = %d, want %d", res.Pagination.Limit, tt.wantLimit)
+			}
+			if res.Pagination.Total != tt.wantTotal || int64(len(res.Items)) != tt.wantTotal {
+				t.Errorf("got %d items (total %d), want %d", len(res.Items), res.Pagination.Total, tt.wantTotal)
+			}
+			if res.Pagination.HasNext {
+				t.Errorf("HasNext = true, want false")
+			}
+		})
+	}
+}
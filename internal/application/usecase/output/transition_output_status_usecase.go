@@ -0,0 +1,85 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/value_obj"
+	userServices "app/internal/domain/user/services"
+	userValueObj "app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TransitionOutputStatusUsecase は「アウトプットのステータスを遷移表に従って変更する」というアプリケーションユースケースです。
+//
+// 下書きの提出・取り下げ後の再編集・公開停止・アーカイブ・復元は所有者本人または admin 権限以上が行えます。
+// レビュー結果の判断にあたる公開（publish）と差し戻し（reject）は admin 権限以上のみ許可します。
+// 変更に成功した場合は、操作者と変更日時をアウトプットに記録します。
+type TransitionOutputStatusUsecase struct {
+	outputRepository repository.OutputRepository
+	logger           port.Logger
+	now              func() time.Time
+}
+
+// NewTransitionOutputStatusUsecase は TransitionOutputStatusUsecase のコンストラクタです。
+func NewTransitionOutputStatusUsecase(outputRepository repository.OutputRepository, logger port.Logger) *TransitionOutputStatusUsecase {
+	return &TransitionOutputStatusUsecase{outputRepository: outputRepository, logger: logger, now: time.Now}
+}
+
+// reviewActions はレビュー担当（admin 権限以上）のみが実行できる操作です。
+var reviewActions = map[value_obj.StatusAction]bool{
+	value_obj.Publish: true,
+	value_obj.Reject:  true,
+}
+
+// TransitionStatus はステータス変更ユースケースのエントリポイントです。
+//
+//  1. 操作が定義済みか確認
+//  2. アウトプットを取得し、所有者本人または admin 権限以上か確認（レビュー操作は admin 権限以上のみ）
+//  3. 遷移表に従って遷移先を決定し、操作者と変更日時を記録
+//  4. 取得時のステータスのまま変わっていない場合のみ保存し、OutputResponse を返却
+func (uc *TransitionOutputStatusUsecase) TransitionStatus(ctx context.Context, cmd outputdto.TransitionOutputStatusCommand) (_ *outputdto.OutputResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "TransitionOutputStatusUsecase.TransitionStatus")
+	defer func() { tracing.End(span, err) }()
+
+	// 操作のチェック
+	action := value_obj.StatusAction(cmd.Action)
+	if !action.IsValid() {
+		return nil, value_obj.OutputStatusActionInvalidError
+	}
+
+	// 既存アウトプットの取得と権限チェック
+	o, err := findOwnedOutput(ctx, uc.outputRepository, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+	actor, _ := policy.PrincipalFromContext(ctx)
+	if reviewActions[action] {
+		if err := userServices.AuthorizeRole(actor.Role, userValueObj.Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	// ステータスの遷移
+	from := o.Status
+	if err := o.ApplyStatusAction(action, actor.UserID, uc.now()); err != nil {
+		return nil, err
+	}
+
+	// ステータス更新
+	if err := uc.outputRepository.UpdateOutputStatus(ctx, o, from); err != nil {
+		if errors.Is(err, value_obj.OutputStatusTransitionError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update output status: %w", err)
+	}
+	uc.logger.Info(ctx, "output status changed", "output_id", o.ID, "action", string(action), "from", from, "to", o.Status)
+
+	return outputdto.NewOutputResponse(o), nil
+}
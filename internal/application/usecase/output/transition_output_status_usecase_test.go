@@ -0,0 +1,124 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestTransitionOutputStatusUsecase_TransitionStatus はステータス変更ユースケースの権限チェックと、
+// 変更者・変更日時の記録を検証します。
+func TestTransitionOutputStatusUsecase_TransitionStatus(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		ctx        context.Context
+		status     value_obj.Status
+		action     string
+		wantErr    error
+		wantStatus value_obj.Status
+		wantBy     string
+	}{
+		"unknown action": {
+			ctx: withPrincipal("user-1", userValueObj.Member), status: value_obj.Draft, action: "delete",
+			wantErr: value_obj.OutputStatusActionInvalidError,
+		},
+		"other member is forbidden": {
+			ctx: withPrincipal("user-2", userValueObj.Member), status: value_obj.Draft, action: "submit",
+			wantErr: userValueObj.UserForbiddenError,
+		},
+		"owner cannot publish": {
+			ctx: withPrincipal("user-1", userValueObj.Member), status: value_obj.InReview, action: "publish",
+			wantErr: userValueObj.UserForbiddenError,
+		},
+		"illegal transition": {
+			ctx: withPrincipal("user-1", userValueObj.Member), status: value_obj.Draft, action: "archive",
+			wantErr: value_obj.OutputStatusTransitionError,
+		},
+		"owner submits": {
+			ctx: withPrincipal("user-1", userValueObj.Member), status: value_obj.Draft, action: "submit",
+			wantStatus: value_obj.InReview, wantBy: "user-1",
+		},
+		"admin publishes": {
+			ctx: withPrincipal("admin-1", userValueObj.Admin), status: value_obj.InReview, action: "publish",
+			wantStatus: value_obj.Published, wantBy: "admin-1",
+		},
+		"owner restores archived": {
+			ctx: withPrincipal("user-1", userValueObj.Member), status: value_obj.Archived, action: "restore",
+			wantStatus: value_obj.Draft, wantBy: "user-1",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("TransitionOutputStatusUsecase テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository(
+				&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門", Status: string(tt.status)},
+			)
+			uc := NewTransitionOutputStatusUsecase(repoMock, testlogger.NewPortLogger(t))
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.TransitionStatus(tt.ctx, outputdto.TransitionOutputStatusCommand{ID: "output-1", Action: tt.action})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if got := repoMock.outputs["output-1"].Status; got != string(tt.status) {
+					t.Fatalf("stored status = %q, want unchanged %q", got, tt.status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Status != string(tt.wantStatus) {
+				t.Errorf("Status = %q, want %q", res.Status, tt.wantStatus)
+			}
+
+			stored := repoMock.outputs["output-1"]
+			if stored.Status != string(tt.wantStatus) || stored.StatusChangedBy != tt.wantBy {
+				t.Errorf("stored = %+v, want status %q by %q", stored, tt.wantStatus, tt.wantBy)
+			}
+			if stored.StatusChangedAt == nil || !stored.StatusChangedAt.Equal(now) {
+				t.Errorf("StatusChangedAt = %v, want %v", stored.StatusChangedAt, now)
+			}
+		})
+	}
+
+	t.Run("concurrent change is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		// 取得後に別の操作でステータスが変わった状況を、保存時の遷移元チェックで再現する
+		repoMock := newTestOutputRepository(
+			&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門", Status: string(value_obj.Draft)},
+		)
+		ctx := withPrincipal("user-1", userValueObj.Member)
+		uc := NewTransitionOutputStatusUsecase(repoMock, testlogger.NewPortLogger(t))
+		uc.now = func() time.Time {
+			repoMock.outputs["output-1"].Status = string(value_obj.InReview)
+			return now
+		}
+
+		_, err := uc.TransitionStatus(ctx, outputdto.TransitionOutputStatusCommand{ID: "output-1", Action: "submit"})
+		if !errors.Is(err, value_obj.OutputStatusTransitionError) {
+			t.Fatalf("expected error %v, got %v", value_obj.OutputStatusTransitionError, err)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/application/tracing"
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/services"
+	"app/internal/domain/output/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// UpdateOutputUsecase は「既存アウトプットの内容を部分更新する」というアプリケーションユースケースです。
+//
+// 所有者本人または admin 権限以上の操作者のみ更新できます。
+// 内容を編集できるのは下書き（draft）のアウトプットのみで、それ以外は OutputNotEditableError を返します。
+// 更新は「取得 → 指定された項目のみ上書き → ドメインバリデーション → 保存」の順で行います。
+type UpdateOutputUsecase struct {
+	outputRepository repository.OutputRepository
+}
+
+// NewUpdateOutputUsecase は UpdateOutputUsecase のコンストラクタです。
+func NewUpdateOutputUsecase(outputRepository repository.OutputRepository) *UpdateOutputUsecase {
+	return &UpdateOutputUsecase{outputRepository: outputRepository}
+}
+
+// UpdateOutput はアウトプット更新ユースケースのエントリポイントです。
+func (uc *UpdateOutputUsecase) UpdateOutput(ctx context.Context, cmd outputdto.UpdateOutputCommand) (_ *outputdto.OutputResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "UpdateOutputUsecase.UpdateOutput")
+	defer func() { tracing.End(span, err) }()
+
+	// 既存アウトプットの取得と権限チェック
+	o, err := findOwnedOutput(ctx, uc.outputRepository, cmd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新項目のチェック
+	if cmd.Title == nil && cmd.Description == nil && cmd.URL == nil && cmd.Type == nil &&
+		cmd.EventDate == nil && cmd.ISBN == nil {
+		return nil, value_obj.OutputUpdateRequiredError
+	}
+
+	// ステータスのチェック
+	if !value_obj.Status(o.Status).IsEditable() {
+		return nil, value_obj.OutputNotEditableError
+	}
+
+	// 入力値の違反は項目ごとにまとめて返す
+	v := &value_obj.ValidationErrors{}
+
+	// 指定された項目のみ上書き
+	if cmd.Title != nil {
+		o.Title = *cmd.Title
+	}
+	if cmd.Description != nil {
+		o.Description = *cmd.Description
+	}
+	if cmd.URL != nil {
+		o.URL = *cmd.URL
+	}
+	if cmd.Type != nil {
+		o.Type = *cmd.Type
+	}
+	if cmd.EventDate != nil {
+		eventDate, err := parseEventDate(*cmd.EventDate)
+		if err != nil {
+			v.Add("event_date", value_obj.OutputEventDateFormatError)
+		}
+		o.EventDate = eventDate
+	}
+	if cmd.ISBN != nil {
+		o.ISBN = services.NormalizeISBN(*cmd.ISBN)
+	}
+
+	// バリデーションチェック
+	// 種別を変更する場合は、変更後の種別で不要になる項目も同じリクエストで消去する必要がある
+	v.Merge(services.UpdateOutputValidation(ctx, o.Title, o.Description, o.URL))
+	v.Merge(services.OutputTypeValidation(ctx, o.Type, o.URL, o.EventDate, o.ISBN))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// アウトプット更新
+	o.UpdatedAt = time.Now()
+	if err := uc.outputRepository.UpdateOutput(ctx, o); err != nil {
+		if errors.Is(err, value_obj.OutputNotEditableError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update output: %w", err)
+	}
+
+	return outputdto.NewOutputResponse(o), nil
+}
@@ -0,0 +1,167 @@
+package output
+
+import (
+	outputdto "app/internal/application/dto/output"
+	"app/internal/domain/output/entity"
+	"app/internal/domain/output/value_obj"
+	userValueObj "app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestUpdateOutputUsecase_UpdateOutput はアウトプットの部分更新を検証します。
+// 指定しなかった項目が既存の値のまま維持されることも確認します。
+func TestUpdateOutputUsecase_UpdateOutput(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	strPtr := func(s string) *string { return &s }
+
+	tests := map[string]struct {
+		ctx       context.Context
+		cmd       outputdto.UpdateOutputCommand
+		wantErr   error
+		wantTitle string
+		wantDesc  string
+	}{
+		"other member is forbidden": {
+			ctx:     withPrincipal("user-2", userValueObj.Member),
+			cmd:     outputdto.UpdateOutputCommand{ID: "output-1", Title: strPtr("変更")},
+			wantErr: userValueObj.UserForbiddenError,
+		},
+		"not found": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.UpdateOutputCommand{ID: "missing", Title: strPtr("変更")},
+			wantErr: value_obj.OutputNotFoundError,
+		},
+		"no fields": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.UpdateOutputCommand{ID: "output-1"},
+			wantErr: value_obj.OutputUpdateRequiredError,
+		},
+		"empty title": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.UpdateOutputCommand{ID: "output-1", Title: strPtr("")},
+			wantErr: value_obj.OutputRequiredError,
+		},
+		"change to book note without isbn": {
+			ctx:     withPrincipal("user-1", userValueObj.Member),
+			cmd:     outputdto.UpdateOutputCommand{ID: "output-1", Type: strPtr("book_note")},
+			wantErr: value_obj.OutputISBNRequiredError,
+		},
+		"change to talk with event date": {
+			ctx:       withPrincipal("user-1", userValueObj.Member),
+			cmd:       outputdto.UpdateOutputCommand{ID: "output-1", Type: strPtr("talk"), EventDate: strPtr("2025-06-01")},
+			wantTitle: "Go入門",
+			wantDesc:  "学習記録",
+		},
+		"owner updates title": {
+			ctx:       withPrincipal("user-1", userValueObj.Member),
+			cmd:       outputdto.UpdateOutputCommand{ID: "output-1", Title: strPtr("Go応用")},
+			wantTitle: "Go応用",
+			wantDesc:  "学習記録",
+		},
+		"admin clears description": {
+			ctx:       withPrincipal("admin-1", userValueObj.Admin),
+			cmd:       outputdto.UpdateOutputCommand{ID: "output-1", Description: strPtr("")},
+			wantTitle: "Go入門",
+			wantDesc:  "",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("UpdateOutputUsecase テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository(
+				&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門", Description: "学習記録", Type: "other", Status: "draft"},
+			)
+
+			res, err := NewUpdateOutputUsecase(repoMock).UpdateOutput(tt.ctx, tt.cmd)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Title != tt.wantTitle || res.Description != tt.wantDesc {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+
+			stored := repoMock.outputs["output-1"]
+			if stored.Title != tt.wantTitle || stored.Description != tt.wantDesc || stored.UserID != "user-1" {
+				t.Fatalf("unexpected stored output: %+v", stored)
+			}
+		})
+	}
+}
+
+// TestUpdateOutputUsecase_UpdateOutput_Status は下書き以外のアウトプットの内容を編集できないことを
+// ステータスごとに検証します。
+func TestUpdateOutputUsecase_UpdateOutput_Status(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputUsecaseTestSuccessInfo.Message())
+
+	title := "Go応用"
+
+	tests := map[string]struct {
+		status  value_obj.Status
+		wantErr error
+	}{
+		"draft":     {status: value_obj.Draft},
+		"in_review": {status: value_obj.InReview, wantErr: value_obj.OutputNotEditableError},
+		"published": {status: value_obj.Published, wantErr: value_obj.OutputNotEditableError},
+		"archived":  {status: value_obj.Archived, wantErr: value_obj.OutputNotEditableError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("UpdateOutputUsecase ステータス別テストケース開始: %s", name)
+
+			repoMock := newTestOutputRepository(
+				&entity.Output{ID: "output-1", UserID: "user-1", Title: "Go入門", Type: "other", Status: string(tt.status)},
+			)
+
+			// admin 権限以上でも下書き以外は編集できない
+			for _, ctx := range []context.Context{
+				withPrincipal("user-1", userValueObj.Member),
+				withPrincipal("admin-1", userValueObj.Admin),
+			} {
+				_, err := NewUpdateOutputUsecase(repoMock).UpdateOutput(ctx, outputdto.UpdateOutputCommand{ID: "output-1", Title: &title})
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+			}
+
+			stored := repoMock.outputs["output-1"]
+			if tt.wantErr != nil && stored.Title != "Go入門" {
+				t.Fatalf("title = %q, want unchanged", stored.Title)
+			}
+			if tt.wantErr == nil && stored.Title != title {
+				t.Fatalf("title = %q, want %q", stored.Title, title)
+			}
+			if stored.Status != string(tt.status) {
+				t.Fatalf("status = %q, want %q", stored.Status, tt.status)
+			}
+		})
+	}
+}
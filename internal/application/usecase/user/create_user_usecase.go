@@ -3,9 +3,11 @@ package user
 import (
 	user "app/internal/application/dto/user"
 	"app/internal/application/port"
+	"app/internal/application/tracing"
 	"app/internal/domain/user/entity"
 	"app/internal/domain/user/repository"
 	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
 	"context"
 	"fmt"
 )
@@ -15,72 +17,124 @@ import (
 // このユースケースの責務は次の通りです。
 //   - プレゼンテーション層（ハンドラなど）から受け取った DTO をもとに、
 //     ドメインのバリデーションロジックを呼び出す
-//   - すでに同じメールアドレスのユーザーが存在しないかリポジトリで確認する
 //   - パスワードをドメイン外の PasswordHasher に委譲してハッシュ化する
-//   - ドメインエンティティを生成し、リポジトリを通して永続化する
+//   - ID を IDGenerator で採番し、ドメインエンティティを生成する
+//   - 同じメールアドレスのユーザーが存在しないかの確認と永続化を、1 つのトランザクションで行う
+//   - 登録したメールアドレスに確認メールを送る（確認するまではゲストと同じ権限として扱われる）
 //
 // 逆に、「HTTP の詳細」「DB のテーブル構造」「ハッシュアルゴリズムの実装」などには関与しません。
 type CreateUserUsecase struct {
 	userRepository repository.UserRepository
 	hasher         port.PasswordHasher
+	ids            port.IDGenerator
+	tx             port.TransactionManager
+	policy         *services.PasswordPolicy
+	verification   port.EmailVerificationSender
+	logger         port.Logger
 }
 
 // NewCreateUserUsecase は CreateUserUsecase のコンストラクタです。
-// リポジトリと PasswordHasher はポート（インターフェース）越しに注入されるため、
+// リポジトリ・PasswordHasher・IDGenerator・TransactionManager はポート（インターフェース）越しに注入されるため、
 // インフラ層の具体的な実装に依存しないままユースケースをテストできます。
-func NewCreateUserUsecase(userRepository repository.UserRepository, hasher port.PasswordHasher) *CreateUserUsecase {
-	return &CreateUserUsecase{userRepository: userRepository, hasher: hasher}
+// パスワードポリシーはデプロイ環境ごとの設定から組み立てたものを受け取ります。
+func NewCreateUserUsecase(
+	userRepository repository.UserRepository,
+	hasher port.PasswordHasher,
+	ids port.IDGenerator,
+	tx port.TransactionManager,
+	policy *services.PasswordPolicy,
+	verification port.EmailVerificationSender,
+	logger port.Logger,
+) *CreateUserUsecase {
+	return &CreateUserUsecase{
+		userRepository: userRepository,
+		hasher:         hasher,
+		ids:            ids,
+		tx:             tx,
+		policy:         policy,
+		verification:   verification,
+		logger:         logger,
+	}
 }
 
 // CreateUser はユーザー作成ユースケースのエントリポイントです。
 // 呼び出し元（ハンドラなど）は DTO とコンテキストを渡すだけで、
 // ユースケース内部で以下の一連のフローが実行されます。
 //
-//  1. ドメインサービスによる入力値のバリデーション
-//  2. メールアドレスの重複チェック（UserRepository.ExistsByEmail）
-//  3. パスワードのハッシュ化（PasswordHasher.Hash）
-//  4. ドメインエンティティの生成（entity.NewUser）
-//  5. ユーザーの永続化（UserRepository.CreateUser）
+//  1. ドメインサービスによる入力値のバリデーション（パスワードはパスワードポリシーで検証）
+//  2. パスワードのハッシュ化（PasswordHasher.Hash）
+//  3. ID の採番（IDGenerator.NewID）とドメインエンティティの生成（entity.NewUser）
+//  4. トランザクション内でメールアドレスの重複チェック（UserRepository.ExistsByEmail）と
+//     ユーザーの永続化（UserRepository.CreateUser）
+//  5. 確認メールの送信（EmailVerificationSender.SendVerification）
+//  6. 作成したユーザーを UserResponse として返却
+//
+// ハッシュ化は時間がかかるため、トランザクションの外で先に行います。
+// 同時に同じメールアドレスで登録された場合は、データベースの一意制約によって一方が
+// UserEmailAlreadyExistsError になります。
+// 確認メールの送信に失敗してもユーザーの登録は取り消さず、警告ログを出力します（確認メールは再送できます）。
 //
 // いずれかのステップでエラーが起きた場合は、原因を失わないよう fmt.Errorf(%w) でラップし、
 // 呼び出し側で「どこで失敗したか」を追跡しやすいようにしています。
-func (uc *CreateUserUsecase) CreateUser(ctx context.Context, cmd user.CreateUserCommand) error {
+func (uc *CreateUserUsecase) CreateUser(ctx context.Context, cmd user.CreateUserCommand) (_ *user.UserResponse, err error) {
 
-	// バリデーションチェック
-	if err := services.CreateUserValidation(ctx, cmd.Name, cmd.Email, cmd.Password, cmd.Bio); err != nil {
-		return err
-	}
+	ctx, span := tracing.Start(ctx, "CreateUserUsecase.CreateUser")
+	defer func() { tracing.End(span, err) }()
 
-	// 重複チェック
-	exists, err := uc.userRepository.ExistsByEmail(ctx, cmd.Email)
-	// 重複チェックに失敗した場合
-	if err != nil {
-		return fmt.Errorf("failed to check email duplication: %w", err)
-	}
-	if exists {
-		return fmt.Errorf("email already exists")
+	// バリデーションチェック
+	if err := services.CreateUserValidation(ctx, uc.policy, cmd.Name, cmd.Email, cmd.Password, cmd.Bio); err != nil {
+		return nil, err
 	}
 
 	// パスワードのハッシュ化
-	hashedPassword, err := uc.hasher.Hash(cmd.Password)
+	hashedPassword, err := uc.hasher.Hash(ctx, cmd.Password)
 
 	// パスワードのハッシュ化に失敗した場合
 	if err != nil {
-		return fmt.Errorf("failed to hash password: %w", err)
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// ID の採番
+	id, err := uc.ids.NewID()
+	if err != nil {
+		return nil, err
 	}
 
 	// Entity生成
-	u, err := entity.NewUser(cmd.Name, cmd.Email, hashedPassword, cmd.Bio)
+	u, err := entity.NewUser(id, cmd.Name, cmd.Email, hashedPassword, cmd.Bio)
 	// Entity生成に失敗した場合
 	if err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+
+		// 重複チェック
+		exists, err := uc.userRepository.ExistsByEmail(ctx, cmd.Email)
+		// 重複チェックに失敗した場合
+		if err != nil {
+			return fmt.Errorf("failed to check email duplication: %w", err)
+		}
+		if exists {
+			return value_obj.UserEmailAlreadyExistsError
+		}
+
+		// ユーザー作成
+		if err := uc.userRepository.CreateUser(ctx, u); err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// ユーザー作成
-	if err := uc.userRepository.CreateUser(ctx, u); err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
+	// 確認メールの送信
+	if err := uc.verification.SendVerification(ctx, u.ID, u.Name, u.Email); err != nil {
+		uc.logger.Warn(ctx, "failed to send email verification", "target_user_id", u.ID, "error", err)
 	}
 
-	return nil
+	return user.NewUserResponse(u), nil
 
 }
@@ -6,10 +6,15 @@ import (
 	"app/internal/domain/user/entity"
 	"app/internal/domain/user/value_obj"
 	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/services"
+	testidgen "app/internal/test/idgen"
 	testlogger "app/internal/test/logger"
+	testtx "app/internal/test/tx"
+	testverification "app/internal/test/verification"
 	"context"
 	"errors"
 	"testing"
+	"time"
 )
 
 // testPasswordHasher は PasswordHasher ポートを満たすテスト用の実装です。
@@ -20,18 +25,18 @@ type testPasswordHasher struct {
 	compareFn func(password, hash string) bool
 }
 
-func (m *testPasswordHasher) Hash(password string) (string, error) {
+func (m *testPasswordHasher) Hash(_ context.Context, password string) (string, error) {
 	if m.hashFn != nil {
 		return m.hashFn(password)
 	}
 	return "", errors.New("hashFn not set")
 }
 
-func (m *testPasswordHasher) Compare(password, hash string) bool {
+func (m *testPasswordHasher) Compare(_ context.Context, password, hash string) (bool, bool) {
 	if m.compareFn != nil {
-		return m.compareFn(password, hash)
+		return m.compareFn(password, hash), false
 	}
-	return false
+	return false, false
 }
 
 // testCreateUserRepository は UserRepository を満たすテスト用実装です。
@@ -56,14 +61,46 @@ func (m *testCreateUserRepository) ExistsByEmail(ctx context.Context, email stri
 	return false, nil
 }
 
+func (m *testCreateUserRepository) FindByID(context.Context, string) (*entity.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *testCreateUserRepository) FindByEmail(context.Context, string) (*entity.User, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *testCreateUserRepository) FindByUser(context.Context, string, string, string) (*entity.User, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (m *testCreateUserRepository) ListUsers(context.Context, repo.UserListCriteria) (*repo.UserPage, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *testCreateUserRepository) UpdateUser(context.Context, *entity.User) error {
 	return errors.New("not implemented")
 }
 
+func (m *testCreateUserRepository) UpdatePassword(context.Context, string, string) error {
+	return errors.New("not implemented")
+}
+
+func (m *testCreateUserRepository) MarkEmailVerified(context.Context, string, string, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testCreateUserRepository) EnableTwoFactor(context.Context, string, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testCreateUserRepository) RecordLoginFailure(context.Context, string, time.Time, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testCreateUserRepository) ResetLoginFailures(context.Context, string) error {
+	return errors.New("not implemented")
+}
+
 func (m *testCreateUserRepository) DeleteUser(context.Context, string) error {
 	return errors.New("not implemented")
 }
@@ -89,10 +126,10 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 		logger := testlogger.New(t)
 		logger.Info("CreateUserUsecase バリデーションエラーケース開始")
 
-		uc := NewCreateUserUsecase(&testCreateUserRepository{}, &testPasswordHasher{})
+		uc := NewCreateUserUsecase(&testCreateUserRepository{}, &testPasswordHasher{}, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{}
-		if err := uc.CreateUser(ctx, cmd); err == nil {
+		if _, err := uc.CreateUser(ctx, cmd); err == nil {
 			t.Fatal("expected validation error, got nil")
 		}
 	})
@@ -117,7 +154,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateUserUsecase(repoMock, hasherMock)
+		uc := NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -126,8 +163,8 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			Bio:      "hello",
 		}
 
-		if err := uc.CreateUser(ctx, cmd); err == nil {
-			t.Fatal("expected email exists error, got nil")
+		if _, err := uc.CreateUser(ctx, cmd); !errors.Is(err, value_obj.UserEmailAlreadyExistsError) {
+			t.Fatalf("expected error %v, got %v", value_obj.UserEmailAlreadyExistsError, err)
 		}
 	})
 
@@ -148,7 +185,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			hashFn: func(password string) (string, error) {
 				return "hashed-" + password, nil
 			},
-		})
+		}, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -157,7 +194,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			Bio:      "hello",
 		}
 
-		err := uc.CreateUser(ctx, cmd)
+		_, err := uc.CreateUser(ctx, cmd)
 		if err == nil {
 			t.Fatal("expected error, got nil")
 		}
@@ -184,7 +221,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateUserUsecase(repoMock, hasherMock)
+		uc := NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -193,7 +230,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			Bio:      "hello",
 		}
 
-		err := uc.CreateUser(ctx, cmd)
+		_, err := uc.CreateUser(ctx, cmd)
 		if err == nil {
 			t.Fatal("expected error, got nil")
 		}
@@ -223,7 +260,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateUserUsecase(repoMock, hasherMock)
+		uc := NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), testverification.NewFake(), testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -232,7 +269,7 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			Bio:      "hello",
 		}
 
-		err := uc.CreateUser(ctx, cmd)
+		_, err := uc.CreateUser(ctx, cmd)
 		if err == nil {
 			t.Fatal("expected error, got nil")
 		}
@@ -271,7 +308,9 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateUserUsecase(repoMock, hasherMock)
+		txMock := testtx.NewFake()
+		verification := testverification.NewFake()
+		uc := NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), txMock, services.DefaultPasswordPolicy(), verification, testlogger.NewPortLogger(t))
 
 		cmd := userdto.CreateUserCommand{
 			Name:     "Alice",
@@ -280,7 +319,8 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 			Bio:      "hello",
 		}
 
-		if err := uc.CreateUser(ctx, cmd); err != nil {
+		res, err := uc.CreateUser(ctx, cmd)
+		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 
@@ -293,6 +333,55 @@ func TestCreateUserUsecase_CreateUser(t *testing.T) {
 		if created.Password != "hashed-Password1" {
 			t.Errorf("created.Password = %s, want %s", created.Password, "hashed-Password1")
 		}
+		if created.ID != "user-0001" {
+			t.Errorf("created.ID = %s, want %s", created.ID, "user-0001")
+		}
+		if res.ID != created.ID || res.Email != created.Email {
+			t.Errorf("res = %+v, want id %s and email %s", res, created.ID, created.Email)
+		}
+		if txMock.Calls != 1 || txMock.Rollbacks != 0 {
+			t.Errorf("transaction calls = %d, rollbacks = %d, want 1 and 0", txMock.Calls, txMock.Rollbacks)
+		}
+		if created.IsEmailVerified() || res.EmailVerified {
+			t.Error("created user must not be email verified")
+		}
+		if requests := verification.Requests(); len(requests) != 1 || requests[0].UserID != created.ID || requests[0].Email != created.Email {
+			t.Errorf("verification requests = %+v, want 1 request for %s", requests, created.ID)
+		}
+	})
+
+	t.Run("verification error", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("CreateUserUsecase 確認メール送信エラーケース開始")
+
+		repoMock := &testCreateUserRepository{
+			existsByEmailFn: func(_ context.Context, _ string) (bool, error) {
+				return false, nil
+			},
+			createUserFn: func(_ context.Context, _ *entity.User) error {
+				return nil
+			},
+		}
+
+		// 確認メールの送信に失敗しても、ユーザーの登録は成功する（確認メールは再送できる）
+		verification := testverification.NewFake()
+		verification.Err = errors.New("smtp error")
+		hasherMock := &testPasswordHasher{
+			hashFn: func(password string) (string, error) {
+				return "hashed-" + password, nil
+			},
+		}
+		uc := NewCreateUserUsecase(repoMock, hasherMock, testidgen.NewFake("user"), testtx.NewFake(), services.DefaultPasswordPolicy(), verification, testlogger.NewPortLogger(t))
+
+		res, err := uc.CreateUser(ctx, userdto.CreateUserCommand{Name: "Alice", Email: "alice@example.com", Password: "Password1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.ID != "user-0001" {
+			t.Errorf("res.ID = %s, want %s", res.ID, "user-0001")
+		}
 	})
 }
 
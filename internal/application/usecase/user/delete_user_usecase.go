@@ -0,0 +1,52 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DeleteUserUsecase は「ユーザーを論理削除する」というアプリケーションユースケースを表します。
+//
+// 削除は root 権限のみ使用可能です。存在しない・削除済みのユーザーを指定した場合は
+// UserNotFoundError を返し、呼び出し側で 404 として扱えるようにしています。
+type DeleteUserUsecase struct {
+	userRepository repository.UserRepository
+}
+
+// NewDeleteUserUsecase は DeleteUserUsecase のコンストラクタです。
+func NewDeleteUserUsecase(userRepository repository.UserRepository) *DeleteUserUsecase {
+	return &DeleteUserUsecase{userRepository: userRepository}
+}
+
+// DeleteUser はユーザー削除ユースケースのエントリポイントです。
+func (uc *DeleteUserUsecase) DeleteUser(ctx context.Context, cmd userdto.DeleteUserCommand) (err error) {
+
+	ctx, span := tracing.Start(ctx, "DeleteUserUsecase.DeleteUser")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック(root権限のみ使用可能)
+	if _, err := policy.Authorize(ctx, value_obj.Root); err != nil {
+		return err
+	}
+
+	// 存在チェック
+	if _, err := uc.userRepository.FindByID(ctx, cmd.ID); err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return err
+		}
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// ユーザー削除
+	if err := uc.userRepository.DeleteUser(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestDeleteUserUsecase_DeleteUser はユーザー削除が root 権限のみに許可され、
+// 存在しないユーザーには UserNotFoundError を返すことを検証します。
+func TestDeleteUserUsecase_DeleteUser(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	asAdmin := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "admin-1", Role: value_obj.Admin})
+	asRoot := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "root-1", Role: value_obj.Root})
+
+	newRepo := func(deleted *string) *testUserRepository {
+		return &testUserRepository{
+			findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+				if id != "user-1" {
+					return nil, value_obj.UserNotFoundError
+				}
+				return &entity.User{ID: id}, nil
+			},
+			deleteUserFn: func(_ context.Context, id string) error {
+				*deleted = id
+				return nil
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		ctx         context.Context
+		id          string
+		wantErr     error
+		wantDeleted string
+	}{
+		"admin is forbidden": {ctx: asAdmin, id: "user-1", wantErr: value_obj.UserForbiddenError},
+		"not found":          {ctx: asRoot, id: "missing", wantErr: value_obj.UserNotFoundError},
+		"root deletes user":  {ctx: asRoot, id: "user-1", wantDeleted: "user-1"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("DeleteUserUsecase テストケース開始: %s", name)
+
+			var deleted string
+			err := NewDeleteUserUsecase(newRepo(&deleted)).DeleteUser(tt.ctx, userdto.DeleteUserCommand{ID: tt.id})
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deleted != tt.wantDeleted {
+				t.Fatalf("deleted = %q, want %q", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GetUserUsecase は「ID を指定してユーザーを 1 件取得する」というアプリケーションユースケースを表します。
+//
+// 本人は自分自身の情報を参照でき、他人の情報は root 権限を持つ操作者のみ参照できます。
+// 返却値はパスワードハッシュを含まない UserResponse に変換してから返します。
+type GetUserUsecase struct {
+	userRepository repository.UserRepository
+}
+
+// NewGetUserUsecase は GetUserUsecase のコンストラクタです。
+func NewGetUserUsecase(userRepository repository.UserRepository) *GetUserUsecase {
+	return &GetUserUsecase{userRepository: userRepository}
+}
+
+// GetUser はユーザー取得ユースケースのエントリポイントです。
+//
+//  1. 本人または root 権限であるか確認（policy.AuthorizeSelfOr）
+//  2. リポジトリの FindByID でユーザーを取得
+//  3. UserResponse に変換して返却
+func (uc *GetUserUsecase) GetUser(ctx context.Context, query userdto.GetUserQuery) (_ *userdto.UserResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "GetUserUsecase.GetUser")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック
+	if _, err := policy.AuthorizeSelfOr(ctx, query.ID, value_obj.Root); err != nil {
+		return nil, err
+	}
+
+	u, err := uc.userRepository.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return userdto.NewUserResponse(u), nil
+}
@@ -0,0 +1,169 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestGetUserUsecase_GetUser はユーザー取得ユースケースの権限チェックとレスポンス変換を検証します。
+func TestGetUserUsecase_GetUser(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	repoMock := &testUserRepository{
+		findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+			if id != "user-1" {
+				return nil, value_obj.UserNotFoundError
+			}
+			return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed"}, nil
+		},
+	}
+
+	tests := map[string]struct {
+		principal *policy.Principal
+		id        string
+		wantErr   error
+	}{
+		"unauthenticated": {
+			id:      "user-1",
+			wantErr: value_obj.UserUnauthenticatedError,
+		},
+		"other member is forbidden": {
+			principal: &policy.Principal{UserID: "user-2", Role: value_obj.Member},
+			id:        "user-1",
+			wantErr:   value_obj.UserForbiddenError,
+		},
+		"self": {
+			principal: &policy.Principal{UserID: "user-1", Role: value_obj.Member},
+			id:        "user-1",
+		},
+		"root": {
+			principal: &policy.Principal{UserID: "root-1", Role: value_obj.Root},
+			id:        "user-1",
+		},
+		"not found": {
+			principal: &policy.Principal{UserID: "root-1", Role: value_obj.Root},
+			id:        "missing",
+			wantErr:   value_obj.UserNotFoundError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("GetUserUsecase テストケース開始: %s", name)
+
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = policy.WithPrincipal(ctx, *tt.principal)
+			}
+
+			res, err := NewGetUserUsecase(repoMock).GetUser(ctx, userdto.GetUserQuery{ID: tt.id})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.ID != "user-1" || res.Email != "alice@example.com" {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+		})
+	}
+}
+
+// testUserRepository は GetUser / ListUsers / UpdateUser / DeleteUser 用のテストリポジトリです。
+// 各ケースで必要なメソッドの振る舞いだけを関数で差し替えて利用します。
+type testUserRepository struct {
+	findByIDFn      func(ctx context.Context, id string) (*entity.User, error)
+	existsByEmailFn func(ctx context.Context, email string) (bool, error)
+	listUsersFn     func(ctx context.Context, criteria repo.UserListCriteria) (*repo.UserPage, error)
+	updateUserFn    func(ctx context.Context, u *entity.User) error
+	deleteUserFn    func(ctx context.Context, id string) error
+}
+
+func (m *testUserRepository) CreateUser(context.Context, *entity.User) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	if m.existsByEmailFn != nil {
+		return m.existsByEmailFn(ctx, email)
+	}
+	return false, errors.New("not implemented")
+}
+
+func (m *testUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	if m.findByIDFn != nil {
+		return m.findByIDFn(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) FindByEmail(context.Context, string) (*entity.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) FindByUser(context.Context, string, string, string) (*entity.User, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) ListUsers(ctx context.Context, criteria repo.UserListCriteria) (*repo.UserPage, error) {
+	if m.listUsersFn != nil {
+		return m.listUsersFn(ctx, criteria)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *testUserRepository) UpdateUser(ctx context.Context, u *entity.User) error {
+	if m.updateUserFn != nil {
+		return m.updateUserFn(ctx, u)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) UpdatePassword(context.Context, string, string) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) MarkEmailVerified(context.Context, string, string, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) EnableTwoFactor(context.Context, string, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) RecordLoginFailure(context.Context, string, time.Time, time.Time) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) ResetLoginFailures(context.Context, string) error {
+	return errors.New("not implemented")
+}
+
+func (m *testUserRepository) DeleteUser(ctx context.Context, id string) error {
+	if m.deleteUserFn != nil {
+		return m.deleteUserFn(ctx, id)
+	}
+	return errors.New("not implemented")
+}
+
+var _ repo.UserRepository = (*testUserRepository)(nil)
@@ -0,0 +1,155 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ListUsersUsecase は「条件に一致するユーザーをページ単位で一覧取得する」というアプリケーションユースケースです。
+//
+// 管理画面の検索パネルから利用されることを想定し、admin 権限以上の操作者のみ使用できます。
+// クエリ DTO をリポジトリの検索条件（UserListCriteria）に変換し、既定値の補完と
+// ドメインバリデーションを行ってから UserRepository.ListUsers に委譲します。
+type ListUsersUsecase struct {
+	userRepository repository.UserRepository
+}
+
+// NewListUsersUsecase は ListUsersUsecase のコンストラクタです。
+func NewListUsersUsecase(userRepository repository.UserRepository) *ListUsersUsecase {
+	return &ListUsersUsecase{userRepository: userRepository}
+}
+
+// ListUsers はユーザー一覧取得ユースケースのエントリポイントです。
+//
+//  1. 操作者が admin 権限以上か確認
+//  2. クエリ DTO を検索条件に変換（日時のパース、並び順・取得件数の既定値補完）
+//  3. ドメインサービス ListUsersValidation で検索条件を検証
+//  4. リポジトリで一覧と総件数を取得し、レスポンスエンベロープに詰め替えて返却
+func (uc *ListUsersUsecase) ListUsers(ctx context.Context, query userdto.ListUsersQuery) (_ *userdto.UserListResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "ListUsersUsecase.ListUsers")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック(admin権限以上で使用可能)
+	if _, err := policy.Authorize(ctx, value_obj.Admin); err != nil {
+		return nil, err
+	}
+
+	// 検索条件への変換
+	criteria, err := toUserListCriteria(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// バリデーションチェック
+	if err := services.ListUsersValidation(ctx, criteria); err != nil {
+		return nil, err
+	}
+
+	// 一覧取得
+	page, err := uc.userRepository.ListUsers(ctx, criteria)
+	if err != nil {
+		if errors.Is(err, value_obj.UserListCursorInvalidError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	items := make([]*userdto.UserResponse, 0, len(page.Users))
+	for _, u := range page.Users {
+		items = append(items, userdto.NewUserResponse(u))
+	}
+
+	return &userdto.UserListResponse{
+		Items: items,
+		Pagination: userdto.Pagination{
+			Total:      page.Total,
+			Limit:      criteria.Limit,
+			Offset:     criteria.Offset,
+			NextCursor: page.NextCursor,
+			HasNext:    page.NextCursor != "",
+		},
+	}, nil
+}
+
+// toUserListCriteria はクエリ DTO をリポジトリの検索条件に変換します。
+// 並び替え項目の既定値は作成日時の降順（新しい順）、取得件数の既定値は DefaultUserListLimit です。
+func toUserListCriteria(query userdto.ListUsersQuery) (repository.UserListCriteria, error) {
+
+	criteria := repository.UserListCriteria{
+		Name:                 strings.TrimSpace(query.Name),
+		Email:                strings.TrimSpace(query.Email),
+		Role:                 query.Role,
+		SkillLevel:           query.SkillLevel,
+		MinYearsOfExperience: query.MinYears,
+		MaxYearsOfExperience: query.MaxYears,
+		IncludeDeleted:       query.IncludeDeleted,
+		SortField:            repository.UserSortField(query.Sort),
+		Limit:                query.Limit,
+		Offset:               query.Offset,
+		Cursor:               query.Cursor,
+	}
+
+	// 並び順の既定値補完
+	if criteria.SortField == "" {
+		criteria.SortField = repository.UserSortByCreatedAt
+		criteria.SortDesc = true
+	}
+	switch strings.ToLower(query.Order) {
+	case "":
+	case "asc":
+		criteria.SortDesc = false
+	case "desc":
+		criteria.SortDesc = true
+	default:
+		return criteria, value_obj.UserListSortInvalidError
+	}
+
+	// 取得件数の既定値補完
+	if criteria.Limit == 0 {
+		criteria.Limit = services.DefaultUserListLimit
+	}
+
+	// 作成日時の範囲
+	// 日付のみ指定された場合、上限はその日の終わりまでを含める
+	if query.CreatedFrom != "" {
+		from, _, err := parseListDate(query.CreatedFrom)
+		if err != nil {
+			return criteria, err
+		}
+		criteria.CreatedFrom = &from
+	}
+	if query.CreatedTo != "" {
+		to, dateOnly, err := parseListDate(query.CreatedTo)
+		if err != nil {
+			return criteria, err
+		}
+		if dateOnly {
+			to = to.Add(24*time.Hour - time.Nanosecond)
+		}
+		criteria.CreatedTo = &to
+	}
+
+	return criteria, nil
+}
+
+// parseListDate は YYYY-MM-DD または RFC3339 形式の日時をパースします。
+// 2 番目の戻り値は日付のみの形式だったかどうかを表します。
+func parseListDate(s string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, value_obj.UserListDateFormatError
+}
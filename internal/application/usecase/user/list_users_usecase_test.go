@@ -0,0 +1,114 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	repo "app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestListUsersUsecase_ListUsers はユーザー一覧ユースケースの権限チェック・検索条件への変換・
+// レスポンスエンベロープへの詰め替えを検証します。
+func TestListUsersUsecase_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	asMember := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-1", Role: value_obj.Member})
+	asAdmin := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "admin-1", Role: value_obj.Admin})
+
+	tests := map[string]struct {
+		ctx     context.Context
+		query   userdto.ListUsersQuery
+		wantErr error
+	}{
+		"member is forbidden":  {ctx: asMember, wantErr: value_obj.UserForbiddenError},
+		"invalid sort":         {ctx: asAdmin, query: userdto.ListUsersQuery{Sort: "password"}, wantErr: value_obj.UserListSortInvalidError},
+		"invalid order":        {ctx: asAdmin, query: userdto.ListUsersQuery{Order: "sideways"}, wantErr: value_obj.UserListSortInvalidError},
+		"limit too large":      {ctx: asAdmin, query: userdto.ListUsersQuery{Limit: 1000}, wantErr: value_obj.UserListLimitRangeError},
+		"cursor with offset":   {ctx: asAdmin, query: userdto.ListUsersQuery{Cursor: "abc", Offset: 10}, wantErr: value_obj.UserListPagingConflictError},
+		"invalid date":         {ctx: asAdmin, query: userdto.ListUsersQuery{CreatedFrom: "yesterday"}, wantErr: value_obj.UserListDateFormatError},
+		"reversed date range":  {ctx: asAdmin, query: userdto.ListUsersQuery{CreatedFrom: "2025-02-01", CreatedTo: "2025-01-01"}, wantErr: value_obj.UserListRangeError},
+		"invalid cursor value": {ctx: asAdmin, query: userdto.ListUsersQuery{Cursor: "broken"}, wantErr: value_obj.UserListCursorInvalidError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ListUsersUsecase エラーケース開始: %s", name)
+
+			repoMock := &testUserRepository{
+				listUsersFn: func(_ context.Context, c repo.UserListCriteria) (*repo.UserPage, error) {
+					if c.Cursor != "" {
+						return nil, value_obj.UserListCursorInvalidError
+					}
+					return &repo.UserPage{}, nil
+				},
+			}
+
+			_, err := NewListUsersUsecase(repoMock).ListUsers(tt.ctx, tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("defaults and envelope", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("ListUsersUsecase 正常系ケース開始")
+
+		var got repo.UserListCriteria
+		repoMock := &testUserRepository{
+			listUsersFn: func(_ context.Context, c repo.UserListCriteria) (*repo.UserPage, error) {
+				got = c
+				return &repo.UserPage{
+					Users:      []*entity.User{{ID: "user-1", Name: "Alice", Password: "hashed"}},
+					Total:      42,
+					NextCursor: "next",
+				}, nil
+			},
+		}
+
+		res, err := NewListUsersUsecase(repoMock).ListUsers(asAdmin, userdto.ListUsersQuery{
+			Name:      " ali ",
+			CreatedTo: "2025-01-31",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.SortField != repo.UserSortByCreatedAt || !got.SortDesc {
+			t.Errorf("sort = %s desc=%v, want created_at desc", got.SortField, got.SortDesc)
+		}
+		if got.Name != "ali" {
+			t.Errorf("Name = %q, want trimmed %q", got.Name, "ali")
+		}
+		if got.Limit != 20 {
+			t.Errorf("Limit = %d, want default 20", got.Limit)
+		}
+		wantTo := time.Date(2025, 1, 31, 23, 59, 59, 999999999, time.UTC)
+		if got.CreatedTo == nil || !got.CreatedTo.Equal(wantTo) {
+			t.Errorf("CreatedTo = %v, want end of day %v", got.CreatedTo, wantTo)
+		}
+
+		if len(res.Items) != 1 || res.Items[0].ID != "user-1" {
+			t.Fatalf("unexpected items: %+v", res.Items)
+		}
+		if res.Pagination.Total != 42 || res.Pagination.NextCursor != "next" || !res.Pagination.HasNext {
+			t.Fatalf("unexpected pagination: %+v", res.Pagination)
+		}
+	})
+}
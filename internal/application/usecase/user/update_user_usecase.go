@@ -0,0 +1,125 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/application/port"
+	"app/internal/application/tracing"
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/services"
+	"app/internal/domain/user/value_obj"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// UpdateUserUsecase は「既存ユーザーの情報を部分更新する」というアプリケーションユースケースを表します。
+//
+// 本人は自分のプロフィールを更新でき、他人の情報の更新と権限（role）の変更は root 権限のみ許可します。
+// 更新は「取得 → 指定された項目のみ上書き → ドメインバリデーション → 保存」の順で行い、
+// 指定されなかった項目は既存の値を維持します。
+// メールアドレスを変更した場合は確認済みの状態を解除し、新しいメールアドレスに確認メールを送ります。
+type UpdateUserUsecase struct {
+	userRepository repository.UserRepository
+	verification   port.EmailVerificationSender
+	logger         port.Logger
+}
+
+// NewUpdateUserUsecase は UpdateUserUsecase のコンストラクタです。
+func NewUpdateUserUsecase(userRepository repository.UserRepository, verification port.EmailVerificationSender, logger port.Logger) *UpdateUserUsecase {
+	return &UpdateUserUsecase{userRepository: userRepository, verification: verification, logger: logger}
+}
+
+// UpdateUser はユーザー更新ユースケースのエントリポイントです。
+//
+//  1. 本人または root 権限であるか確認（権限変更は root のみ）
+//  2. 更新項目が 1 つ以上指定されているか確認
+//  3. 既存ユーザーを取得し、指定された項目のみ上書き
+//  4. メールアドレスを変更する場合は重複チェック
+//  5. ドメインサービス UpdateUserValidation で更新後の値を検証
+//  6. リポジトリの UpdateUser で保存し、UserResponse を返却
+//  7. メールアドレスを変更した場合は確認メールを送信（失敗しても更新は取り消さず、警告ログを出力）
+func (uc *UpdateUserUsecase) UpdateUser(ctx context.Context, cmd userdto.UpdateUserCommand) (_ *userdto.UserResponse, err error) {
+
+	ctx, span := tracing.Start(ctx, "UpdateUserUsecase.UpdateUser")
+	defer func() { tracing.End(span, err) }()
+
+	// 権限チェック
+	actor, err := policy.AuthorizeSelfOr(ctx, cmd.ID, value_obj.Root)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Role != nil {
+		if err := services.AuthorizeRole(actor.Role, value_obj.Root); err != nil {
+			return nil, err
+		}
+	}
+
+	// 更新項目のチェック
+	if cmd.Name == nil && cmd.Email == nil && cmd.Bio == nil && cmd.Role == nil &&
+		cmd.SkillLevel == nil && cmd.YearsOfExperience == nil {
+		return nil, value_obj.UserUpdateRequiredError
+	}
+
+	// 既存ユーザーの取得
+	u, err := uc.userRepository.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, value_obj.UserNotFoundError) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// メールアドレス変更時の重複チェック
+	emailChanged := cmd.Email != nil && *cmd.Email != u.Email
+	if emailChanged {
+		exists, err := uc.userRepository.ExistsByEmail(ctx, *cmd.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check email duplication: %w", err)
+		}
+		if exists {
+			return nil, value_obj.UserEmailAlreadyExistsError
+		}
+	}
+
+	// 指定された項目のみ上書き
+	if cmd.Name != nil {
+		u.Name = *cmd.Name
+	}
+	if cmd.Email != nil {
+		u.ChangeEmail(*cmd.Email)
+	}
+	if cmd.Bio != nil {
+		u.Bio = *cmd.Bio
+	}
+	if cmd.Role != nil {
+		u.Role = *cmd.Role
+	}
+	if cmd.SkillLevel != nil {
+		u.SkillLevel = *cmd.SkillLevel
+	}
+	if cmd.YearsOfExperience != nil {
+		u.YearsOfExperience = *cmd.YearsOfExperience
+	}
+
+	// バリデーションチェック
+	if err := services.UpdateUserValidation(ctx, u.Name, u.Email, u.Bio, u.Role, u.YearsOfExperience); err != nil {
+		return nil, err
+	}
+
+	// ユーザー更新
+	u.UpdatedAt = time.Now()
+	if err := uc.userRepository.UpdateUser(ctx, u); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// メールアドレス変更時の確認メールの送信
+	if emailChanged {
+		if err := uc.verification.SendVerification(ctx, u.ID, u.Name, u.Email); err != nil {
+			uc.logger.Warn(ctx, "failed to send email verification", "target_user_id", u.ID, "error", err)
+		}
+	}
+
+	return userdto.NewUserResponse(u), nil
+}
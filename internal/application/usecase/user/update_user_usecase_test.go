@@ -0,0 +1,194 @@
+package user
+
+import (
+	userdto "app/internal/application/dto/user"
+	"app/internal/application/policy"
+	"app/internal/domain/user/entity"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+	testverification "app/internal/test/verification"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestUpdateUserUsecase_UpdateUser はユーザー更新ユースケースの権限・バリデーション・部分更新を検証します。
+//
+// 本人による更新、他人による更新、権限（role）の変更、メールアドレスの重複など、
+// 「誰が・何を」更新できるのかのルールを表形式で一望できるようにしています。
+func TestUpdateUserUsecase_UpdateUser(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserUsecaseTestStartInfo.Message())
+	defer logger.Info(value_obj.UserUsecaseTestSuccessInfo.Message())
+
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+
+	asMember := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-1", Role: value_obj.Member})
+	asOtherMember := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "user-2", Role: value_obj.Member})
+	asRoot := policy.WithPrincipal(context.Background(), policy.Principal{UserID: "root-1", Role: value_obj.Root})
+
+	tests := map[string]struct {
+		ctx     context.Context
+		cmd     userdto.UpdateUserCommand
+		wantErr error
+	}{
+		"unauthenticated": {
+			ctx:     context.Background(),
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Name: str("Bob")},
+			wantErr: value_obj.UserUnauthenticatedError,
+		},
+		"other member is forbidden": {
+			ctx:     asOtherMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Name: str("Bob")},
+			wantErr: value_obj.UserForbiddenError,
+		},
+		"self cannot change role": {
+			ctx:     asMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Role: str("root")},
+			wantErr: value_obj.UserForbiddenError,
+		},
+		"no fields": {
+			ctx:     asMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1"},
+			wantErr: value_obj.UserUpdateRequiredError,
+		},
+		"not found": {
+			ctx:     asRoot,
+			cmd:     userdto.UpdateUserCommand{ID: "missing", Name: str("Bob")},
+			wantErr: value_obj.UserNotFoundError,
+		},
+		"duplicate email": {
+			ctx:     asMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Email: str("taken@example.com")},
+			wantErr: value_obj.UserEmailAlreadyExistsError,
+		},
+		"empty name": {
+			ctx:     asMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Name: str("")},
+			wantErr: value_obj.UserRequiredError,
+		},
+		"invalid role": {
+			ctx:     asRoot,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", Role: str("superuser")},
+			wantErr: value_obj.UserRoleInvalidError,
+		},
+		"years out of range": {
+			ctx:     asMember,
+			cmd:     userdto.UpdateUserCommand{ID: "user-1", YearsOfExperience: num(-1)},
+			wantErr: value_obj.UserYearsOfExperienceRangeError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("UpdateUserUsecase エラーケース開始: %s", name)
+
+			repoMock := &testUserRepository{
+				findByIDFn: func(_ context.Context, id string) (*entity.User, error) {
+					if id != "user-1" {
+						return nil, value_obj.UserNotFoundError
+					}
+					return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Role: "member"}, nil
+				},
+				existsByEmailFn: func(_ context.Context, email string) (bool, error) {
+					return email == "taken@example.com", nil
+				},
+				updateUserFn: func(context.Context, *entity.User) error {
+					t.Fatal("UpdateUser must not be called")
+					return nil
+				},
+			}
+
+			_, err := NewUpdateUserUsecase(repoMock, testverification.NewFake(), testlogger.NewPortLogger(t)).UpdateUser(tt.ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("UpdateUserUsecase 正常系ケース開始")
+
+		var saved *entity.User
+		repoMock := &testUserRepository{
+			findByIDFn: func(context.Context, string) (*entity.User, error) {
+				return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed", Role: "member", Bio: "hello"}, nil
+			},
+			updateUserFn: func(_ context.Context, u *entity.User) error {
+				saved = u
+				return nil
+			},
+		}
+
+		res, err := NewUpdateUserUsecase(repoMock, testverification.NewFake(), testlogger.NewPortLogger(t)).UpdateUser(asRoot, userdto.UpdateUserCommand{
+			ID:                "user-1",
+			Bio:               str(""),
+			Role:              str("admin"),
+			YearsOfExperience: num(3),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if saved.Name != "Alice" {
+			t.Errorf("Name = %q, want unchanged %q", saved.Name, "Alice")
+		}
+		if saved.Bio != "" {
+			t.Errorf("Bio = %q, want cleared", saved.Bio)
+		}
+		if saved.Role != "admin" || res.Role != "admin" {
+			t.Errorf("Role = %q / %q, want %q", saved.Role, res.Role, "admin")
+		}
+		if res.YearsOfExperience != 3 {
+			t.Errorf("YearsOfExperience = %d, want %d", res.YearsOfExperience, 3)
+		}
+	})
+	t.Run("email change", func(t *testing.T) {
+		t.Parallel()
+
+		logger := testlogger.New(t)
+		logger.Info("UpdateUserUsecase メールアドレス変更ケース開始")
+
+		verifiedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+		var saved *entity.User
+		repoMock := &testUserRepository{
+			findByIDFn: func(context.Context, string) (*entity.User, error) {
+				return &entity.User{ID: "user-1", Name: "Alice", Email: "alice@example.com", Password: "hashed", Role: "member", EmailVerifiedAt: &verifiedAt}, nil
+			},
+			existsByEmailFn: func(context.Context, string) (bool, error) {
+				return false, nil
+			},
+			updateUserFn: func(_ context.Context, u *entity.User) error {
+				saved = u
+				return nil
+			},
+		}
+
+		// 変更後のメールアドレスは未確認に戻り、確認メールを送る
+		verification := testverification.NewFake()
+		res, err := NewUpdateUserUsecase(repoMock, verification, testlogger.NewPortLogger(t)).UpdateUser(asRoot, userdto.UpdateUserCommand{
+			ID:    "user-1",
+			Email: str("alice.new@example.com"),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.IsEmailVerified() || res.EmailVerified {
+			t.Error("changed email must not be verified")
+		}
+		if requests := verification.Requests(); len(requests) != 1 || requests[0].Email != "alice.new@example.com" {
+			t.Errorf("verification requests = %+v, want 1 request for %s", requests, "alice.new@example.com")
+		}
+	})
+}
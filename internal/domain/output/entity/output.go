@@ -3,25 +3,40 @@ package entity
 import (
 	"errors"
 	"time"
+
+	"app/internal/domain/output/value_obj"
 )
 
 // Output Entity
 type Output struct {
-	ID          string    `json:"id"`
-	UserID      string    `json:"user_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	URL         string    `json:"url"`
-	Type        string    `json:"type"`
-	Status      string    `json:"status"`
-	DeleteFlag  bool      `json:"delete_flag"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+
+	// 種別ごとの付加情報(登壇の開催日、読書メモの ISBN)
+	EventDate *time.Time `json:"event_date"`
+	ISBN      string     `json:"isbn"`
+
+	// 最後にステータスを変更した操作者と日時(作成直後は未設定)
+	StatusChangedBy string     `json:"status_changed_by"`
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+
+	DeleteFlag bool      `json:"delete_flag"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 // NewOutput コンストラクタ
-func NewOutput(userID, title, description, url, outputType string) (*Output, error) {
+// ID は呼び出し側で採番した値（port.IDGenerator）を受け取ります。
+func NewOutput(id, userID, title, description, url, outputType string) (*Output, error) {
 	// 必須入力チェック（不変的チェック）
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
 	if userID == "" {
 		return nil, errors.New("user_id is required")
 	}
@@ -31,13 +46,31 @@ func NewOutput(userID, title, description, url, outputType string) (*Output, err
 
 	// Entity生成
 	return &Output{
+		ID:          id,
 		UserID:      userID,
 		Title:       title,
 		Description: description,
 		URL:         url,
 		Type:        outputType,
-		Status:      "draft", // デフォルトは下書き
+		Status:      string(value_obj.Draft), // デフォルトは下書き
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}, nil
 }
+
+// ApplyStatusAction はステータスの遷移表に従って操作を適用し、変更者と変更日時を記録します。
+// 遷移できない場合はエンティティを変更せずにエラーを返します。
+func (o *Output) ApplyStatusAction(action value_obj.StatusAction, changedBy string, at time.Time) error {
+
+	next, err := value_obj.Status(o.Status).Apply(action)
+	if err != nil {
+		return err
+	}
+
+	o.Status = string(next)
+	o.StatusChangedBy = changedBy
+	o.StatusChangedAt = &at
+	o.UpdatedAt = at
+
+	return nil
+}
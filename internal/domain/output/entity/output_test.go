@@ -1,7 +1,9 @@
 package entity
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"app/internal/domain/output/value_obj"
 	testlogger "app/internal/test/logger"
@@ -20,17 +22,22 @@ func TestNewOutput_Success(t *testing.T) {
 	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
 	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
 
+	id := "01890a5d-ac96-774b-bcce-b302099a8057"
 	userID := "user-123"
 	title := "テストアウトプット"
 	description := "これはテスト用のアウトプットです"
 	url := "https://example.com/output"
 	outputType := "blog"
 
-	o, err := NewOutput(userID, title, description, url, outputType)
+	o, err := NewOutput(id, userID, title, description, url, outputType)
 	if err != nil {
 		t.Fatalf("NewOutput() unexpected error: %v", err)
 	}
 
+	if o.ID != id {
+		t.Errorf("ID = %q, want %q", o.ID, id)
+	}
+
 	if o.UserID != userID {
 		t.Errorf("UserID = %q, want %q", o.UserID, userID)
 	}
@@ -59,13 +66,14 @@ func TestNewOutput_Success(t *testing.T) {
 
 // TestNewOutput_RequiredFields は必須項目が欠けている場合にエラーとなることを検証します。
 //
-// user_id / title のいずれかが空文字の場合に、想定しているエラーメッセージが返るかをテーブル形式で確認します。
+// id / user_id / title のいずれかが空文字の場合に、想定しているエラーメッセージが返るかをテーブル形式で確認します。
 // これにより、NewOutput の入力チェック仕様を変更した場合でも、
 // どのパターンでどんなエラーになるべきかをテストから素早く思い出せるようにしています。
 func TestNewOutput_RequiredFields(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		id            string
 		userID        string
 		title         string
 		description   string
@@ -73,7 +81,17 @@ func TestNewOutput_RequiredFields(t *testing.T) {
 		outputType    string
 		wantErrSubstr string
 	}{
+		"empty id": {
+			id:            "",
+			userID:        "user-123",
+			title:         "テストアウトプット",
+			description:   "これはテスト用のアウトプットです",
+			url:           "https://example.com/output",
+			outputType:    "blog",
+			wantErrSubstr: "id is required",
+		},
 		"empty user_id": {
+			id:            "output-1",
 			userID:        "",
 			title:         "テストアウトプット",
 			description:   "これはテスト用のアウトプットです",
@@ -82,6 +100,7 @@ func TestNewOutput_RequiredFields(t *testing.T) {
 			wantErrSubstr: "user_id is required",
 		},
 		"empty title": {
+			id:            "output-1",
 			userID:        "user-123",
 			title:         "",
 			description:   "これはテスト用のアウトプットです",
@@ -99,7 +118,7 @@ func TestNewOutput_RequiredFields(t *testing.T) {
 			logger := testlogger.New(t)
 			logger.Info("NewOutput の必須項目テストケース開始: %s", name)
 
-			_, err := NewOutput(tt.userID, tt.title, tt.description, tt.url, tt.outputType)
+			_, err := NewOutput(tt.id, tt.userID, tt.title, tt.description, tt.url, tt.outputType)
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
@@ -109,3 +128,73 @@ func TestNewOutput_RequiredFields(t *testing.T) {
 		})
 	}
 }
+
+// TestOutput_ApplyStatusAction はステータスの遷移表を表形式で検証します。
+//
+// 許可された遷移では遷移先・変更者・変更日時が記録され、
+// 許可されていない遷移ではエラーとなりエンティティが変更されないことを確認します。
+func TestOutput_ApplyStatusAction(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
+
+	at := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		from    value_obj.Status
+		action  value_obj.StatusAction
+		want    value_obj.Status
+		wantErr error
+	}{
+		"submit draft":          {from: value_obj.Draft, action: value_obj.Submit, want: value_obj.InReview},
+		"reject in_review":      {from: value_obj.InReview, action: value_obj.Reject, want: value_obj.Draft},
+		"publish in_review":     {from: value_obj.InReview, action: value_obj.Publish, want: value_obj.Published},
+		"unpublish published":   {from: value_obj.Published, action: value_obj.Unpublish, want: value_obj.Draft},
+		"archive published":     {from: value_obj.Published, action: value_obj.Archive, want: value_obj.Archived},
+		"restore archived":      {from: value_obj.Archived, action: value_obj.Restore, want: value_obj.Draft},
+		"publish draft":         {from: value_obj.Draft, action: value_obj.Publish, wantErr: value_obj.OutputStatusTransitionError},
+		"archive in_review":     {from: value_obj.InReview, action: value_obj.Archive, wantErr: value_obj.OutputStatusTransitionError},
+		"submit published":      {from: value_obj.Published, action: value_obj.Submit, wantErr: value_obj.OutputStatusTransitionError},
+		"restore draft":         {from: value_obj.Draft, action: value_obj.Restore, wantErr: value_obj.OutputStatusTransitionError},
+		"unpublish archived":    {from: value_obj.Archived, action: value_obj.Unpublish, wantErr: value_obj.OutputStatusTransitionError},
+		"unknown action":        {from: value_obj.Draft, action: "delete", wantErr: value_obj.OutputStatusActionInvalidError},
+		"unknown stored status": {from: "deleted", action: value_obj.Submit, wantErr: value_obj.OutputStatusTransitionError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ApplyStatusAction テストケース開始: %s", name)
+
+			o := &Output{ID: "output-1", Status: string(tt.from)}
+
+			err := o.ApplyStatusAction(tt.action, "user-1", at)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if o.Status != string(tt.from) || o.StatusChangedAt != nil {
+					t.Fatalf("output should not change on error: %+v", o)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if o.Status != string(tt.want) {
+				t.Errorf("Status = %q, want %q", o.Status, tt.want)
+			}
+			if o.StatusChangedBy != "user-1" {
+				t.Errorf("StatusChangedBy = %q, want %q", o.StatusChangedBy, "user-1")
+			}
+			if o.StatusChangedAt == nil || !o.StatusChangedAt.Equal(at) || !o.UpdatedAt.Equal(at) {
+				t.Errorf("StatusChangedAt = %v, UpdatedAt = %v, want %v", o.StatusChangedAt, o.UpdatedAt, at)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"app/internal/domain/output/entity"
+	"context"
+)
+
+// Output Entityを扱うRepository
+type OutputRepository interface {
+
+	// アウトプット作成
+	CreateOutput(cxt context.Context, output *entity.Output) error
+
+	// ID指定によるアウトプット取得(論理削除済みは対象外)
+	FindByID(cxt context.Context, id string) (*entity.Output, error)
+
+	// アウトプット一覧取得
+	ListOutputs(cxt context.Context, criteria OutputListCriteria) (*OutputPage, error)
+
+	// アウトプット更新(ステータスは更新しない)
+	// 保存済みのステータスが下書きの場合のみ更新する
+	UpdateOutput(cxt context.Context, output *entity.Output) error
+
+	// ステータス更新
+	// 保存済みのステータスが from と一致する場合のみ更新する
+	UpdateOutputStatus(cxt context.Context, output *entity.Output, from string) error
+
+	// アウトプット削除(論理削除)
+	DeleteOutput(cxt context.Context, id string) error
+}
+
+// アウトプット一覧の検索条件
+// 指定された条件はすべて AND で結合する(空文字の条件は無視)
+type OutputListCriteria struct {
+	UserID string
+	Type   string
+	Status string
+
+	// ページング
+	Limit  int
+	Offset int
+}
+
+// アウトプット一覧の取得結果
+type OutputPage struct {
+	Outputs []*entity.Output
+
+	// 検索条件に一致する総件数(ページングに関係なく数える)
+	Total int64
+}
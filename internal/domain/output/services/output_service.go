@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"unicode/utf8"
+
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/value_obj"
+)
+
+// アウトプットの入力値の上限
+const (
+	MaxOutputTitleLength       = 255
+	MaxOutputDescriptionLength = 1000
+)
+
+// アウトプット一覧の取得件数の既定値と上限
+const (
+	DefaultOutputListLimit = 20
+	MaxOutputListLimit     = 100
+)
+
+// CreateOutputValidation は「アウトプットを新規作成してよい状態か」を判定するためのドメインバリデーションです。
+//
+//   - 必須入力: title が空であればエラー
+//   - タイトル: 255文字を超えていればエラー
+//   - 説明文: 1000文字を超えていればエラー
+//   - URL: 指定されている場合、http/https の絶対 URL でなければエラー
+//
+// 文字数は日本語のタイトルを想定し、バイト数ではなく文字（rune）数で数えます。
+// 違反はすべての項目についてまとめ、項目名付きの *value_obj.ValidationErrors として返します。
+func CreateOutputValidation(ctx context.Context, title string, description string, rawURL string) error {
+
+	v := &value_obj.ValidationErrors{}
+
+	// 必須入力項目のチェック
+	if title == "" {
+		v.Add("title", value_obj.OutputRequiredError)
+	}
+
+	// タイトルの入力数チェック
+	if utf8.RuneCountInString(title) > MaxOutputTitleLength {
+		v.Add("title", value_obj.OutputTitleLengthError)
+	}
+
+	// 説明文の入力数チェック
+	if utf8.RuneCountInString(description) > MaxOutputDescriptionLength {
+		v.Add("description", value_obj.OutputDescriptionLengthError)
+	}
+
+	// URLの形式チェック
+	if rawURL != "" && !IsValidOutputURL(rawURL) {
+		v.Add("url", value_obj.OutputURLFormatError)
+	}
+
+	return v.Err()
+}
+
+// UpdateOutputValidation は部分更新の結果として得られる最終的な値に対して、作成時と同じルールをチェックします。
+func UpdateOutputValidation(ctx context.Context, title string, description string, rawURL string) error {
+	return CreateOutputValidation(ctx, title, description, rawURL)
+}
+
+// ListOutputsValidation はアウトプット一覧の検索条件とページング指定が妥当かを判定します。
+func ListOutputsValidation(ctx context.Context, criteria repository.OutputListCriteria) error {
+
+	// 種別のチェック
+	if criteria.Type != "" && !value_obj.OutputType(criteria.Type).IsValid() {
+		return value_obj.OutputTypeInvalidError
+	}
+
+	// ステータスのチェック
+	if criteria.Status != "" && !value_obj.Status(criteria.Status).IsValid() {
+		return value_obj.OutputStatusInvalidError
+	}
+
+	// ページングのチェック
+	if criteria.Limit < 1 || criteria.Limit > MaxOutputListLimit {
+		return value_obj.OutputListLimitRangeError
+	}
+	if criteria.Offset < 0 {
+		return value_obj.OutputListOffsetRangeError
+	}
+
+	return nil
+}
+
+// IsValidOutputURL は http/https スキームでホストを持つ絶対 URL かどうかを判定します。
+func IsValidOutputURL(rawURL string) bool {
+
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"app/internal/domain/output/repository"
+	"app/internal/domain/output/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestCreateOutputValidation はアウトプットの入力値チェックを表形式で検証します。
+// 文字数は rune 単位で数えるため、日本語の上限ちょうどの値が通ることも確認します。
+func TestCreateOutputValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		title       string
+		description string
+		url         string
+		wantErr     error
+	}{
+		"valid":                     {title: "Go入門", description: "学習記録", url: "https://example.com/post"},
+		"valid without url":         {title: "Go入門"},
+		"japanese title at max":     {title: strings.Repeat("あ", MaxOutputTitleLength)},
+		"empty title":               {title: "", wantErr: value_obj.OutputRequiredError},
+		"title too long":            {title: strings.Repeat("a", MaxOutputTitleLength+1), wantErr: value_obj.OutputTitleLengthError},
+		"description too long":      {title: "Go入門", description: strings.Repeat("あ", MaxOutputDescriptionLength+1), wantErr: value_obj.OutputDescriptionLengthError},
+		"relative url":              {title: "Go入門", url: "/posts/1", wantErr: value_obj.OutputURLFormatError},
+		"unsupported scheme":        {title: "Go入門", url: "ftp://example.com/file", wantErr: value_obj.OutputURLFormatError},
+		"url without host":          {title: "Go入門", url: "https://", wantErr: value_obj.OutputURLFormatError},
+		"url with surrounding text": {title: "Go入門", url: "see https://example.com", wantErr: value_obj.OutputURLFormatError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("CreateOutputValidation テストケース開始: %s", name)
+
+			err := CreateOutputValidation(ctx, tt.title, tt.description, tt.url)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestCreateOutputValidation_AllFields は複数の項目が不正な場合に、すべての違反が項目名付きで返ることを検証します。
+func TestCreateOutputValidation_AllFields(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
+
+	err := CreateOutputValidation(context.Background(), "", strings.Repeat("あ", MaxOutputDescriptionLength+1), "/posts/1")
+
+	var v *value_obj.ValidationErrors
+	if !errors.As(err, &v) {
+		t.Fatalf("expected *ValidationErrors, got %T (%v)", err, err)
+	}
+
+	want := []value_obj.FieldError{
+		{Field: "title", Message: value_obj.OutputRequiredError},
+		{Field: "description", Message: value_obj.OutputDescriptionLengthError},
+		{Field: "url", Message: value_obj.OutputURLFormatError},
+	}
+	got := v.Fields()
+	if len(got) != len(want) {
+		t.Fatalf("fields = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("fields[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// TestListOutputsValidation はアウトプット一覧の検索条件とページング指定のチェックを検証します。
+func TestListOutputsValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		criteria repository.OutputListCriteria
+		wantErr  error
+	}{
+		"valid":           {criteria: repository.OutputListCriteria{Limit: DefaultOutputListLimit}},
+		"limit at max":    {criteria: repository.OutputListCriteria{Limit: MaxOutputListLimit, Offset: 40}},
+		"known status":    {criteria: repository.OutputListCriteria{Status: "in_review", Limit: 10}},
+		"known type":      {criteria: repository.OutputListCriteria{Type: "book_note", Limit: 10}},
+		"unknown type":    {criteria: repository.OutputListCriteria{Type: "podcast", Limit: 10}, wantErr: value_obj.OutputTypeInvalidError},
+		"unknown status":  {criteria: repository.OutputListCriteria{Status: "deleted", Limit: 10}, wantErr: value_obj.OutputStatusInvalidError},
+		"zero limit":      {criteria: repository.OutputListCriteria{Limit: 0}, wantErr: value_obj.OutputListLimitRangeError},
+		"limit over max":  {criteria: repository.OutputListCriteria{Limit: MaxOutputListLimit + 1}, wantErr: value_obj.OutputListLimitRangeError},
+		"negative offset": {criteria: repository.OutputListCriteria{Limit: 10, Offset: -1}, wantErr: value_obj.OutputListOffsetRangeError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ListOutputsValidation(ctx, tt.criteria)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
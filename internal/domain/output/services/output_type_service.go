@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"app/internal/domain/output/value_obj"
+)
+
+// OutputTypeRule はアウトプット種別ごとの入力ルールです。
+type OutputTypeRule struct {
+	Type value_obj.OutputType
+
+	// URL の入力が必須か
+	RequiresURL bool
+
+	// URL をコードホスティングサービスに限定するか
+	RequiresCodeHost bool
+
+	// 開催日の入力が必須か(必須でない種別では指定不可)
+	RequiresEventDate bool
+
+	// ISBN の入力が必須か(必須でない種別では指定不可)
+	RequiresISBN bool
+}
+
+// outputTypeRules は種別ごとの入力ルールの定義です。
+var outputTypeRules = map[value_obj.OutputType]OutputTypeRule{
+	value_obj.Blog:       {Type: value_obj.Blog, RequiresURL: true},
+	value_obj.Slide:      {Type: value_obj.Slide, RequiresURL: true},
+	value_obj.Repository: {Type: value_obj.Repository, RequiresURL: true, RequiresCodeHost: true},
+	value_obj.Video:      {Type: value_obj.Video, RequiresURL: true},
+	value_obj.BookNote:   {Type: value_obj.BookNote, RequiresISBN: true},
+	value_obj.Talk:       {Type: value_obj.Talk, RequiresEventDate: true},
+	value_obj.Other:      {Type: value_obj.Other},
+}
+
+// codeHosts はリポジトリ種別の URL として許可するコードホスティングサービスのホスト名です。
+var codeHosts = map[string]bool{
+	"github.com":      true,
+	"gist.github.com": true,
+	"gitlab.com":      true,
+	"bitbucket.org":   true,
+	"codeberg.org":    true,
+	"git.sr.ht":       true,
+}
+
+// OutputTypeRules は全種別の入力ルールを表示順に返します。
+func OutputTypeRules() []OutputTypeRule {
+
+	rules := make([]OutputTypeRule, 0, len(outputTypeRules))
+	for _, t := range value_obj.OutputTypes() {
+		rules = append(rules, outputTypeRules[t])
+	}
+
+	return rules
+}
+
+// OutputTypeValidation は「アウトプットの種別ごとのルールを満たしているか」を判定するドメインバリデーションです。
+//
+//   - 種別: 定義済みの種別でなければエラー
+//   - URL: blog / slide / repository / video は必須、repository はコードホスティングサービスのみ
+//   - 開催日: talk は必須、それ以外の種別では指定不可
+//   - ISBN: book_note は必須かつ ISBN-10 / ISBN-13 のチェックディジットが正しいこと、それ以外の種別では指定不可
+//
+// URL の形式そのもののチェックは CreateOutputValidation で行います。
+// 違反はすべての項目についてまとめ、項目名付きの *value_obj.ValidationErrors として返します。
+func OutputTypeValidation(ctx context.Context, outputType string, rawURL string, eventDate *time.Time, isbn string) error {
+
+	v := &value_obj.ValidationErrors{}
+
+	// 種別のチェック
+	// 種別が不明な場合は以降のルールを決められないため、ここで返す
+	rule, ok := outputTypeRules[value_obj.OutputType(outputType)]
+	if !ok {
+		v.Add("type", value_obj.OutputTypeInvalidError)
+		return v.Err()
+	}
+
+	// URLのチェック
+	if rule.RequiresURL && rawURL == "" {
+		v.Add("url", value_obj.OutputTypeURLRequiredError)
+	}
+	if rule.RequiresCodeHost && rawURL != "" && !isCodeHostURL(rawURL) {
+		v.Add("url", value_obj.OutputRepositoryURLHostError)
+	}
+
+	// 開催日のチェック
+	if rule.RequiresEventDate && eventDate == nil {
+		v.Add("event_date", value_obj.OutputEventDateRequiredError)
+	}
+	if !rule.RequiresEventDate && eventDate != nil {
+		v.Add("event_date", value_obj.OutputTypeFieldNotAllowedError)
+	}
+
+	// ISBNのチェック
+	if rule.RequiresISBN {
+		if isbn == "" {
+			v.Add("isbn", value_obj.OutputISBNRequiredError)
+		} else if !IsValidISBN(isbn) {
+			v.Add("isbn", value_obj.OutputISBNFormatError)
+		}
+	}
+	if !rule.RequiresISBN && isbn != "" {
+		v.Add("isbn", value_obj.OutputTypeFieldNotAllowedError)
+	}
+
+	return v.Err()
+}
+
+// NormalizeISBN は ISBN からハイフンと空白を取り除き、チェックディジットの x を大文字にそろえます。
+func NormalizeISBN(isbn string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+}
+
+// IsValidISBN は ISBN-10 または ISBN-13 としてチェックディジットまで正しいかを判定します。
+// ハイフン・空白区切りの表記も受け付けます。
+func IsValidISBN(isbn string) bool {
+
+	s := NormalizeISBN(isbn)
+
+	switch len(s) {
+	case 10:
+		// ISBN-10: 重み 10〜1 の加重和が 11 の倍数(末尾の X は 10)
+		sum := 0
+		for i, r := range s {
+			var d int
+			switch {
+			case r >= '0' && r <= '9':
+				d = int(r - '0')
+			case r == 'X' && i == 9:
+				d = 10
+			default:
+				return false
+			}
+			sum += d * (10 - i)
+		}
+		return sum%11 == 0
+	case 13:
+		// ISBN-13: 978 / 979 で始まり、重み 1,3 の交互の加重和が 10 の倍数
+		if !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+			return false
+		}
+		sum := 0
+		for i, r := range s {
+			if r < '0' || r > '9' {
+				return false
+			}
+			d := int(r - '0')
+			if i%2 == 1 {
+				d *= 3
+			}
+			sum += d
+		}
+		return sum%10 == 0
+	}
+
+	return false
+}
+
+// isCodeHostURL はコードホスティングサービスの URL かどうかを判定します。
+func isCodeHostURL(rawURL string) bool {
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+
+	return codeHosts[host]
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/internal/domain/output/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestOutputTypeValidation は種別ごとの入力ルールを表形式で検証します。
+func TestOutputTypeValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.OutputDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.OutputDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	date := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		outputType string
+		url        string
+		eventDate  *time.Time
+		isbn       string
+		wantErr    error
+	}{
+		"blog with url":              {outputType: "blog", url: "https://example.com/post"},
+		"blog without url":           {outputType: "blog", wantErr: value_obj.OutputTypeURLRequiredError},
+		"video without url":          {outputType: "video", wantErr: value_obj.OutputTypeURLRequiredError},
+		"repository on github":       {outputType: "repository", url: "https://github.com/example/app"},
+		"repository on www.gitlab":   {outputType: "repository", url: "https://www.gitlab.com/example/app"},
+		"repository on other host":   {outputType: "repository", url: "https://example.com/app", wantErr: value_obj.OutputRepositoryURLHostError},
+		"repository on lookalike":    {outputType: "repository", url: "https://github.com.example.com/app", wantErr: value_obj.OutputRepositoryURLHostError},
+		"talk with event date":       {outputType: "talk", eventDate: &date},
+		"talk without event date":    {outputType: "talk", wantErr: value_obj.OutputEventDateRequiredError},
+		"blog with event date":       {outputType: "blog", url: "https://example.com", eventDate: &date, wantErr: value_obj.OutputTypeFieldNotAllowedError},
+		"book note with isbn-13":     {outputType: "book_note", isbn: "9784873119694"},
+		"book note with isbn-10 x":   {outputType: "book_note", isbn: "080442957X"},
+		"book note without isbn":     {outputType: "book_note", wantErr: value_obj.OutputISBNRequiredError},
+		"book note with bad isbn":    {outputType: "book_note", isbn: "9784873119695", wantErr: value_obj.OutputISBNFormatError},
+		"other with isbn":            {outputType: "other", isbn: "9784873119694", wantErr: value_obj.OutputTypeFieldNotAllowedError},
+		"other without anything":     {outputType: "other"},
+		"unknown type":               {outputType: "podcast", wantErr: value_obj.OutputTypeInvalidError},
+		"empty type is not resolved": {outputType: "", wantErr: value_obj.OutputTypeInvalidError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("OutputTypeValidation テストケース開始: %s", name)
+
+			err := OutputTypeValidation(ctx, tt.outputType, tt.url, tt.eventDate, tt.isbn)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestIsValidISBN は ISBN-10 / ISBN-13 のチェックディジット判定を検証します。
+func TestIsValidISBN(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		isbn string
+		want bool
+	}{
+		"isbn-13":              {isbn: "9784873119694", want: true},
+		"isbn-13 with hyphens": {isbn: "978-4-87311-969-4", want: true},
+		"isbn-10":              {isbn: "4873119693", want: true},
+		"isbn-10 lowercase x":  {isbn: "080442957x", want: true},
+		"isbn-13 bad checksum": {isbn: "9784873119690", want: false},
+		"isbn-13 bad prefix":   {isbn: "1234873119694", want: false},
+		"isbn-10 x not at end": {isbn: "08044X9572", want: false},
+		"wrong length":         {isbn: "97848731196", want: false},
+		"contains letters":     {isbn: "97848731196A4", want: false},
+		"empty":                {isbn: "", want: false},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsValidISBN(tt.isbn); got != tt.want {
+				t.Fatalf("IsValidISBN(%q) = %v, want %v", tt.isbn, got, tt.want)
+			}
+		})
+	}
+}
@@ -103,6 +103,86 @@ var (
 		message: "URLの形式が正しくありません。",
 	}
 
+	// 入力値の検証関連(項目ごとのエラーをまとめたもの)
+	OutputValidationError = ErrorMessage{
+		code:    "output.validation",
+		message: "入力内容に誤りがあります。",
+	}
+
+	// 種別関連
+	OutputTypeInvalidError = ErrorMessage{
+		code:    "output.type.invalid",
+		message: "アウトプットの種別が正しくありません。",
+	}
+	OutputTypeURLRequiredError = ErrorMessage{
+		code:    "output.type.url.required",
+		message: "この種別のアウトプットにはURLを入力してください。",
+	}
+	OutputRepositoryURLHostError = ErrorMessage{
+		code:    "output.type.repository.host",
+		message: "リポジトリのURLはコードホスティングサービスのURLを入力してください。",
+	}
+	OutputEventDateRequiredError = ErrorMessage{
+		code:    "output.type.event_date.required",
+		message: "登壇のアウトプットには開催日を入力してください。",
+	}
+	OutputEventDateFormatError = ErrorMessage{
+		code:    "output.event_date.format",
+		message: "開催日は YYYY-MM-DD 形式で入力してください。",
+	}
+	OutputISBNRequiredError = ErrorMessage{
+		code:    "output.type.isbn.required",
+		message: "読書メモのアウトプットにはISBNを入力してください。",
+	}
+	OutputISBNFormatError = ErrorMessage{
+		code:    "output.isbn.format",
+		message: "ISBNの形式が正しくありません。",
+	}
+	OutputTypeFieldNotAllowedError = ErrorMessage{
+		code:    "output.type.field.not_allowed",
+		message: "この種別のアウトプットには指定できない項目が含まれています。",
+	}
+
+	// 取得関連
+	OutputNotFoundError = ErrorMessage{
+		code:    "output.not_found",
+		message: "アウトプットが見つかりません。",
+	}
+
+	// 更新関連
+	OutputUpdateRequiredError = ErrorMessage{
+		code:    "output.update.required",
+		message: "更新する項目を1つ以上指定してください。",
+	}
+
+	// ステータス関連
+	OutputStatusInvalidError = ErrorMessage{
+		code:    "output.status.invalid",
+		message: "ステータスの指定が正しくありません。",
+	}
+	OutputStatusActionInvalidError = ErrorMessage{
+		code:    "output.status.action.invalid",
+		message: "ステータスの変更操作が正しくありません。",
+	}
+	OutputStatusTransitionError = ErrorMessage{
+		code:    "output.status.transition",
+		message: "現在のステータスからは変更できない操作です。",
+	}
+	OutputNotEditableError = ErrorMessage{
+		code:    "output.status.not_editable",
+		message: "下書き以外のアウトプットは編集できません。下書きに戻してから編集してください。",
+	}
+
+	// 一覧取得関連
+	OutputListLimitRangeError = ErrorMessage{
+		code:    "output.list.limit.range",
+		message: "取得件数は1以上100以下で指定してください。",
+	}
+	OutputListOffsetRangeError = ErrorMessage{
+		code:    "output.list.offset.range",
+		message: "取得開始位置は0以上で指定してください。",
+	}
+
 	// --- テスト用メッセージ ---
 
 	// OutputDomainTestStartInfo はアウトプットドメイン層のテスト開始を表す情報メッセージです。
@@ -0,0 +1,60 @@
+package value_obj
+
+// アウトプットの種別
+type OutputType string
+
+// 種別定義
+const (
+	Blog       OutputType = "blog"
+	Slide      OutputType = "slide"
+	Repository OutputType = "repository"
+	Video      OutputType = "video"
+	BookNote   OutputType = "book_note"
+	Talk       OutputType = "talk"
+	Other      OutputType = "other"
+)
+
+// 定義済みの種別を表示順に返す
+func OutputTypes() []OutputType {
+	return []OutputType{Blog, Slide, Repository, Video, BookNote, Talk, Other}
+}
+
+// 文字列から種別への変換
+// 空文字は「その他」として扱い、未知の値は false を返す
+func ParseOutputType(s string) (OutputType, bool) {
+	if s == "" {
+		return Other, true
+	}
+	t := OutputType(s)
+	return t, t.IsValid()
+}
+
+// 種別として定義されている値かのチェック
+func (t OutputType) IsValid() bool {
+	switch t {
+	case Blog, Slide, Repository, Video, BookNote, Talk, Other:
+		return true
+	}
+	return false
+}
+
+// 画面表示用の名称
+func (t OutputType) Label() string {
+	switch t {
+	case Blog:
+		return "ブログ"
+	case Slide:
+		return "スライド"
+	case Repository:
+		return "リポジトリ"
+	case Video:
+		return "動画"
+	case BookNote:
+		return "読書メモ"
+	case Talk:
+		return "登壇"
+	case Other:
+		return "その他"
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package value_obj
+
+// アウトプットの公開ステータス
+type Status string
+
+// ステータス定義
+const (
+	Draft     Status = "draft"
+	InReview  Status = "in_review"
+	Published Status = "published"
+	Archived  Status = "archived"
+)
+
+// ステータスとして定義されている値かのチェック
+func (s Status) IsValid() bool {
+	switch s {
+	case Draft, InReview, Published, Archived:
+		return true
+	}
+	return false
+}
+
+// 内容を編集できるステータスかのチェック
+// レビュー中・公開中・アーカイブ済みのアウトプットは、下書きに戻してから編集する
+func (s Status) IsEditable() bool {
+	return s == Draft
+}
+
+// ステータスを変更する操作
+type StatusAction string
+
+// 操作定義
+const (
+	Submit    StatusAction = "submit"
+	Reject    StatusAction = "reject"
+	Publish   StatusAction = "publish"
+	Unpublish StatusAction = "unpublish"
+	Archive   StatusAction = "archive"
+	Restore   StatusAction = "restore"
+)
+
+// statusTransition は操作ごとの遷移元と遷移先の組です。
+type statusTransition struct {
+	from Status
+	to   Status
+}
+
+// 遷移表
+// ここに定義されていない (操作, 遷移元) の組み合わせはすべて不正な遷移として扱う
+//
+//	draft --submit--> in_review --publish--> published --archive--> archived
+//	  ^                  |                       |                      |
+//	  +------reject------+                       |                      |
+//	  +-------------------unpublish--------------+                      |
+//	  +----------------------------restore------------------------------+
+var statusTransitions = map[StatusAction]statusTransition{
+	Submit:    {from: Draft, to: InReview},
+	Reject:    {from: InReview, to: Draft},
+	Publish:   {from: InReview, to: Published},
+	Unpublish: {from: Published, to: Draft},
+	Archive:   {from: Published, to: Archived},
+	Restore:   {from: Archived, to: Draft},
+}
+
+// 操作として定義されている値かのチェック
+func (a StatusAction) IsValid() bool {
+	_, ok := statusTransitions[a]
+	return ok
+}
+
+// 現在のステータスに操作を適用した遷移先を返す
+// 未定義の操作は OutputStatusActionInvalidError、現在のステータスから実行できない操作は OutputStatusTransitionError
+func (s Status) Apply(action StatusAction) (Status, error) {
+
+	t, ok := statusTransitions[action]
+	if !ok {
+		return s, OutputStatusActionInvalidError
+	}
+	if t.from != s {
+		return s, OutputStatusTransitionError
+	}
+
+	return t.to, nil
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// EmailVerificationToken Entity
+// メールアドレス確認用のトークンは平文では保存せず、ハッシュ値のみを保持します。
+// 発行時のメールアドレスを記録し、その後にメールアドレスが変更された場合は確認に使えないようにします。
+type EmailVerificationToken struct {
+	TokenHash string     `json:"-" gorm:"primaryKey"`
+	UserID    string     `json:"user_id" gorm:"index"`
+	Email     string     `json:"email"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewEmailVerificationToken コンストラクタ
+func NewEmailVerificationToken(userID, email, tokenHash string, createdAt, expiresAt time.Time) (*EmailVerificationToken, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+	if tokenHash == "" {
+		return nil, errors.New("token_hash is required")
+	}
+
+	// Entity生成
+	return &EmailVerificationToken{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		Email:     email,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// IsUsed は使用済み（または無効化済み）かどうかを返します。
+func (t *EmailVerificationToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired は指定時刻時点で有効期限切れかどうかを返します。
+func (t *EmailVerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
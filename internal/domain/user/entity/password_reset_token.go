@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// PasswordResetToken Entity
+// パスワード再設定トークンは平文では保存せず、ハッシュ値のみを保持します。
+// 一度使用したトークンは UsedAt を記録し、再度は使えないようにします。
+type PasswordResetToken struct {
+	TokenHash string     `json:"-" gorm:"primaryKey"`
+	UserID    string     `json:"user_id" gorm:"index"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewPasswordResetToken コンストラクタ
+func NewPasswordResetToken(userID, tokenHash string, createdAt, expiresAt time.Time) (*PasswordResetToken, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if tokenHash == "" {
+		return nil, errors.New("token_hash is required")
+	}
+
+	// Entity生成
+	return &PasswordResetToken{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// IsUsed は使用済み（または無効化済み）かどうかを返します。
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired は指定時刻時点で有効期限切れかどうかを返します。
+func (t *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
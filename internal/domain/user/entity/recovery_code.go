@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// RecoveryCode Entity
+// 認証アプリを使えなくなった場合に TOTP の代わりに使用する、一度限りのリカバリーコードです。
+// リカバリーコードは平文では保存せず、ハッシュ値のみを保持します。
+type RecoveryCode struct {
+	CodeHash  string     `json:"-" gorm:"primaryKey"`
+	UserID    string     `json:"user_id" gorm:"index"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewRecoveryCode コンストラクタ
+func NewRecoveryCode(userID, codeHash string, createdAt time.Time) (*RecoveryCode, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if codeHash == "" {
+		return nil, errors.New("code_hash is required")
+	}
+
+	// Entity生成
+	return &RecoveryCode{
+		UserID:    userID,
+		CodeHash:  codeHash,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// IsUsed は使用済みかどうかを返します。
+func (c *RecoveryCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
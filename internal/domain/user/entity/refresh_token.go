@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// RefreshToken Entity
+// リフレッシュトークンは平文では保存せず、ハッシュ値のみを保持します。
+// 同じログインから発行されたトークンは FamilyID で束ね、ローテーション時の再利用検知に利用します。
+type RefreshToken struct {
+	TokenHash  string     `json:"-" gorm:"primaryKey"`
+	UserID     string     `json:"user_id" gorm:"index"`
+	FamilyID   string     `json:"family_id" gorm:"index"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	ReplacedBy string     `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// NewRefreshToken コンストラクタ
+// familyID が空の場合は新しいファミリーの先頭トークンとして自身のハッシュを FamilyID にします。
+func NewRefreshToken(userID, tokenHash, familyID string, expiresAt time.Time) (*RefreshToken, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if tokenHash == "" {
+		return nil, errors.New("token_hash is required")
+	}
+	if familyID == "" {
+		familyID = tokenHash
+	}
+
+	// Entity生成
+	return &RefreshToken{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// IsRevoked は失効済みかどうかを返します。
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired は指定時刻時点で有効期限切れかどうかを返します。
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// TOTPCredential Entity
+// 2 段階認証（RFC 6238 の TOTP）のシークレットを保持します。
+// シークレットは暗号化した値のみを保存し、平文は登録時に認証アプリへ渡す場合にのみ扱います。
+// 登録を始めた時点では未確認（ConfirmedAt が nil）で、認証アプリのコードを確認して初めて有効になります。
+type TOTPCredential struct {
+	UserID           string     `json:"user_id" gorm:"primaryKey"`
+	SecretCiphertext string     `json:"-"`
+	ConfirmedAt      *time.Time `json:"confirmed_at"`
+
+	// 最後に使用したコードの時間ステップ（同じコードの再利用を防ぐ）
+	LastUsedStep int64 `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTOTPCredential コンストラクタ
+// 暗号化済みのシークレットを受け取り、未確認の状態で作成します。
+func NewTOTPCredential(userID, secretCiphertext string, createdAt time.Time) (*TOTPCredential, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if secretCiphertext == "" {
+		return nil, errors.New("secret_ciphertext is required")
+	}
+
+	// Entity生成
+	return &TOTPCredential{
+		UserID:           userID,
+		SecretCiphertext: secretCiphertext,
+		CreatedAt:        createdAt,
+		UpdatedAt:        createdAt,
+	}, nil
+}
+
+// IsConfirmed は認証アプリのコードで登録を確認済みかどうかを返します。
+func (c *TOTPCredential) IsConfirmed() bool {
+	return c.ConfirmedAt != nil
+}
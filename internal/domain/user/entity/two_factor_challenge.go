@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// TwoFactorChallenge Entity
+// パスワードの検証に成功した後、2 段階目の認証（TOTP・リカバリーコード）を待つ間のチャレンジです。
+// チャレンジのトークンは平文では保存せず、ハッシュ値のみを保持します。
+// コードの総当たりを防ぐため、誤ったコードの入力回数を Attempts に記録します。
+type TwoFactorChallenge struct {
+	TokenHash string     `json:"-" gorm:"primaryKey"`
+	UserID    string     `json:"user_id" gorm:"index"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	Attempts  int        `json:"attempts"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewTwoFactorChallenge コンストラクタ
+func NewTwoFactorChallenge(userID, tokenHash string, createdAt, expiresAt time.Time) (*TwoFactorChallenge, error) {
+	// 必須入力チェック（不変的チェック）
+	if userID == "" {
+		return nil, errors.New("user_id is required")
+	}
+	if tokenHash == "" {
+		return nil, errors.New("token_hash is required")
+	}
+
+	// Entity生成
+	return &TwoFactorChallenge{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// IsUsed は使用済みかどうかを返します。
+func (c *TwoFactorChallenge) IsUsed() bool {
+	return c.UsedAt != nil
+}
+
+// IsExpired は指定時刻時点で有効期限切れかどうかを返します。
+func (c *TwoFactorChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
@@ -3,6 +3,8 @@ package entity
 import (
 	"errors"
 	"time"
+
+	"app/internal/domain/user/value_obj"
 )
 
 // User Entity
@@ -18,11 +20,28 @@ type User struct {
 	DeleteFlag        bool      `json:"delete_flag"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
+
+	// メールアドレスを確認した日時（未確認の場合は nil）
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+
+	// 2 段階認証（TOTP）を有効にした日時（無効の場合は nil）
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at"`
+
+	// 連続したログインの失敗回数（パスワード・2 段階目のコードの誤り。ログインに成功すると 0 に戻る）
+	FailedLoginCount int `json:"-"`
+
+	// 最後にログインに失敗した日時（失敗していない場合は nil）
+	LastFailedLoginAt *time.Time `json:"-"`
 }
 
 // NewUser コンストラクタ
-func NewUser(name, email, hashedPassword, bio string) (*User, error) {
+// ID は呼び出し側で採番した値（port.IDGenerator）を受け取ります。
+// 新規登録ユーザーのメールアドレスは未確認の状態で作成します。
+func NewUser(id, name, email, hashedPassword, bio string) (*User, error) {
 	// 必須入力チェック（不変的チェック）
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
 	if name == "" {
 		return nil, errors.New("name is required")
 	}
@@ -35,11 +54,53 @@ func NewUser(name, email, hashedPassword, bio string) (*User, error) {
 
 	// Entity生成
 	return &User{
+		ID:        id,
 		Name:      name,
 		Email:     email,
 		Password:  hashedPassword,
+		Role:      string(value_obj.Member), // 新規登録ユーザーは一般メンバー
 		Bio:       bio,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}, nil
 }
+
+// IsEmailVerified はメールアドレスを確認済みかどうかを返します。
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// ChangeEmail はメールアドレスを変更します。
+// 変更後のアドレスはまだ確認していないため、確認済みの状態を解除します（同じアドレスの場合は何もしません）。
+func (u *User) ChangeEmail(email string) {
+	if email == u.Email {
+		return
+	}
+	u.Email = email
+	u.EmailVerifiedAt = nil
+}
+
+// IsTwoFactorEnabled は 2 段階認証を有効にしているかどうかを返します。
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+// IsLoginLocked は指定時刻時点でログインを拒否する状態かどうかを返します。
+// 連続した失敗が maxFailures 回に達し、最後の失敗から lockout が経過していない場合に拒否します。
+func (u *User) IsLoginLocked(now time.Time, maxFailures int, lockout time.Duration) bool {
+	return u.FailedLoginCount >= maxFailures && u.LastFailedLoginAt != nil && now.Sub(*u.LastFailedLoginAt) < lockout
+}
+
+// EffectiveRole は権限の判定に使用する権限を返します。
+// メールアドレスを確認していないユーザーは、確認するまでゲストと同じ権限として扱います。
+// 2 段階認証が必須の権限（管理者・ルート）で 2 段階認証を有効にしていないユーザーは、有効にするまでメンバーと同じ権限として扱います。
+func (u *User) EffectiveRole() value_obj.Role {
+	if !u.IsEmailVerified() {
+		return value_obj.Guest
+	}
+	role := value_obj.ParseRole(u.Role)
+	if role.RequiresTwoFactor() && !u.IsTwoFactorEnabled() {
+		return value_obj.Member
+	}
+	return role
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"app/internal/domain/user/value_obj"
 	testlogger "app/internal/test/logger"
@@ -20,16 +21,21 @@ func TestNewUser_Success(t *testing.T) {
 	logger.Info(value_obj.UserDomainTestStartInfo.Message())
 	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
 
+	id := "01890a5d-ac96-774b-bcce-b302099a8057"
 	name := "Alice"
 	email := "alice@example.com"
 	hashedPassword := "hashed-password"
 	bio := "hello"
 
-	u, err := NewUser(name, email, hashedPassword, bio)
+	u, err := NewUser(id, name, email, hashedPassword, bio)
 	if err != nil {
 		t.Fatalf("NewUser() unexpected error: %v", err)
 	}
 
+	if u.ID != id {
+		t.Errorf("ID = %q, want %q", u.ID, id)
+	}
+
 	if u.Name != name {
 		t.Errorf("Name = %q, want %q", u.Name, name)
 	}
@@ -52,29 +58,39 @@ func TestNewUser_Success(t *testing.T) {
 
 // TestNewUser_RequiredFields は必須項目が欠けている場合にエラーとなることを検証します。
 //
-// name / email / password のいずれかが空文字の場合に、想定しているエラーメッセージが返るかをテーブル形式で確認します。
+// id / name / email / password のいずれかが空文字の場合に、想定しているエラーメッセージが返るかをテーブル形式で確認します。
 // これにより、NewUser の入力チェック仕様を変更した場合でも、
 // どのパターンでどんなエラーになるべきかをテストから素早く思い出せるようにしています。
 func TestNewUser_RequiredFields(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		id            string
 		name          string
 		email         string
 		hashedPass    string
 		wantErrSubstr string
 	}{
+		"empty id": {
+			name:          "Alice",
+			email:         "alice@example.com",
+			hashedPass:    "hashed-password",
+			wantErrSubstr: "id is required",
+		},
 		"empty name": {
+			id:            "user-1",
 			email:         "alice@example.com",
 			hashedPass:    "hashed-password",
 			wantErrSubstr: "name is required",
 		},
 		"empty email": {
+			id:            "user-1",
 			name:          "Alice",
 			hashedPass:    "hashed-password",
 			wantErrSubstr: "email is required",
 		},
 		"empty password": {
+			id:            "user-1",
 			name:          "Alice",
 			email:         "alice@example.com",
 			wantErrSubstr: "password is required",
@@ -89,7 +105,7 @@ func TestNewUser_RequiredFields(t *testing.T) {
 			logger := testlogger.New(t)
 			logger.Info("NewUser の必須項目テストケース開始: %s", name)
 
-			_, err := NewUser(tt.name, tt.email, tt.hashedPass, "")
+			_, err := NewUser(tt.id, tt.name, tt.email, tt.hashedPass, "")
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
@@ -100,4 +116,45 @@ func TestNewUser_RequiredFields(t *testing.T) {
 	}
 }
 
+// TestUser_EffectiveRole はメールアドレスを確認していないユーザーが、
+// 登録された権限にかかわらずゲストとして扱われることを検証します。
+// メールアドレスを変更した場合も、変更後のアドレスを確認するまではゲストに戻ります。
+// また、管理者・ルート権限は 2 段階認証を有効にするまでメンバーとして扱われます。
+func TestUser_EffectiveRole(t *testing.T) {
+	t.Parallel()
 
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	u, err := NewUser("user-1", "Alice", "alice@example.com", "hashed-password", "")
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error: %v", err)
+	}
+	u.Role = string(value_obj.Admin)
+
+	if u.IsEmailVerified() || u.EffectiveRole() != value_obj.Guest {
+		t.Errorf("new user: verified = %v, role = %q, want unverified guest", u.IsEmailVerified(), u.EffectiveRole())
+	}
+
+	verifiedAt := time.Now()
+	u.EmailVerifiedAt = &verifiedAt
+	if u.EffectiveRole() != value_obj.Member {
+		t.Errorf("admin without two-factor: role = %q, want %q", u.EffectiveRole(), value_obj.Member)
+	}
+
+	u.TwoFactorEnabledAt = &verifiedAt
+	if u.EffectiveRole() != value_obj.Admin {
+		t.Errorf("verified user: role = %q, want %q", u.EffectiveRole(), value_obj.Admin)
+	}
+
+	u.ChangeEmail("alice@example.com")
+	if !u.IsEmailVerified() {
+		t.Error("same email must keep verified state")
+	}
+
+	u.ChangeEmail("alice@example.org")
+	if u.IsEmailVerified() || u.EffectiveRole() != value_obj.Guest {
+		t.Errorf("changed email: verified = %v, role = %q, want unverified guest", u.IsEmailVerified(), u.EffectiveRole())
+	}
+}
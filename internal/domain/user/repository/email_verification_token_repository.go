@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// EmailVerificationToken Entityを扱うRepository
+type EmailVerificationTokenRepository interface {
+
+	// メールアドレス確認トークン保存
+	CreateEmailVerificationToken(cxt context.Context, token *entity.EmailVerificationToken) error
+
+	// ハッシュ値によるメールアドレス確認トークン取得
+	FindByTokenHash(cxt context.Context, tokenHash string) (*entity.EmailVerificationToken, error)
+
+	// ユーザーに発行した直近のトークン取得(新しい順に最大 limit 件。再送の回数制限に使用)
+	FindRecentByUserID(cxt context.Context, userID string, limit int) ([]*entity.EmailVerificationToken, error)
+
+	// メールアドレス確認トークンの使用(未使用の場合のみ。使用済みの場合は UserEmailVerificationTokenInvalidError)
+	UseEmailVerificationToken(cxt context.Context, tokenHash string, usedAt time.Time) error
+
+	// ユーザー単位の未使用トークンの一括無効化(新しいトークンの発行時・確認完了時)
+	InvalidateByUserID(cxt context.Context, userID string, invalidatedAt time.Time) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// PasswordResetToken Entityを扱うRepository
+type PasswordResetTokenRepository interface {
+
+	// パスワード再設定トークン保存
+	CreatePasswordResetToken(cxt context.Context, token *entity.PasswordResetToken) error
+
+	// ハッシュ値によるパスワード再設定トークン取得
+	FindByTokenHash(cxt context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+
+	// ユーザーに発行した直近のトークン取得(新しい順に最大 limit 件。申請の回数制限に使用)
+	FindRecentByUserID(cxt context.Context, userID string, limit int) ([]*entity.PasswordResetToken, error)
+
+	// パスワード再設定トークンの使用(未使用の場合のみ。使用済みの場合は UserPasswordResetTokenInvalidError)
+	UsePasswordResetToken(cxt context.Context, tokenHash string, usedAt time.Time) error
+
+	// ユーザー単位の未使用トークンの一括無効化(新しいトークンの発行時・パスワードの再設定時)
+	InvalidateByUserID(cxt context.Context, userID string, invalidatedAt time.Time) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// RecoveryCode Entityを扱うRepository
+type RecoveryCodeRepository interface {
+
+	// リカバリーコードの置き換え(ユーザーの既存のコードを削除して保存する)
+	ReplaceRecoveryCodes(cxt context.Context, userID string, codes []*entity.RecoveryCode) error
+
+	// リカバリーコードの使用(未使用の場合のみ。使用済み・存在しない場合は UserTwoFactorCodeInvalidError)
+	UseRecoveryCode(cxt context.Context, userID string, codeHash string, usedAt time.Time) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// RefreshToken Entityを扱うRepository
+type RefreshTokenRepository interface {
+
+	// リフレッシュトークン保存
+	CreateRefreshToken(cxt context.Context, token *entity.RefreshToken) error
+
+	// ハッシュ値によるリフレッシュトークン取得
+	FindByTokenHash(cxt context.Context, tokenHash string) (*entity.RefreshToken, error)
+
+	// リフレッシュトークン失効(未失効の場合のみ。ローテーション時は後継トークンのハッシュを記録。失効済みの場合は UserRefreshTokenInvalidError)
+	RevokeRefreshToken(cxt context.Context, tokenHash string, replacedBy string, revokedAt time.Time) error
+
+	// ファミリー単位の一括失効(再利用検知時)
+	RevokeFamily(cxt context.Context, familyID string, revokedAt time.Time) error
+
+	// ユーザー単位の一括失効(パスワードの再設定時)
+	RevokeByUserID(cxt context.Context, userID string, revokedAt time.Time) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// TOTPCredential Entityを扱うRepository
+type TOTPCredentialRepository interface {
+
+	// TOTP シークレット保存(未確認の登録がある場合は置き換える)
+	SaveTOTPCredential(cxt context.Context, credential *entity.TOTPCredential) error
+
+	// ユーザーIDによる TOTP シークレット取得(未登録の場合は UserTwoFactorNotEnrolledError)
+	FindByUserID(cxt context.Context, userID string) (*entity.TOTPCredential, error)
+
+	// 登録の確認(確認に使用したコードの時間ステップも記録する)
+	ConfirmTOTPCredential(cxt context.Context, userID string, step int64, confirmedAt time.Time) error
+
+	// コードの時間ステップの使用(使用済みのステップ以前の場合は UserTwoFactorCodeInvalidError)
+	UseTOTPStep(cxt context.Context, userID string, step int64) error
+}
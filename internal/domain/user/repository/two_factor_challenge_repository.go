@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"context"
+	"time"
+)
+
+// TwoFactorChallenge Entityを扱うRepository
+type TwoFactorChallengeRepository interface {
+
+	// チャレンジ保存
+	CreateTwoFactorChallenge(cxt context.Context, challenge *entity.TwoFactorChallenge) error
+
+	// ハッシュ値によるチャレンジ取得(見つからない場合は UserTwoFactorChallengeInvalidError)
+	FindByTokenHash(cxt context.Context, tokenHash string) (*entity.TwoFactorChallenge, error)
+
+	// 誤ったコードの入力回数の加算
+	IncrementAttempts(cxt context.Context, tokenHash string) error
+
+	// チャレンジの使用(未使用の場合のみ。使用済みの場合は UserTwoFactorChallengeInvalidError)
+	UseTwoFactorChallenge(cxt context.Context, tokenHash string, usedAt time.Time) error
+}
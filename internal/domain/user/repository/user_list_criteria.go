@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"app/internal/domain/user/entity"
+	"time"
+)
+
+// ユーザー一覧の並び替え項目
+type UserSortField string
+
+const (
+	UserSortByCreatedAt         UserSortField = "created_at"
+	UserSortByUpdatedAt         UserSortField = "updated_at"
+	UserSortByName              UserSortField = "name"
+	UserSortByEmail             UserSortField = "email"
+	UserSortByYearsOfExperience UserSortField = "years_of_experience"
+)
+
+// 並び替え項目として許可されているかのチェック
+func (f UserSortField) IsValid() bool {
+	switch f {
+	case UserSortByCreatedAt, UserSortByUpdatedAt, UserSortByName, UserSortByEmail, UserSortByYearsOfExperience:
+		return true
+	}
+	return false
+}
+
+// ユーザー一覧の検索条件
+// 指定された条件はすべて AND で結合する(空文字・nil の条件は無視)
+type UserListCriteria struct {
+	// 部分一致条件
+	Name  string
+	Email string
+
+	// 完全一致条件
+	Role       string
+	SkillLevel string
+
+	// 範囲条件
+	MinYearsOfExperience *int
+	MaxYearsOfExperience *int
+	CreatedFrom          *time.Time
+	CreatedTo            *time.Time
+
+	// 論理削除済みユーザーを含めるか
+	IncludeDeleted bool
+
+	// 並び順
+	SortField UserSortField
+	SortDesc  bool
+
+	// ページング(Cursor を指定した場合は Offset を無視してキーセット方式で取得)
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// ユーザー一覧の取得結果
+type UserPage struct {
+	Users []*entity.User
+
+	// 検索条件に一致する総件数(ページングに関係なく数える)
+	Total int64
+
+	// 次ページ取得用のカーソル(次ページが無い場合は空文字)
+	NextCursor string
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"app/internal/domain/user/entity"
 	"context"
+	"time"
 )
 
 // User Entityを扱うRepository
@@ -14,12 +15,36 @@ type UserRepository interface {
 	// 登録メールアドレス重複チェック
 	ExistsByEmail(cxt context.Context, email string) (bool, error)
 
+	// ID指定によるユーザー取得(認証済みユーザー自身の参照に使用)
+	FindByID(cxt context.Context, id string) (*entity.User, error)
+
+	// メールアドレス指定によるユーザー取得(ログイン時に使用)
+	FindByEmail(cxt context.Context, email string) (*entity.User, error)
+
 	// ユーザー検索(root権限のみ使用可能)
 	FindByUser(cxt context.Context, id string, name string, email string) (*entity.User, error)
 
+	// ユーザー一覧取得(admin権限以上で使用可能)
+	ListUsers(cxt context.Context, criteria UserListCriteria) (*UserPage, error)
+
 	// ユーザー更新(root権限のみ使用可能)
 	UpdateUser(cxt context.Context, user *entity.User) error
 
+	// パスワードハッシュの更新(ログイン時のハッシュの作り直しに使用)
+	UpdatePassword(cxt context.Context, id string, hashedPassword string) error
+
+	// メールアドレスの確認済みへの更新(確認時点のメールアドレスと一致する場合のみ)
+	MarkEmailVerified(cxt context.Context, id string, email string, verifiedAt time.Time) error
+
+	// 2 段階認証の有効化(TOTP の登録を確認した時点で使用)
+	EnableTwoFactor(cxt context.Context, id string, enabledAt time.Time) error
+
+	// ログインの失敗の記録(直前の失敗が resetBefore より前の場合は 1 回目として数え直す)
+	RecordLoginFailure(cxt context.Context, id string, failedAt time.Time, resetBefore time.Time) error
+
+	// ログインの失敗回数のリセット(ログインに成功した時点で使用)
+	ResetLoginFailures(cxt context.Context, id string) error
+
 	// ユーザー削除(root権限のみ使用可能)
 	DeleteUser(cxt context.Context, id string) error
 }
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"app/internal/domain/user/value_obj"
+)
+
+// AuthorizeRole は「操作者の権限が、操作に必要な権限を満たしているか」を判定するドメインルールです。
+//
+// 権限の上下関係（Root > Admin > Member > Guest）は value_obj.Role に定義されており、
+// ここではその結果を UserForbiddenError として呼び出し側に伝える役割のみを担います。
+// HTTP ミドルウェア・ユースケースのどちらから呼び出しても同じ判定になるよう、この関数に集約しています。
+func AuthorizeRole(actor value_obj.Role, required value_obj.Role) error {
+
+	if !actor.Satisfies(required) {
+		return value_obj.UserForbiddenError
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestAuthorizeRole は権限の上下関係（Root > Admin > Member > Guest）に従って
+// 操作が許可・拒否されることを表形式で検証します。
+func TestAuthorizeRole(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	tests := map[string]struct {
+		actor    value_obj.Role
+		required value_obj.Role
+		wantErr  error
+	}{
+		"root can do root operation":       {actor: value_obj.Root, required: value_obj.Root},
+		"admin cannot do root operation":   {actor: value_obj.Admin, required: value_obj.Root, wantErr: value_obj.UserForbiddenError},
+		"admin can do admin operation":     {actor: value_obj.Admin, required: value_obj.Admin},
+		"member cannot do admin operation": {actor: value_obj.Member, required: value_obj.Admin, wantErr: value_obj.UserForbiddenError},
+		"member can do member operation":   {actor: value_obj.Member, required: value_obj.Member},
+		"guest cannot do member operation": {actor: value_obj.Guest, required: value_obj.Member, wantErr: value_obj.UserForbiddenError},
+		"guest can do guest operation":     {actor: value_obj.Guest, required: value_obj.Guest},
+		"unknown role is treated as guest": {actor: value_obj.ParseRole("superuser"), required: value_obj.Member, wantErr: value_obj.UserForbiddenError},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("AuthorizeRole テストケース開始: %s", name)
+
+			err := AuthorizeRole(tt.actor, tt.required)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"app/internal/domain/user/value_obj"
+)
+
+// 名前・メールアドレスとの類似チェックで比較する部分文字列の最小の長さ
+// これより短い部分（"Al" など）は偶然一致しやすいため比較しません。
+const similarTokenMinLength = 3
+
+// BreachedPasswordChecker は漏えいが確認されているパスワードの一覧を照会するインターフェースです。
+// 一覧の読み込み方法（ファイルなど）はインフラ層で実装します。
+type BreachedPasswordChecker interface {
+
+	// 漏えい済みのパスワードであれば true を返す
+	IsBreached(password string) bool
+}
+
+// PasswordPolicy はパスワードの強度に関するドメインルールです。
+//
+// ルールの値はデプロイ環境ごとの設定から組み立てます（インフラ層の security.NewPasswordPolicy を参照）。
+// 文字種は Unicode の分類で判定し、英字・数字以外の記号や全角文字も使用できます。
+type PasswordPolicy struct {
+	// 最小・最大の文字数（Unicode の文字単位。0 は制限なし）
+	MinLength int
+	MaxLength int
+
+	// 必須の文字種
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+
+	// 名前・メールアドレスと似たパスワードを拒否するか
+	RejectSimilar bool
+
+	// 漏えい済みパスワードの一覧（nil の場合は確認しない）
+	Breached BreachedPasswordChecker
+}
+
+// DefaultPasswordPolicy は設定が無い場合の既定のパスワードポリシーを返します。
+// 8〜128 文字で英大文字・英小文字・数字を含み、名前・メールアドレスと似ていないことを求めます。
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        128,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RejectSimilar:    true,
+	}
+}
+
+// Validate はパスワードがポリシーを満たすかを判定します。
+// ルールは 文字数 → 使用できない文字 → 文字種 → 名前・メールアドレスとの類似 → 漏えい済み の順に確認し、
+// 最初の違反で打ち切らずに、違反したすべてのルールの ErrorMessage をこの順に返します（すべて満たす場合は nil）。
+// 利用者は 1 回の送信で直すべき点をすべて確認できます。
+// 空文字の判定は必須入力チェックで行うため、ここでは扱いません。
+func (p *PasswordPolicy) Validate(password string, name string, email string) []value_obj.ErrorMessage {
+
+	var violations []value_obj.ErrorMessage
+
+	// 文字数
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		violations = append(violations, value_obj.UserPasswordTooShortError)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, value_obj.UserPasswordTooLongError)
+	}
+
+	// 文字種
+	var hasInvalid, hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case r == utf8.RuneError || unicode.IsControl(r):
+			hasInvalid = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if hasInvalid {
+		violations = append(violations, value_obj.UserPasswordInvalidCharacterError)
+	}
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, value_obj.UserPasswordUppercaseRequiredError)
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, value_obj.UserPasswordLowercaseRequiredError)
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, value_obj.UserPasswordDigitRequiredError)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, value_obj.UserPasswordSymbolRequiredError)
+	}
+
+	// 名前・メールアドレスとの類似
+	if p.RejectSimilar && isSimilarToProfile(password, name, email) {
+		violations = append(violations, value_obj.UserPasswordSimilarToProfileError)
+	}
+
+	// 漏えい済み
+	if p.Breached != nil && p.Breached.IsBreached(password) {
+		violations = append(violations, value_obj.UserPasswordBreachedError)
+	}
+
+	return violations
+}
+
+// isSimilarToProfile はパスワードが名前・メールアドレスと似ているかを判定します。
+//
+// 英数字以外を除いて小文字にした上で、次のいずれかに当てはまれば似ていると判定します。
+//   - パスワードが名前・メールアドレスのローカル部の全体、またはその単語を含む
+//   - 名前・メールアドレスのローカル部の全体がパスワードを含む
+//
+// メールアドレスのドメイン（"com" など）は一般的な単語と一致しやすいため比較しません。
+//
+// 例: 名前 "Alice Smith" に対する "smith2024!" や、メールアドレス "alice@example.com" に対する "Alice123"
+func isSimilarToProfile(password string, name string, email string) bool {
+
+	normalized := normalizeForSimilarity(password)
+	if normalized == "" {
+		return false
+	}
+
+	local, _, _ := strings.Cut(email, "@")
+	for _, source := range []string{name, local} {
+		whole := normalizeForSimilarity(source)
+		if whole == "" {
+			continue
+		}
+
+		// 名前・ローカル部の全体がパスワードを含む（"alicesmith" と "Smith" など）
+		if utf8.RuneCountInString(normalized) >= similarTokenMinLength && strings.Contains(whole, normalized) {
+			return true
+		}
+
+		// パスワードが名前・ローカル部の全体、またはその単語を含む
+		tokens := strings.FieldsFunc(strings.ToLower(source), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, token := range append(tokens, whole) {
+			if utf8.RuneCountInString(token) >= similarTokenMinLength && strings.Contains(normalized, token) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// normalizeForSimilarity は英字・数字以外を除き、小文字に変換します。
+func normalizeForSimilarity(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
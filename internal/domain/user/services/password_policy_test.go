@@ -0,0 +1,151 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// testBreachedPasswords は固定の一覧で照会するテスト用の BreachedPasswordChecker です。
+type testBreachedPasswords map[string]bool
+
+func (b testBreachedPasswords) IsBreached(password string) bool {
+	return b[strings.ToLower(password)]
+}
+
+// TestPasswordPolicy_Validate はパスワードポリシーの各ルールが、違反したルールごとの
+// ErrorMessage を返し、複数のルールに違反した場合はすべてを返すことを検証します。
+func TestPasswordPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	strict := &PasswordPolicy{
+		MinLength:        10,
+		MaxLength:        16,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		RejectSimilar:    true,
+		Breached:         testBreachedPasswords{"correct#horse1a": true},
+	}
+
+	tests := map[string]struct {
+		policy   *PasswordPolicy
+		password string
+		name     string
+		email    string
+		want     []value_obj.ErrorMessage
+	}{
+		"valid": {
+			policy:   strict,
+			password: "Tr0ub4dor&3x",
+		},
+		"too short": {
+			policy:   strict,
+			password: "Ab1!",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordTooShortError},
+		},
+		"too long": {
+			policy:   strict,
+			password: "Abcdefgh1!abcdefg",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordTooLongError},
+		},
+		"length counts characters not bytes": {
+			policy:   &PasswordPolicy{MinLength: 4, MaxLength: 4},
+			password: "パスワー", // 4 文字・12 バイト
+		},
+		"control character": {
+			policy:   strict,
+			password: "Abcdefgh1!\n",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordInvalidCharacterError},
+		},
+		"uppercase required": {
+			policy:   strict,
+			password: "abcdefgh1!",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordUppercaseRequiredError},
+		},
+		"lowercase required": {
+			policy:   strict,
+			password: "ABCDEFGH1!",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordLowercaseRequiredError},
+		},
+		"digit required": {
+			policy:   strict,
+			password: "Abcdefghi!",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordDigitRequiredError},
+		},
+		"symbol required": {
+			policy:   strict,
+			password: "Abcdefghi1",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordSymbolRequiredError},
+		},
+		"space counts as symbol": {
+			policy:   strict,
+			password: "Abcdef ghi1",
+		},
+		"contains name": {
+			policy:   strict,
+			password: "Smith2024!xY",
+			name:     "Alice Smith",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordSimilarToProfileError},
+		},
+		"contains email local part": {
+			policy:   strict,
+			password: "Alice.Doe#99",
+			email:    "alice.doe@example.com",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordSimilarToProfileError},
+		},
+		"email domain is ignored": {
+			policy:   strict,
+			password: "Welcome#2024",
+			email:    "alice@example.com",
+		},
+		"similar check disabled": {
+			policy:   &PasswordPolicy{},
+			password: "alice",
+			name:     "Alice",
+		},
+		"breached": {
+			policy:   strict,
+			password: "Correct#Horse1a",
+			want:     []value_obj.ErrorMessage{value_obj.UserPasswordBreachedError},
+		},
+		"every violation": {
+			policy:   strict,
+			password: "alice\t",
+			name:     "Alice",
+			want: []value_obj.ErrorMessage{
+				value_obj.UserPasswordTooShortError,
+				value_obj.UserPasswordInvalidCharacterError,
+				value_obj.UserPasswordUppercaseRequiredError,
+				value_obj.UserPasswordDigitRequiredError,
+				value_obj.UserPasswordSymbolRequiredError,
+				value_obj.UserPasswordSimilarToProfileError,
+			},
+		},
+		"default policy allows symbols": {
+			policy:   DefaultPasswordPolicy(),
+			password: "Password1!",
+			name:     "Alice",
+			email:    "alice@example.com",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.policy.Validate(tt.password, tt.name, tt.email)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("expected violations %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
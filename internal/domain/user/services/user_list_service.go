@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+)
+
+// ユーザー一覧の取得件数の既定値と上限
+const (
+	DefaultUserListLimit = 20
+	MaxUserListLimit     = 100
+)
+
+// ListUsersValidation はユーザー一覧の検索条件が妥当かを判定するドメインバリデーションです。
+//
+//   - 並び替え項目: 許可された項目以外であればエラー
+//   - 取得件数: 1〜100 の範囲外であればエラー
+//   - 取得開始位置: 負の値であればエラー、カーソルと同時に指定されていればエラー
+//   - 権限: 定義済みの権限以外であればエラー
+//   - 範囲条件: 経験年数・作成日時の下限が上限を超えていればエラー
+//
+// 既定値の補完（並び替え項目・取得件数）は呼び出し側で行い、この関数は補完後の値を検証します。
+func ListUsersValidation(ctx context.Context, criteria repository.UserListCriteria) error {
+
+	// 並び替え項目のチェック
+	if !criteria.SortField.IsValid() {
+		return value_obj.UserListSortInvalidError
+	}
+
+	// ページングのチェック
+	if criteria.Limit < 1 || criteria.Limit > MaxUserListLimit {
+		return value_obj.UserListLimitRangeError
+	}
+	if criteria.Offset < 0 {
+		return value_obj.UserListOffsetRangeError
+	}
+	if criteria.Cursor != "" && criteria.Offset > 0 {
+		return value_obj.UserListPagingConflictError
+	}
+
+	// 権限の値チェック
+	if criteria.Role != "" && value_obj.ParseRole(criteria.Role) != value_obj.Role(criteria.Role) {
+		return value_obj.UserRoleInvalidError
+	}
+
+	// 範囲条件のチェック
+	if criteria.MinYearsOfExperience != nil && criteria.MaxYearsOfExperience != nil &&
+		*criteria.MinYearsOfExperience > *criteria.MaxYearsOfExperience {
+		return value_obj.UserListRangeError
+	}
+	if criteria.CreatedFrom != nil && criteria.CreatedTo != nil && criteria.CreatedFrom.After(*criteria.CreatedTo) {
+		return value_obj.UserListRangeError
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/internal/domain/user/repository"
+	"app/internal/domain/user/value_obj"
+	testlogger "app/internal/test/logger"
+)
+
+// TestListUsersValidation はユーザー一覧の検索条件チェックを表形式で検証します。
+func TestListUsersValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+	intPtr := func(n int) *int { return &n }
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// valid は妥当な検索条件を返し、各ケースで 1 項目だけ変更して使います。
+	valid := func() repository.UserListCriteria {
+		return repository.UserListCriteria{SortField: repository.UserSortByCreatedAt, Limit: DefaultUserListLimit}
+	}
+
+	tests := map[string]struct {
+		modify  func(c *repository.UserListCriteria)
+		wantErr error
+	}{
+		"valid":             {modify: func(*repository.UserListCriteria) {}},
+		"unknown sort":      {modify: func(c *repository.UserListCriteria) { c.SortField = "password" }, wantErr: value_obj.UserListSortInvalidError},
+		"zero limit":        {modify: func(c *repository.UserListCriteria) { c.Limit = 0 }, wantErr: value_obj.UserListLimitRangeError},
+		"limit over max":    {modify: func(c *repository.UserListCriteria) { c.Limit = MaxUserListLimit + 1 }, wantErr: value_obj.UserListLimitRangeError},
+		"negative offset":   {modify: func(c *repository.UserListCriteria) { c.Offset = -1 }, wantErr: value_obj.UserListOffsetRangeError},
+		"cursor and offset": {modify: func(c *repository.UserListCriteria) { c.Cursor = "x"; c.Offset = 1 }, wantErr: value_obj.UserListPagingConflictError},
+		"unknown role":      {modify: func(c *repository.UserListCriteria) { c.Role = "owner" }, wantErr: value_obj.UserRoleInvalidError},
+		"years reversed": {
+			modify: func(c *repository.UserListCriteria) {
+				c.MinYearsOfExperience, c.MaxYearsOfExperience = intPtr(5), intPtr(1)
+			},
+			wantErr: value_obj.UserListRangeError,
+		},
+		"created reversed": {
+			modify:  func(c *repository.UserListCriteria) { c.CreatedFrom, c.CreatedTo = &feb, &jan },
+			wantErr: value_obj.UserListRangeError,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ListUsersValidation テストケース開始: %s", name)
+
+			c := valid()
+			tt.modify(&c)
+
+			err := ListUsersValidation(ctx, c)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,51 +2,86 @@ package services
 
 import (
 	"context"
-	"regexp"
+	"net/mail"
+	"strings"
 
 	"app/internal/domain/user/value_obj"
 )
 
-// PasswordRegex はパスワード文字列の形式チェックに使用する正規表現です。
-// 「半角英大文字・半角英小文字・数字」のみを許可し、その他の記号や全角文字を弾くことで
-// 誤入力や意図しない文字種を早期に検知するために利用します。
-var PasswordRegex = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
-
 // CreateUserValidation は「ユーザーを新規作成してよい状態か」を判定するためのドメインバリデーションです。
 // アプリケーション層やハンドラ層から呼び出され、以下のルールを一括でチェックします。
 //
 //   - 必須入力: name, email, password のいずれかが欠けていればエラー
-//   - パスワード長: 8文字未満であればエラー
-//   - パスワード形式: 半角英数字以外の文字を含んでいればエラー
+//   - メールアドレス形式: user@example.com の形式でなければエラー
+//   - パスワード: パスワードポリシー（文字数・文字種・名前やメールアドレスとの類似・漏えい済み）に違反していればエラー
 //   - 自己紹介文: 255文字を超えていればエラー
 //
-// これらのルールはユーザードメインに閉じたビジネスルールであり、呼び出し側からは
-// value_obj で定義されたエラーメッセージを通して「何が原因で作成できないのか」を把握できます。
-func CreateUserValidation(ctx context.Context, name string, email string, password string, bio string) error {
+// 最初の違反で打ち切らずにすべての項目をチェックし、違反があれば項目名付きの
+// *value_obj.ValidationErrors として返します。フォームは 1 回の送信で全項目のエラーを表示できます。
+func CreateUserValidation(ctx context.Context, policy *PasswordPolicy, name string, email string, password string, bio string) error {
+
+	v := &value_obj.ValidationErrors{}
 
 	// 必須入力項目のチェック
-	if name == "" || email == "" || password == "" {
-		return value_obj.UserRequiredError
+	if name == "" {
+		v.Add("name", value_obj.UserRequiredError)
 	}
-
-	// パスワードの入力数チェック
-	// 8文字以上であること
-	// 半角英数字であること
-	if len(password) < 8 {
-		return value_obj.UserPasswordLengthError
+	if email == "" {
+		v.Add("email", value_obj.UserRequiredError)
 	}
-	if !PasswordRegex.MatchString(password) {
-		return value_obj.UserPasswordFormatError
+	if password == "" {
+		v.Add("password", value_obj.UserRequiredError)
 	}
 
+	// メールアドレスの形式チェック
+	if email != "" && !IsValidEmail(email) {
+		v.Add("email", value_obj.UserEmailFormatError)
+	}
+
+	// パスワードポリシーのチェック
+	// 違反したすべてのルールのエラーを返す
+	addPasswordViolation(v, policy, password, name, email)
+
 	// 自己紹介文の入力数チェック
 	// 255文字以内であること
 	if len(bio) > 255 {
-		return value_obj.UserBioLengthError
+		v.Add("bio", value_obj.UserBioLengthError)
 	}
 
 	// エラーがない場合はnilを返す
-	return nil
+	return v.Err()
+}
+
+// ResetPasswordValidation は「パスワードの再設定で新しいパスワードとして使ってよいか」を判定するためのドメインバリデーションです。
+// ユーザー作成時（CreateUserValidation）と同じパスワードのルールを、再設定するユーザーの名前・メールアドレスに対してチェックします。
+//
+//   - 必須入力: password が空であればエラー
+//   - パスワード: パスワードポリシーに違反していればエラー
+//
+// 違反があれば項目名 password 付きの *value_obj.ValidationErrors として返します。
+func ResetPasswordValidation(ctx context.Context, policy *PasswordPolicy, name string, email string, password string) error {
+
+	v := &value_obj.ValidationErrors{}
+
+	// 必須入力項目のチェック
+	if password == "" {
+		v.Add("password", value_obj.UserRequiredError)
+	}
+
+	// パスワードポリシーのチェック
+	addPasswordViolation(v, policy, password, name, email)
+
+	return v.Err()
+}
+
+// addPasswordViolation はパスワードがポリシーに違反していれば、違反したすべてのルールのエラーを項目 password に追加します。
+// 空文字の場合は必須入力チェックに任せ、何もしません。
+func addPasswordViolation(v *value_obj.ValidationErrors, policy *PasswordPolicy, password string, name string, email string) {
+
+	if password == "" {
+		return
+	}
+	v.AddAll("password", policy.Validate(password, name, email)...)
 }
 
 // FindUserValidation はユーザー検索時に「検索条件がまったく指定されていない」状態を防ぐためのドメインバリデーションです。
@@ -62,3 +97,61 @@ func FindUserValidation(ctx context.Context, id string, name string, email strin
 
 	return nil
 }
+
+// UpdateUserValidation は「更新後のユーザーが保存してよい状態か」を判定するためのドメインバリデーションです。
+// 部分更新の結果として得られる最終的な値に対して、以下のルールをまとめてチェックします。
+//
+//   - 必須入力: name, email が空になっていればエラー
+//   - メールアドレス形式: user@example.com の形式でなければエラー
+//   - 自己紹介文: 255文字を超えていればエラー
+//   - 権限: 定義済みの権限（root/admin/member/guest）以外であればエラー
+//   - 経験年数: 0〜100 の範囲外であればエラー
+func UpdateUserValidation(ctx context.Context, name string, email string, bio string, role string, yearsOfExperience int) error {
+
+	v := &value_obj.ValidationErrors{}
+
+	// 必須入力項目のチェック
+	if name == "" {
+		v.Add("name", value_obj.UserRequiredError)
+	}
+	if email == "" {
+		v.Add("email", value_obj.UserRequiredError)
+	}
+
+	// メールアドレスの形式チェック
+	if email != "" && !IsValidEmail(email) {
+		v.Add("email", value_obj.UserEmailFormatError)
+	}
+
+	// 自己紹介文の入力数チェック
+	if len(bio) > 255 {
+		v.Add("bio", value_obj.UserBioLengthError)
+	}
+
+	// 権限の値チェック
+	if value_obj.ParseRole(role) != value_obj.Role(role) {
+		v.Add("role", value_obj.UserRoleInvalidError)
+	}
+
+	// 経験年数の範囲チェック
+	if yearsOfExperience < 0 || yearsOfExperience > 100 {
+		v.Add("years_of_experience", value_obj.UserYearsOfExperienceRangeError)
+	}
+
+	return v.Err()
+}
+
+// IsValidEmail はメールアドレスが「ローカル部@ドメイン」の単一アドレスかどうかを判定します。
+// "Alice <alice@example.com>" のような表示名付きの形式や、ドットを含まないドメインは許可しません。
+func IsValidEmail(email string) bool {
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return false
+	}
+
+	at := strings.LastIndex(email, "@")
+	domain := email[at+1:]
+
+	return strings.Contains(domain, ".") && !strings.HasPrefix(domain, ".") && !strings.HasSuffix(domain, ".")
+}
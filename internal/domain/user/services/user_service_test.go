@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"app/internal/domain/user/value_obj"
@@ -70,10 +72,10 @@ func TestFindUserValidation(t *testing.T) {
 
 // TestCreateUserValidation はユーザー作成時の入力チェックの動作を検証します。
 //
-// 必須入力・パスワード長・パスワード形式・自己紹介文の長さなど、
+// 必須入力・メールアドレス形式・パスワードポリシー・自己紹介文の長さなど、
 // CreateUserValidation に閉じ込められたドメインルールが正しく機能しているかを
 // ケースごとに表形式で確認します。
-// どのパターンでどのエラーを返すかを、このテストを読むだけで一望できるようにすることが狙いです。
+// 違反は項目ごとにまとめて返るため、期待値も「項目名 → エラーの一覧」の形で記述しています。
 func TestCreateUserValidation(t *testing.T) {
 	t.Parallel()
 
@@ -84,40 +86,82 @@ func TestCreateUserValidation(t *testing.T) {
 	ctx := context.Background()
 
 	tests := map[string]struct {
-		name     string
-		email    string
-		password string
-		bio      string
-		wantErr  error
+		name       string
+		email      string
+		password   string
+		bio        string
+		wantFields map[string][]value_obj.ErrorMessage
 	}{
 		"all required empty": {
-			wantErr: value_obj.UserRequiredError,
+			wantFields: map[string][]value_obj.ErrorMessage{
+				"name":     {value_obj.UserRequiredError},
+				"email":    {value_obj.UserRequiredError},
+				"password": {value_obj.UserRequiredError},
+			},
 		},
 		"password too short": {
+			name:       "Alice",
+			email:      "alice@example.com",
+			password:   "Short1",
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {value_obj.UserPasswordTooShortError}},
+		},
+		"password violates policy": {
+			name:       "Alice",
+			email:      "alice@example.com",
+			password:   "invalid1!",
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {value_obj.UserPasswordUppercaseRequiredError}},
+		},
+		"password violates several rules": {
 			name:     "Alice",
 			email:    "alice@example.com",
-			password: "short",
-			wantErr:  value_obj.UserPasswordLengthError,
+			password: "alice",
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {
+				value_obj.UserPasswordTooShortError,
+				value_obj.UserPasswordUppercaseRequiredError,
+				value_obj.UserPasswordDigitRequiredError,
+				value_obj.UserPasswordSimilarToProfileError,
+			}},
 		},
-		"password invalid format": {
+		"password with symbols": {
 			name:     "Alice",
 			email:    "alice@example.com",
-			password: "invalid!",
-			wantErr:  value_obj.UserPasswordFormatError,
+			password: "Pass word1!",
 		},
 		"bio too long": {
-			name:     "Alice",
-			email:    "alice@example.com",
-			password: "Password1",
+			name:       "Alice",
+			email:      "alice@example.com",
+			password:   "Password1",
+			bio:        string(make([]byte, 256)),
+			wantFields: map[string][]value_obj.ErrorMessage{"bio": {value_obj.UserBioLengthError}},
+		},
+		"email without at": {
+			name:       "Alice",
+			email:      "alice.example.com",
+			password:   "Password1",
+			wantFields: map[string][]value_obj.ErrorMessage{"email": {value_obj.UserEmailFormatError}},
+		},
+		"email with display name": {
+			name:       "Alice",
+			email:      "Alice <alice@example.com>",
+			password:   "Password1",
+			wantFields: map[string][]value_obj.ErrorMessage{"email": {value_obj.UserEmailFormatError}},
+		},
+		"multiple fields invalid": {
+			email:    "alice",
+			password: "short",
 			bio:      string(make([]byte, 256)),
-			wantErr:  value_obj.UserBioLengthError,
+			wantFields: map[string][]value_obj.ErrorMessage{
+				"name":     {value_obj.UserRequiredError},
+				"email":    {value_obj.UserEmailFormatError},
+				"password": {value_obj.UserPasswordTooShortError, value_obj.UserPasswordUppercaseRequiredError, value_obj.UserPasswordDigitRequiredError},
+				"bio":      {value_obj.UserBioLengthError},
+			},
 		},
 		"valid input": {
 			name:     "Alice",
 			email:    "alice@example.com",
 			password: "Password1",
 			bio:      "hello",
-			wantErr:  nil,
 		},
 	}
 
@@ -129,16 +173,142 @@ func TestCreateUserValidation(t *testing.T) {
 			logger := testlogger.New(t)
 			logger.Info("CreateUserValidation テストケース開始: %s", name)
 
-			err := CreateUserValidation(ctx, tt.name, tt.email, tt.password, tt.bio)
+			err := CreateUserValidation(ctx, DefaultPasswordPolicy(), tt.name, tt.email, tt.password, tt.bio)
+			assertFieldErrors(t, err, tt.wantFields)
+		})
+	}
+}
 
-			switch {
-			case tt.wantErr == nil && err != nil:
-				t.Fatalf("unexpected error: %v", err)
-			case tt.wantErr != nil && err == nil:
-				t.Fatalf("expected error %v, got nil", tt.wantErr)
-			case tt.wantErr != nil && err != nil && err.Error() != tt.wantErr.Error():
-				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
-			}
+// TestResetPasswordValidation はパスワード再設定時の入力チェックが、
+// ユーザー作成時と同じパスワードのルールを再設定するユーザーの名前・メールアドレスに対して適用することを検証します。
+func TestResetPasswordValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		password   string
+		wantFields map[string][]value_obj.ErrorMessage
+	}{
+		"empty": {
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {value_obj.UserRequiredError}},
+		},
+		"too short": {
+			password:   "Pass1",
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {value_obj.UserPasswordTooShortError}},
+		},
+		"similar to email": {
+			password:   "Alice2024x",
+			wantFields: map[string][]value_obj.ErrorMessage{"password": {value_obj.UserPasswordSimilarToProfileError}},
+		},
+		"valid": {
+			password: "Tr0ubadour",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("ResetPasswordValidation テストケース開始: %s", name)
+
+			err := ResetPasswordValidation(ctx, DefaultPasswordPolicy(), "Alice Smith", "alice@example.com", tt.password)
+			assertFieldErrors(t, err, tt.wantFields)
 		})
 	}
 }
+
+// TestUpdateUserValidation はユーザー更新時の入力チェックが、違反をすべて項目ごとに返すことを検証します。
+func TestUpdateUserValidation(t *testing.T) {
+	t.Parallel()
+
+	logger := testlogger.New(t)
+	logger.Info(value_obj.UserDomainTestStartInfo.Message())
+	defer logger.Info(value_obj.UserDomainTestSuccessInfo.Message())
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		name       string
+		email      string
+		bio        string
+		role       string
+		years      int
+		wantFields map[string][]value_obj.ErrorMessage
+	}{
+		"valid input": {
+			name:  "Alice",
+			email: "alice@example.com",
+			role:  string(value_obj.Member),
+			years: 3,
+		},
+		"all invalid": {
+			email: "not-an-email",
+			bio:   string(make([]byte, 256)),
+			role:  "owner",
+			years: 101,
+			wantFields: map[string][]value_obj.ErrorMessage{
+				"name":                {value_obj.UserRequiredError},
+				"email":               {value_obj.UserEmailFormatError},
+				"bio":                 {value_obj.UserBioLengthError},
+				"role":                {value_obj.UserRoleInvalidError},
+				"years_of_experience": {value_obj.UserYearsOfExperienceRangeError},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := testlogger.New(t)
+			logger.Info("UpdateUserValidation テストケース開始: %s", name)
+
+			err := UpdateUserValidation(ctx, tt.name, tt.email, tt.bio, tt.role, tt.years)
+			assertFieldErrors(t, err, tt.wantFields)
+		})
+	}
+}
+
+// assertFieldErrors は err が want と同じ項目・エラーの組を（項目ごとに追加順で）持つ ValidationErrors であることを確認します。
+// want が空の場合は err が nil であることを確認します。
+func assertFieldErrors(t *testing.T, err error, want map[string][]value_obj.ErrorMessage) {
+	t.Helper()
+
+	if len(want) == 0 {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+
+	var v *value_obj.ValidationErrors
+	if !errors.As(err, &v) {
+		t.Fatalf("expected *ValidationErrors, got %T (%v)", err, err)
+	}
+
+	got := map[string][]value_obj.ErrorMessage{}
+	for _, f := range v.Fields() {
+		got[f.Field] = append(got[f.Field], f.Message)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("fields = %v, want %v", got, want)
+	}
+	for field, msgs := range want {
+		if !slices.Equal(got[field], msgs) {
+			t.Errorf("field %q = %v, want %v", field, got[field], msgs)
+		}
+		for _, msg := range msgs {
+			if !errors.Is(err, msg) {
+				t.Errorf("errors.Is(err, %v) = false", msg)
+			}
+		}
+	}
+}
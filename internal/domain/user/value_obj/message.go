@@ -85,14 +85,42 @@ var (
 		message: "必須入力項目を入力してください。",
 	}
 
-	// パスワード関連
-	UserPasswordLengthError = ErrorMessage{
-		code:    "user.password.length",
-		message: "パスワードは8文字以上で入力してください。",
+	// パスワード関連(パスワードポリシーのルールごとのエラー)
+	UserPasswordTooShortError = ErrorMessage{
+		code:    "user.password.too_short",
+		message: "パスワードが短すぎます。",
 	}
-	UserPasswordFormatError = ErrorMessage{
-		code:    "user.password.format",
-		message: "パスワードは半角英数字で入力してください。",
+	UserPasswordTooLongError = ErrorMessage{
+		code:    "user.password.too_long",
+		message: "パスワードが長すぎます。",
+	}
+	UserPasswordInvalidCharacterError = ErrorMessage{
+		code:    "user.password.invalid_character",
+		message: "パスワードに使用できない文字が含まれています。",
+	}
+	UserPasswordUppercaseRequiredError = ErrorMessage{
+		code:    "user.password.uppercase_required",
+		message: "パスワードには英大文字を含めてください。",
+	}
+	UserPasswordLowercaseRequiredError = ErrorMessage{
+		code:    "user.password.lowercase_required",
+		message: "パスワードには英小文字を含めてください。",
+	}
+	UserPasswordDigitRequiredError = ErrorMessage{
+		code:    "user.password.digit_required",
+		message: "パスワードには数字を含めてください。",
+	}
+	UserPasswordSymbolRequiredError = ErrorMessage{
+		code:    "user.password.symbol_required",
+		message: "パスワードには記号を含めてください。",
+	}
+	UserPasswordSimilarToProfileError = ErrorMessage{
+		code:    "user.password.similar_to_profile",
+		message: "パスワードに名前やメールアドレスと似た文字列は使用できません。",
+	}
+	UserPasswordBreachedError = ErrorMessage{
+		code:    "user.password.breached",
+		message: "このパスワードは漏えいが確認されているため使用できません。",
 	}
 
 	// 自己紹介関連
@@ -101,12 +129,136 @@ var (
 		message: "自己紹介文は255文字以内で入力してください。",
 	}
 
+	// メールアドレス関連
+	UserEmailAlreadyExistsError = ErrorMessage{
+		code:    "user.email.duplicate",
+		message: "このメールアドレスは既に登録されています。",
+	}
+	UserEmailFormatError = ErrorMessage{
+		code:    "user.email.format",
+		message: "メールアドレスの形式が正しくありません。",
+	}
+
+	// 入力値の検証関連(項目ごとのエラーをまとめたもの)
+	UserValidationError = ErrorMessage{
+		code:    "user.validation",
+		message: "入力内容に誤りがあります。",
+	}
+
+	// 権限関連
+	UserRoleInvalidError = ErrorMessage{
+		code:    "user.role.invalid",
+		message: "権限の値が正しくありません。",
+	}
+
+	// 経験年数関連
+	UserYearsOfExperienceRangeError = ErrorMessage{
+		code:    "user.years_of_experience.range",
+		message: "経験年数は0以上100以下で入力してください。",
+	}
+
+	// 更新関連
+	UserUpdateRequiredError = ErrorMessage{
+		code:    "user.update.required",
+		message: "更新する項目を1つ以上指定してください。",
+	}
+
 	// 検索関連
 	UserSearchRequiredError = ErrorMessage{
 		code:    "user.search.required",
 		message: "検索条件を1つ以上指定してください。",
 	}
 
+	// 取得関連
+	UserNotFoundError = ErrorMessage{
+		code:    "user.not_found",
+		message: "ユーザーが見つかりません。",
+	}
+
+	// 認証関連
+	UserLoginFailedError = ErrorMessage{
+		code:    "user.login.failed",
+		message: "メールアドレスまたはパスワードが正しくありません。",
+	}
+	UserRefreshTokenInvalidError = ErrorMessage{
+		code:    "user.token.invalid",
+		message: "リフレッシュトークンが無効です。再度ログインしてください。",
+	}
+	UserPasswordResetTokenInvalidError = ErrorMessage{
+		code:    "user.password_reset.token_invalid",
+		message: "パスワード再設定のリンクが無効か、有効期限が切れています。もう一度再設定を申請してください。",
+	}
+	UserEmailVerificationTokenInvalidError = ErrorMessage{
+		code:    "user.email_verification.token_invalid",
+		message: "メールアドレス確認のリンクが無効か、有効期限が切れています。確認メールを再送してください。",
+	}
+	UserEmailAlreadyVerifiedError = ErrorMessage{
+		code:    "user.email_verification.already_verified",
+		message: "メールアドレスは確認済みです。",
+	}
+	UserEmailVerificationThrottledError = ErrorMessage{
+		code:    "user.email_verification.throttled",
+		message: "確認メールの送信回数が多すぎます。しばらく待ってから再度お試しください。",
+	}
+
+	// 2 段階認証関連
+	UserTwoFactorAlreadyEnabledError = ErrorMessage{
+		code:    "user.two_factor.already_enabled",
+		message: "2 段階認証は設定済みです。",
+	}
+	UserTwoFactorNotEnrolledError = ErrorMessage{
+		code:    "user.two_factor.not_enrolled",
+		message: "2 段階認証の設定が開始されていません。最初から設定し直してください。",
+	}
+	UserTwoFactorCodeInvalidError = ErrorMessage{
+		code:    "user.two_factor.code_invalid",
+		message: "認証コードが正しくありません。",
+	}
+	UserTwoFactorChallengeInvalidError = ErrorMessage{
+		code:    "user.two_factor.challenge_invalid",
+		message: "2 段階認証の有効期限が切れたか、入力の回数が上限に達しました。再度ログインしてください。",
+	}
+
+	// 認可関連
+	UserUnauthenticatedError = ErrorMessage{
+		code:    "user.auth.unauthenticated",
+		message: "ログインが必要です。",
+	}
+	UserForbiddenError = ErrorMessage{
+		code:    "user.auth.forbidden",
+		message: "この操作を行う権限がありません。",
+	}
+
+	// 一覧取得関連
+	UserListSortInvalidError = ErrorMessage{
+		code:    "user.list.sort.invalid",
+		message: "並び替え項目の指定が正しくありません。",
+	}
+	UserListLimitRangeError = ErrorMessage{
+		code:    "user.list.limit.range",
+		message: "取得件数は1以上100以下で指定してください。",
+	}
+	UserListOffsetRangeError = ErrorMessage{
+		code:    "user.list.offset.range",
+		message: "取得開始位置は0以上で指定してください。",
+	}
+	UserListPagingConflictError = ErrorMessage{
+		code:    "user.list.paging.conflict",
+		message: "カーソルと取得開始位置は同時に指定できません。",
+	}
+	UserListRangeError = ErrorMessage{
+		code:    "user.list.range",
+		message: "範囲指定の下限が上限を超えています。",
+	}
+	UserListDateFormatError = ErrorMessage{
+		code:    "user.list.date.format",
+		message: "日時は YYYY-MM-DD または RFC3339 形式で指定してください。",
+	}
+	UserListCursorInvalidError = ErrorMessage{
+		code:    "user.list.cursor.invalid",
+		message: "カーソルの値が正しくありません。",
+	}
+
 	// --- テスト用メッセージ ---
 
 	// UserDomainTestStartInfo はユーザドメイン層のテスト開始を表す情報メッセージです。
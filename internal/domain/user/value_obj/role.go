@@ -10,6 +10,31 @@ const (
 	Guest  Role = "guest"
 )
 
+// 文字列から権限への変換
+// 未知の値や空文字はゲスト権限として扱う
+func ParseRole(s string) Role {
+	switch Role(s) {
+	case Root, Admin, Member:
+		return Role(s)
+	}
+	return Guest
+}
+
+// 要求された権限を満たしているかのチェック
+func (r Role) Satisfies(required Role) bool {
+	switch required {
+	case Root:
+		return r.IsRoot()
+	case Admin:
+		return r.IsAdmin()
+	case Member:
+		return r.IsMember()
+	case Guest:
+		return true
+	}
+	return false
+}
+
 // ゲストユーザーの規制メソッド
 func (r Role) IsMember() bool {
 
@@ -45,3 +70,9 @@ func (r Role) IsRoot() bool {
 	}
 	return false
 }
+
+// 2 段階認証が必須の権限かのチェック
+// 全ユーザーを削除できる管理者・ルート権限は、パスワードに加えて TOTP による認証を必須とする
+func (r Role) RequiresTwoFactor() bool {
+	return r.IsAdmin()
+}
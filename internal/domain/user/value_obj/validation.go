@@ -0,0 +1,110 @@
+package value_obj
+
+import "strings"
+
+// FieldError は項目単位の検証エラーです。
+// Field にはリクエストの JSON の項目名を設定します。
+type FieldError struct {
+	Field   string
+	Message ErrorMessage
+}
+
+// ValidationErrors はバリデーションで見つかったすべての違反を保持します。
+//
+// 最初の違反で処理を打ち切らず、フォームの全項目のエラーをまとめて返却するために使用します。
+// 同じ項目に対する違反は最初に追加したもののみ保持します（AddAll でまとめて追加した違反はすべて保持します）。
+// Unwrap で個々の ErrorMessage を返すため、errors.Is(err, UserRequiredError) のような判定もそのまま使用できます。
+type ValidationErrors struct {
+	fields []FieldError
+}
+
+// Add は項目の違反を追加します。
+func (v *ValidationErrors) Add(field string, m ErrorMessage) {
+	if v.Has(field) {
+		return
+	}
+	v.fields = append(v.fields, FieldError{Field: field, Message: m})
+}
+
+// AddAll は 1 つの確認で見つかった項目の複数の違反をまとめて追加します。
+// 項目に対する違反が既にある場合は追加しません。
+func (v *ValidationErrors) AddAll(field string, ms ...ErrorMessage) {
+	if v.Has(field) {
+		return
+	}
+	for _, m := range ms {
+		v.fields = append(v.fields, FieldError{Field: field, Message: m})
+	}
+}
+
+// Has は項目に対する違反が既にあるかを返します。
+func (v *ValidationErrors) Has(field string) bool {
+	for _, f := range v.fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge は別のバリデーション結果を取り込みます。
+// ValidationErrors 以外の ErrorMessage は項目名なしの違反として追加します。
+func (v *ValidationErrors) Merge(err error) {
+	switch e := err.(type) {
+	case *ValidationErrors:
+		for _, f := range e.fields {
+			v.Add(f.Field, f.Message)
+		}
+	case ErrorMessage:
+		v.Add("", e)
+	}
+}
+
+// Fields は保持している違反を追加順に返します。
+func (v *ValidationErrors) Fields() []FieldError {
+	return v.fields
+}
+
+// Err は違反が 1 件も無い場合は nil を、ある場合は自身を error として返します。
+func (v *ValidationErrors) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return v
+}
+
+// Error implements the error interface.
+func (v *ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v.fields))
+	for _, f := range v.fields {
+		msgs = append(msgs, f.Field+": "+f.Message.Message())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap は個々の違反を返し、errors.Is / errors.As で判定できるようにします。
+func (v *ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(v.fields))
+	for _, f := range v.fields {
+		errs = append(errs, f.Message)
+	}
+	return errs
+}
+
+// Code はバリデーションエラー全体を表すコードです。
+func (v *ValidationErrors) Code() string {
+	return UserValidationError.Code()
+}
+
+// Message はバリデーションエラー全体を表すメッセージです。
+func (v *ValidationErrors) Message() string {
+	return UserValidationError.Message()
+}
+
+// EachField は違反ごとに項目名・コード・メッセージを渡して fn を呼び出します。
+// ドメインごとの型に依存せずにレスポンスへ変換するために使用します。
+func (v *ValidationErrors) EachField(fn func(field string, code string, message string)) {
+	for _, f := range v.fields {
+		fn(f.Field, f.Message.Code(), f.Message.Message())
+	}
+}
@@ -0,0 +1,31 @@
+package idgen
+
+import (
+	"fmt"
+	"sync"
+
+	"app/internal/application/port"
+)
+
+// FakeGenerator は "<prefix>-0001", "<prefix>-0002", ... と連番の ID を返すテスト用の port.IDGenerator です。
+// 結果が毎回同じになるため、テストで ID を期待値として比較できます。
+type FakeGenerator struct {
+	mu     sync.Mutex
+	prefix string
+	n      int
+}
+
+// NewFake は FakeGenerator のコンストラクタです。
+func NewFake(prefix string) *FakeGenerator {
+	return &FakeGenerator{prefix: prefix}
+}
+
+// NewID は次の連番の ID を返します。
+func (g *FakeGenerator) NewID() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.n++
+	return fmt.Sprintf("%s-%04d", g.prefix, g.n), nil
+}
+
+var _ port.IDGenerator = (*FakeGenerator)(nil)
@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"app/internal/application/port"
+)
+
+// PortLogger はユースケースに注入する port.Logger のテスト用実装です。
+// アプリケーションのログを *testing.T に出力し、テスト結果と一緒に確認できるようにします。
+type PortLogger struct {
+	t *testing.T
+}
+
+// NewPortLogger は PortLogger のコンストラクタです。
+func NewPortLogger(t *testing.T) *PortLogger {
+	return &PortLogger{t: t}
+}
+
+func (l *PortLogger) Debug(_ context.Context, msg string, args ...any) { l.log("DEBUG", msg, args) }
+func (l *PortLogger) Info(_ context.Context, msg string, args ...any)  { l.log("INFO", msg, args) }
+func (l *PortLogger) Warn(_ context.Context, msg string, args ...any)  { l.log("WARN", msg, args) }
+func (l *PortLogger) Error(_ context.Context, msg string, args ...any) { l.log("ERROR", msg, args) }
+
+// log は "[APP][レベル] メッセージ key=value ..." の形式で出力します。
+func (l *PortLogger) log(level string, msg string, args []any) {
+	l.t.Helper()
+	l.t.Logf("[APP][%s] %s %s", level, msg, fmt.Sprint(args...))
+}
+
+var _ port.Logger = (*PortLogger)(nil)
@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	"app/internal/application/port"
+)
+
+// FakeSender は送信したメールをメモリ上に保持するテスト用の port.MailSender です。
+// Err を設定すると送信に失敗したものとしてそのエラーを返し、メールは保持しません。
+type FakeSender struct {
+	mu   sync.Mutex
+	sent []port.Mail
+
+	Err error
+}
+
+// NewFake は FakeSender のコンストラクタです。
+func NewFake() *FakeSender {
+	return &FakeSender{}
+}
+
+// Send はメールを保持します。
+func (s *FakeSender) Send(_ context.Context, m port.Mail) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// Sent はこれまでに送信したメールを送信順に返します。
+func (s *FakeSender) Sent() []port.Mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]port.Mail(nil), s.sent...)
+}
+
+var _ port.MailSender = (*FakeSender)(nil)
@@ -0,0 +1,31 @@
+package tx
+
+import (
+	"context"
+
+	"app/internal/application/port"
+)
+
+// FakeManager は fn をそのまま実行するテスト用の port.TransactionManager です。
+// コミット・ロールバックは行わず、実行回数とロールバック相当（fn がエラーを返した）回数を記録します。
+type FakeManager struct {
+	Calls     int
+	Rollbacks int
+}
+
+// NewFake は FakeManager のコンストラクタです。
+func NewFake() *FakeManager {
+	return &FakeManager{}
+}
+
+// Do は fn を実行し、結果をそのまま返します。
+func (m *FakeManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Calls++
+	if err := fn(ctx); err != nil {
+		m.Rollbacks++
+		return err
+	}
+	return nil
+}
+
+var _ port.TransactionManager = (*FakeManager)(nil)
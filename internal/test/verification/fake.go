@@ -0,0 +1,49 @@
+package verification
+
+import (
+	"context"
+	"sync"
+
+	"app/internal/application/port"
+)
+
+// Request は確認メールの送信依頼の内容です。
+type Request struct {
+	UserID string
+	Name   string
+	Email  string
+}
+
+// FakeSender は確認メールの送信依頼をメモリ上に保持するテスト用の port.EmailVerificationSender です。
+// Err を設定すると送信に失敗したものとしてそのエラーを返し、依頼は保持しません。
+type FakeSender struct {
+	mu       sync.Mutex
+	requests []Request
+
+	Err error
+}
+
+// NewFake は FakeSender のコンストラクタです。
+func NewFake() *FakeSender {
+	return &FakeSender{}
+}
+
+// SendVerification は送信依頼を保持します。
+func (s *FakeSender) SendVerification(_ context.Context, userID string, name string, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.requests = append(s.requests, Request{UserID: userID, Name: name, Email: email})
+	return nil
+}
+
+// Requests はこれまでの送信依頼を依頼順に返します。
+func (s *FakeSender) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+var _ port.EmailVerificationSender = (*FakeSender)(nil)